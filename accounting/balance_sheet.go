@@ -0,0 +1,252 @@
+package accounting
+
+import (
+	"time"
+
+	"github.com/lightninglabs/faraday/fiat"
+	"github.com/lightninglabs/lndclient"
+	"github.com/shopspring/decimal"
+)
+
+// msatPerBtc is the number of millisatoshis in a bitcoin.
+const msatPerBtc = 100_000_000_000
+
+// BalanceSheetConfig contains the functions required to produce a balance
+// sheet.
+type BalanceSheetConfig struct {
+	// Timestamp is the time that the balance sheet should be produced
+	// for.
+	Timestamp time.Time
+
+	// Now is the present time, at which our current balances are looked
+	// up. If the balance sheet's timestamp is before this time, our
+	// balances are reconstructed using the entries between the two.
+	Now time.Time
+
+	// WalletBalance returns our current on chain wallet balance.
+	WalletBalance func() (*lndclient.WalletBalance, error)
+
+	// OpenChannels provides a list of all currently open channels.
+	OpenChannels func() ([]lndclient.ChannelInfo, error)
+
+	// PendingChannels provides a list of our pending channels.
+	PendingChannels func() (*lndclient.PendingChannels, error)
+
+	// ListEntries returns the on chain and off chain audit entries for
+	// [Timestamp, Now).
+	ListEntries func() (Report, error)
+
+	// GetPrice returns the bitcoin price at the time provided. This
+	// function may be nil if fiat values are not required.
+	GetPrice func(time.Time) (*fiat.Price, error)
+}
+
+// Balances contains the components of our node's balance, expressed in
+// millisatoshis. Balances are signed because reconstructed balances may be
+// negative if the entries used to reconstruct them are incomplete.
+type Balances struct {
+	// OnChain is our confirmed on chain wallet balance.
+	OnChain int64
+
+	// Channels is our local balance in open and pending open channels.
+	Channels int64
+
+	// PendingClose is our balance in channels that are waiting for their
+	// close transaction to confirm, and in force closed channels which
+	// have not yet been fully resolved on chain.
+	PendingClose int64
+
+	// UnsettledHtlcs is the value of outgoing htlcs that are in flight in
+	// our open channels.
+	UnsettledHtlcs int64
+}
+
+// Total returns the sum of our balances.
+func (b *Balances) Total() int64 {
+	return b.OnChain + b.Channels + b.PendingClose + b.UnsettledHtlcs
+}
+
+// BalanceSheet contains our node's balances at a point in time.
+type BalanceSheet struct {
+	// Timestamp is the time that the balance sheet was produced for.
+	Timestamp time.Time
+
+	// Balances contains the components of our balance.
+	Balances
+
+	// BTCPrice is the bitcoin price at the time of the balance sheet. It
+	// is nil if fiat values were not requested.
+	BTCPrice *fiat.Price
+}
+
+// BTC returns an amount in millisatoshis expressed in bitcoin.
+func BTC(amtMsat int64) decimal.Decimal {
+	return decimal.NewFromInt(amtMsat).Div(decimal.NewFromInt(msatPerBtc))
+}
+
+// Fiat returns the fiat value of an amount in millisatoshis, using the balance
+// sheet's bitcoin price. If no price is set, zero is returned.
+func (b *BalanceSheet) Fiat(amtMsat int64) decimal.Decimal {
+	if b.BTCPrice == nil {
+		return decimal.Zero
+	}
+
+	return b.BTCPrice.Price.Mul(BTC(amtMsat))
+}
+
+// GetBalanceSheet produces a balance sheet for our node at the time provided.
+// Since lnd only provides our current balances, balances at earlier times are
+// reconstructed by reverting the effect of each audit entry between the time
+// requested and the present.
+func GetBalanceSheet(cfg *BalanceSheetConfig) (*BalanceSheet, error) {
+	walletBalance, err := cfg.WalletBalance()
+	if err != nil {
+		return nil, err
+	}
+
+	channels, err := cfg.OpenChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := cfg.PendingChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	balances := currentBalances(walletBalance, channels, pending)
+
+	if cfg.Timestamp.Before(cfg.Now) {
+		entries, err := cfg.ListEntries()
+		if err != nil {
+			return nil, err
+		}
+
+		revertEntries(&balances, entries)
+	}
+
+	sheet := &BalanceSheet{
+		Timestamp: cfg.Timestamp,
+		Balances:  balances,
+	}
+
+	if cfg.GetPrice != nil {
+		sheet.BTCPrice, err = cfg.GetPrice(cfg.Timestamp)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return sheet, nil
+}
+
+// currentBalances calculates our current balances from lnd's wallet balance
+// and channel state.
+func currentBalances(wallet *lndclient.WalletBalance,
+	channels []lndclient.ChannelInfo,
+	pending *lndclient.PendingChannels) Balances {
+
+	balances := Balances{
+		OnChain: satsToMsat(wallet.Confirmed),
+	}
+
+	for _, channel := range channels {
+		balances.Channels += satsToMsat(channel.LocalBalance)
+
+		for _, htlc := range channel.PendingHtlcs {
+			if htlc.Incoming {
+				continue
+			}
+
+			balances.UnsettledHtlcs += satsToMsat(htlc.Amount)
+		}
+	}
+
+	for _, channel := range pending.PendingOpen {
+		balances.Channels += satsToMsat(channel.LocalBalance)
+	}
+
+	for _, channel := range pending.WaitingClose {
+		balances.PendingClose += satsToMsat(channel.LocalBalance)
+	}
+
+	for _, channel := range pending.PendingForceClose {
+		balances.PendingClose += satsToMsat(channel.LimboBalance)
+	}
+
+	return balances
+}
+
+// revertEntries reverts the effect of a set of entries on our balances,
+// producing our balances before the entries occurred.
+//
+// On chain entry amounts are lnd's net change in our wallet balance, which
+// already includes the on chain fees we paid, so on chain fee entries only
+// affect the balance that the fee was paid from when it was not our wallet.
+// Htlcs are short-lived, so we assume that any htlcs currently in flight were
+// added after the entries, and attribute them to our channel balances.
+//
+// Known omission: force closes do not produce an entry for the funds that
+// move from our channels into limbo, so if a channel was force closed after
+// the entries started, its limbo balance is attributed to pending closes
+// rather than to our channels. Our total balance is unaffected.
+func revertEntries(balances *Balances, entries Report) {
+	balances.Channels += balances.UnsettledHtlcs
+	balances.UnsettledHtlcs = 0
+
+	for _, entry := range entries {
+		amt := int64(entry.Amount)
+		if !entry.Credit {
+			amt *= -1
+		}
+
+		switch entry.Type {
+		// Channel opens move funds from our wallet into a channel,
+		// except for the on chain fees we paid to open the channel,
+		// which are included in the open's amount.
+		case EntryTypeLocalChannelOpen:
+			balances.OnChain -= amt
+			balances.Channels += amt
+
+		case EntryTypeChannelOpenFee:
+			balances.Channels -= amt
+
+		// Channel closes pay out our balance from the channel, less
+		// any fees we paid to close it, to our wallet.
+		case EntryTypeChannelClose:
+			balances.OnChain -= amt
+			balances.Channels += amt
+
+		case EntryTypeChannelCloseFee:
+			balances.Channels -= amt
+
+		// Sweeps move funds from pending closes into our wallet, with
+		// the fee paid from the funds being swept.
+		case EntryTypeSweep:
+			balances.OnChain -= amt
+			balances.PendingClose += amt
+
+		case EntryTypeSweepFee:
+			balances.PendingClose -= amt
+
+		case EntryTypeReceipt, EntryTypePayment:
+			if entry.OnChain {
+				balances.OnChain -= amt
+			} else {
+				balances.Channels -= amt
+			}
+
+		// On chain fees are included in the amount of the transaction
+		// that paid them, off chain fees are not.
+		case EntryTypeFee:
+			if !entry.OnChain {
+				balances.Channels -= amt
+			}
+
+		case EntryTypeCircularReceipt, EntryTypeCircularPayment,
+			EntryTypeCircularPaymentFee, EntryTypeForwardFee:
+
+			balances.Channels -= amt
+		}
+	}
+}
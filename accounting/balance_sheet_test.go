@@ -0,0 +1,177 @@
+package accounting
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/faraday/fiat"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetBalanceSheet tests reconstruction of our balances at a point in time.
+func TestGetBalanceSheet(t *testing.T) {
+	now := time.Unix(1_600_000_000, 0)
+
+	wallet := &lndclient.WalletBalance{Confirmed: 10_000}
+	channels := []lndclient.ChannelInfo{
+		{
+			LocalBalance: 5000,
+			PendingHtlcs: []lndclient.PendingHtlc{
+				{Amount: 100},
+				{Amount: 200, Incoming: true},
+			},
+		},
+	}
+	pending := &lndclient.PendingChannels{
+		PendingOpen: []lndclient.PendingChannel{
+			{LocalBalance: 1000},
+		},
+		WaitingClose: []lndclient.WaitingCloseChannel{
+			{PendingChannel: lndclient.PendingChannel{
+				LocalBalance: 300,
+			}},
+		},
+		PendingForceClose: []lndclient.ForceCloseChannel{
+			{LimboBalance: 700},
+		},
+	}
+
+	entry := func(entryType EntryType, amt int64,
+		onChain bool) *HarmonyEntry {
+
+		credit := amt >= 0
+		if !credit {
+			amt *= -1
+		}
+
+		return &HarmonyEntry{
+			Amount:  lnwire.MilliSatoshi(amt * 1000),
+			Type:    entryType,
+			OnChain: onChain,
+			Credit:  credit,
+		}
+	}
+
+	entries := Report{
+		// We opened a 2000 sat channel, paying 100 sats in fees.
+		entry(EntryTypeLocalChannelOpen, -2100, true),
+		entry(EntryTypeChannelOpenFee, -100, true),
+
+		// A channel closed paying out 900 sats, with 50 sats of fees.
+		entry(EntryTypeChannelClose, 900, true),
+		entry(EntryTypeChannelCloseFee, -50, true),
+
+		// We swept 400 sats, paying 20 in fees.
+		entry(EntryTypeSweep, 400, true),
+		entry(EntryTypeSweepFee, -20, true),
+
+		// We received 3000 sats on chain, and paid 500 sats off
+		// chain with 5 sats in fees.
+		entry(EntryTypeReceipt, 3000, true),
+		entry(EntryTypePayment, -500, false),
+		entry(EntryTypeFee, -5, false),
+
+		// We earned 10 sats forwarding, and rebalanced 100 sats
+		// paying 2 sats in fees.
+		entry(EntryTypeForward, 0, false),
+		entry(EntryTypeForwardFee, 10, false),
+		entry(EntryTypeCircularPayment, -100, false),
+		entry(EntryTypeCircularReceipt, 100, false),
+		entry(EntryTypeCircularPaymentFee, -2, false),
+	}
+
+	price := &fiat.Price{
+		Timestamp: now.Add(-time.Hour),
+		Price:     decimal.NewFromInt(20_000),
+		Currency:  "USD",
+	}
+
+	tests := []struct {
+		name      string
+		timestamp time.Time
+		expected  Balances
+	}{
+		{
+			name:      "present",
+			timestamp: now,
+			expected: Balances{
+				OnChain:        10_000_000,
+				Channels:       6_000_000,
+				PendingClose:   1_000_000,
+				UnsettledHtlcs: 100_000,
+			},
+		},
+		{
+			// On chain: 10000 + 2100 - 900 - 400 - 3000.
+			// Channels: 6000 + 100 - 2100 + 100 + 900 + 50 +
+			// 500 + 5 - 10 + 2.
+			// Pending: 1000 + 400 + 20.
+			name:      "reconstructed",
+			timestamp: now.Add(-time.Hour),
+			expected: Balances{
+				OnChain:      7_800_000,
+				Channels:     5_547_000,
+				PendingClose: 1_420_000,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := &BalanceSheetConfig{
+				Timestamp: test.timestamp,
+				Now:       now,
+				WalletBalance: func() (*lndclient.WalletBalance,
+					error) {
+
+					return wallet, nil
+				},
+				OpenChannels: func() ([]lndclient.ChannelInfo,
+					error) {
+
+					return channels, nil
+				},
+				PendingChannels: func() (
+					*lndclient.PendingChannels, error) {
+
+					return pending, nil
+				},
+				ListEntries: func() (Report, error) {
+					return entries, nil
+				},
+				GetPrice: func(ts time.Time) (*fiat.Price,
+					error) {
+
+					require.Equal(t, test.timestamp, ts)
+					return price, nil
+				},
+			}
+
+			sheet, err := GetBalanceSheet(cfg)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, sheet.Balances)
+			require.Equal(t, price, sheet.BTCPrice)
+		})
+	}
+}
+
+// TestBalanceSheetValues tests conversion of balance sheet amounts to bitcoin
+// and fiat.
+func TestBalanceSheetValues(t *testing.T) {
+	require.Equal(t, "0.015", BTC(1_500_000_000).String())
+	require.Equal(t, "-0.00000000001", BTC(-1).String())
+
+	sheet := &BalanceSheet{}
+	require.True(t, sheet.Fiat(1_500_000_000).IsZero())
+
+	sheet.BTCPrice = &fiat.Price{Price: decimal.NewFromInt(20_000)}
+	require.Equal(t, "300", sheet.Fiat(1_500_000_000).String())
+	require.Equal(t, "-300", sheet.Fiat(-1_500_000_000).String())
+}
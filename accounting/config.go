@@ -13,6 +13,15 @@ import (
 	"github.com/lightningnetwork/lnd/routing/route"
 )
 
+// PaymentLookback is the amount of time before the start of an off chain
+// report that we query payments from. We query payments by the time that they
+// were created, but report them when they settle, so we include those that
+// were created before our report and may have settled within it. Payments
+// resolve within the timeout of their htlcs, which is far shorter than our
+// lookback. Invoices have no such bound, because they can be settled at any
+// time before they expire, so they are queried with a separate lookback.
+const PaymentLookback = time.Hour * 24 * 30
+
 // decodePaymentRequest is a signature for decoding payment requests.
 type decodePaymentRequest func(payReq string) (*lndclient.PaymentRequest, error)
 
@@ -22,13 +31,14 @@ type OffChainConfig struct {
 	CommonConfig
 
 	// ListInvoices lists all our invoices.
-	ListInvoices func() ([]lndclient.Invoice, error)
+	ListInvoices func(ctx context.Context) ([]lndclient.Invoice, error)
 
 	// ListPayments lists all our payments.
-	ListPayments func() ([]lndclient.Payment, error)
+	ListPayments func(ctx context.Context) ([]lndclient.Payment, error)
 
 	// ListForwards lists all our forwards over out relevant period.
-	ListForwards func() ([]lndclient.ForwardingEvent, error)
+	ListForwards func(ctx context.Context) ([]lndclient.ForwardingEvent,
+		error)
 
 	// DecodePayReq decodes a payment request.
 	DecodePayReq decodePaymentRequest
@@ -44,21 +54,25 @@ type OnChainConfig struct {
 	CommonConfig
 
 	// OpenChannels provides a list of all currently open channels.
-	OpenChannels func() ([]lndclient.ChannelInfo, error)
+	OpenChannels func(ctx context.Context) ([]lndclient.ChannelInfo,
+		error)
 
 	// ClosedChannels provides a list of all closed channels.
-	ClosedChannels func() ([]lndclient.ClosedChannel, error)
+	ClosedChannels func(ctx context.Context) ([]lndclient.ClosedChannel,
+		error)
 
 	// PendingChannels provides a list of our pending channels.
-	PendingChannels func() (*lndclient.PendingChannels, error)
+	PendingChannels func(ctx context.Context) (*lndclient.PendingChannels,
+		error)
 
 	// OnChainTransactions provides a list of all on chain transactions
 	// relevant to our wallet over a block range.
-	OnChainTransactions func() ([]lndclient.Transaction, error)
+	OnChainTransactions func(ctx context.Context) ([]lndclient.Transaction,
+		error)
 
 	// ListSweeps returns the transaction ids of the list of sweeps known
 	// to lnd.
-	ListSweeps func() ([]string, error)
+	ListSweeps func(ctx context.Context) ([]string, error)
 
 	// GetFee gets the total fees for a transaction. This function may be
 	// nil if we do not have access to a bitcoin backend to lookup fees.
@@ -97,8 +111,7 @@ type CommonConfig struct {
 // The txLookup function may be nil if a connection to a bitcoin backend is not
 // available. If this is the case, the fee report will log warnings indicating
 // that fee lookups are not possible in certain cases.
-func NewOnChainConfig(ctx context.Context,
-	lnd lndclient.LndServices, startTime, endTime time.Time,
+func NewOnChainConfig(lnd lndclient.LndServices, startTime, endTime time.Time,
 	blockRangeLookup func(start, end time.Time) (uint32, uint32, error),
 	disableFiat bool, txLookup fees.GetDetailsFunc,
 	priceCfg *fiat.PriceSourceConfig,
@@ -135,21 +148,29 @@ func NewOnChainConfig(ctx context.Context,
 		"activity", startHeight, endHeight)
 
 	return &OnChainConfig{
-		OpenChannels: lndwrap.ListChannels(
-			ctx, lnd.Client, false,
-		),
-		ClosedChannels: func() ([]lndclient.ClosedChannel, error) {
+		OpenChannels: func(ctx context.Context) (
+			[]lndclient.ChannelInfo, error) {
+
+			return lndwrap.ListChannels(ctx, lnd.Client, false)()
+		},
+		ClosedChannels: func(ctx context.Context) (
+			[]lndclient.ClosedChannel, error) {
+
 			return lnd.Client.ClosedChannels(ctx)
 		},
-		PendingChannels: func() (*lndclient.PendingChannels, error) {
+		PendingChannels: func(ctx context.Context) (
+			*lndclient.PendingChannels, error) {
+
 			return lnd.Client.PendingChannels(ctx)
 		},
-		OnChainTransactions: func() ([]lndclient.Transaction, error) {
+		OnChainTransactions: func(ctx context.Context) (
+			[]lndclient.Transaction, error) {
+
 			return lnd.Client.ListTransactions(
 				ctx, int32(startHeight), int32(endHeight),
 			)
 		},
-		ListSweeps: func() ([]string, error) {
+		ListSweeps: func(ctx context.Context) ([]string, error) {
 			return lnd.WalletKit.ListSweeps(ctx, int32(startHeight))
 		},
 		CommonConfig: CommonConfig{
@@ -165,27 +186,45 @@ func NewOnChainConfig(ctx context.Context,
 
 // NewOffChainConfig creates a config for creating off chain reports. It takes
 // max parameters which allow control over the pagination size for queries to
-// lnd.
+// lnd. Only the payments created from PaymentLookback before our start time
+// until our end time are queried. If an invoice lookback is provided, only the
+// invoices created from the lookback before our start time until our end time
+// are queried, otherwise all of our invoices are queried so that we include
+// invoices that were created long before they were settled. The context
+// provided is used to decode payment requests, our listing queries use the
+// context that they are called with.
 func NewOffChainConfig(ctx context.Context, lnd lndclient.LndServices,
 	maxInvoices, maxPayments, maxForwards uint64, ownPubkey route.Vertex,
 	startTime, endTime time.Time, disableFiat bool,
-	priceCfg *fiat.PriceSourceConfig,
-	categories []CustomCategory) *OffChainConfig {
+	priceCfg *fiat.PriceSourceConfig, categories []CustomCategory,
+	invoiceLookback time.Duration) *OffChainConfig {
 
 	return &OffChainConfig{
-		ListInvoices: func() ([]lndclient.Invoice, error) {
-			return lndwrap.ListInvoices(
-				ctx, 0, maxInvoices,
-				lnd.Client,
+		ListInvoices: func(ctx context.Context) ([]lndclient.Invoice,
+			error) {
+
+			if invoiceLookback == 0 {
+				return lndwrap.ListInvoices(
+					ctx, 0, maxInvoices, lnd.Client,
+				)
+			}
+
+			return lndwrap.ListInvoicesInRange(
+				ctx, startTime.Add(-invoiceLookback), endTime,
+				maxInvoices, lnd.Client,
 			)
 		},
-		ListPayments: func() ([]lndclient.Payment, error) {
-			return lndwrap.ListPayments(
-				ctx, 0, maxPayments,
-				lnd.Client,
+		ListPayments: func(ctx context.Context) ([]lndclient.Payment,
+			error) {
+
+			return lndwrap.ListPaymentsInRange(
+				ctx, startTime.Add(-PaymentLookback), endTime,
+				maxPayments, lnd.Client,
 			)
 		},
-		ListForwards: func() ([]lndclient.ForwardingEvent, error) {
+		ListForwards: func(ctx context.Context) (
+			[]lndclient.ForwardingEvent, error) {
+
 			return lndwrap.ListForwards(
 				ctx, maxForwards, startTime, endTime,
 				lnd.Client,
@@ -0,0 +1,85 @@
+package accounting
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	invoicespkg "github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// mockInvoiceClient mocks lnd's invoice listing.
+type mockInvoiceClient struct {
+	lndclient.LightningClient
+
+	invoices []lndclient.Invoice
+}
+
+// ListInvoices returns the page of our invoices after the offset requested.
+func (m *mockInvoiceClient) ListInvoices(_ context.Context,
+	req lndclient.ListInvoicesRequest) (*lndclient.ListInvoicesResponse,
+	error) {
+
+	resp := &lndclient.ListInvoicesResponse{
+		LastIndexOffset: req.Offset,
+	}
+
+	for _, invoice := range m.invoices {
+		if invoice.AddIndex <= req.Offset ||
+			uint64(len(resp.Invoices)) == req.MaxInvoices {
+
+			continue
+		}
+
+		resp.Invoices = append(resp.Invoices, invoice)
+		resp.LastIndexOffset = invoice.AddIndex
+	}
+
+	return resp, nil
+}
+
+// TestOffChainConfigOldInvoice tests that invoices that were created long
+// before the start of a report, but settled within it, are included in our
+// off chain reports by default.
+func TestOffChainConfigOldInvoice(t *testing.T) {
+	ctx := context.Background()
+
+	start := time.Unix(1_600_000_000, 0)
+	end := start.Add(time.Hour * 24)
+
+	oldInvoice := lndclient.Invoice{
+		AddIndex:     1,
+		CreationDate: start.Add(PaymentLookback * -2),
+		SettleDate:   start.Add(time.Hour),
+		State:        invoicespkg.ContractSettled,
+	}
+
+	recentInvoice := lndclient.Invoice{
+		AddIndex:     2,
+		CreationDate: start,
+		SettleDate:   start.Add(time.Hour * 2),
+		State:        invoicespkg.ContractSettled,
+	}
+
+	lnd := lndclient.LndServices{
+		Client: &mockInvoiceClient{
+			invoices: []lndclient.Invoice{
+				oldInvoice, recentInvoice,
+			},
+		},
+	}
+
+	cfg := NewOffChainConfig(
+		ctx, lnd, 1, 1, 1, route.Vertex{}, start, end, true, nil,
+		nil, 0,
+	)
+
+	invoices, err := cfg.ListInvoices(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []lndclient.Invoice{
+		oldInvoice, recentInvoice,
+	}, filterInvoices(start, end, invoices))
+}
@@ -0,0 +1,307 @@
+package accounting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/faraday/fiat"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// ExportVersion is the version of our export format.
+const ExportVersion = 1
+
+var (
+	// ErrExportVersion is returned when an export was produced with a
+	// format version that we do not support.
+	ErrExportVersion = errors.New("unsupported export version")
+
+	// ErrExportRange is returned when an offline audit is requested for a
+	// period that is not covered by an export.
+	ErrExportRange = errors.New("audit range not covered by export")
+)
+
+// Export contains all of the lnd data required to produce an audit for a
+// period, so that audits can be reproduced without a connection to lnd.
+type Export struct {
+	// Version is the version of the export format.
+	Version int
+
+	// FaradayVersion is the version of faraday that produced the export.
+	FaradayVersion string
+
+	// CreatedAt is the time that the export was created.
+	CreatedAt time.Time
+
+	// StartTime is the start of the period that the export covers,
+	// inclusive.
+	StartTime time.Time
+
+	// EndTime is the end of the period that the export covers,
+	// exclusive.
+	EndTime time.Time
+
+	// OwnPubKey is our node's public key.
+	OwnPubKey route.Vertex
+
+	// Invoices contains all of our invoices.
+	Invoices []lndclient.Invoice
+
+	// Payments contains all of our payments.
+	Payments []lndclient.Payment
+
+	// PaymentRequests contains the decoded payment requests of our
+	// payments, keyed by encoded payment request.
+	PaymentRequests map[string]*lndclient.PaymentRequest
+
+	// Forwards contains the forwards over the period.
+	Forwards []lndclient.ForwardingEvent
+
+	// OpenChannels contains our open channels. The close address of each
+	// channel is omitted, because it is not required for audits.
+	OpenChannels []lndclient.ChannelInfo
+
+	// ClosedChannels contains our closed channels.
+	ClosedChannels []lndclient.ClosedChannel
+
+	// PendingChannels contains our pending channels.
+	PendingChannels *lndclient.PendingChannels
+
+	// Transactions contains our on chain transactions over the block
+	// range that covers the period.
+	Transactions []lndclient.Transaction
+
+	// Sweeps contains the transaction ids of our sweeps.
+	Sweeps []string
+
+	// FeesAvailable is true if the export was produced with a bitcoin
+	// backend, and contains the fees for our transactions.
+	FeesAvailable bool
+
+	// TxFees contains the total fees paid by each of our transactions,
+	// keyed by transaction id, if fees are available.
+	TxFees map[string]btcutil.Amount
+}
+
+// ExportConfig contains the functions required to produce an export.
+type ExportConfig struct {
+	// OnChain is the config used to query on chain data for the export.
+	OnChain *OnChainConfig
+
+	// OffChain is the config used to query off chain data for the export.
+	OffChain *OffChainConfig
+
+	// FaradayVersion is the version of faraday producing the export.
+	FaradayVersion string
+
+	// Parallelism is the number of queries that we run concurrently to
+	// produce the export. If this value is not set,
+	// DefaultFetchParallelism is used.
+	Parallelism int
+}
+
+// NewExport queries all of the data required to produce an audit for the
+// period covered by our configs. If our on chain config has a fee lookup
+// function, the fees for our transactions are included in the export.
+func NewExport(ctx context.Context, cfg *ExportConfig) (*Export, error) {
+	export := &Export{
+		Version:         ExportVersion,
+		FaradayVersion:  cfg.FaradayVersion,
+		CreatedAt:       time.Now(),
+		StartTime:       cfg.OffChain.StartTime,
+		EndTime:         cfg.OffChain.EndTime,
+		OwnPubKey:       cfg.OffChain.OwnPubKey,
+		PaymentRequests: make(map[string]*lndclient.PaymentRequest),
+	}
+
+	group, groupCtx := newFetchGroup(ctx, cfg.Parallelism)
+	offChain := fetchOffChain(groupCtx, group, cfg.OffChain)
+	onChain := fetchOnChain(groupCtx, group, cfg.OnChain)
+
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+
+	export.Invoices = offChain.invoices
+	export.Payments = offChain.payments
+	export.Forwards = offChain.forwards
+	export.OpenChannels = onChain.open
+	export.ClosedChannels = onChain.closed
+	export.PendingChannels = onChain.pending
+	export.Transactions = onChain.txns
+	export.Sweeps = onChain.sweeps
+
+	for _, payment := range export.Payments {
+		payReq := payment.PaymentRequest
+		if payReq == "" {
+			continue
+		}
+
+		decoded, err := cfg.OffChain.DecodePayReq(payReq)
+		if err != nil {
+			return nil, fmt.Errorf("payment %v: decode payment "+
+				"request failed: %w", payment.Hash, err)
+		}
+
+		export.PaymentRequests[payReq] = decoded
+	}
+
+	// Close addresses are an interface, so can't be decoded from our
+	// export. We don't need them to produce audits, so we omit them.
+	for i := range export.OpenChannels {
+		export.OpenChannels[i].CloseAddr = nil
+	}
+
+	if cfg.OnChain.GetFee == nil {
+		return export, nil
+	}
+
+	// Not all of our transactions require fee lookups, so we just log
+	// transactions that we could not look up. Audits that require these
+	// fees will fail, as they would with live data.
+	export.FeesAvailable = true
+	export.TxFees = make(map[string]btcutil.Amount)
+
+	for _, tx := range export.Transactions {
+		if tx.Tx == nil {
+			continue
+		}
+
+		fee, err := cfg.OnChain.GetFee(tx.Tx.TxHash())
+		if err != nil {
+			log.Warnf("Could not lookup fee for export of tx "+
+				"%v: %v", tx.TxHash, err)
+
+			continue
+		}
+
+		export.TxFees[tx.TxHash] = fee
+	}
+
+	return export, nil
+}
+
+// NewOfflineConfigs returns on chain and off chain configs which produce an
+// audit for [startTime, endTime) from an export rather than from lnd. The
+// period requested must be covered by the export.
+func NewOfflineConfigs(export *Export, startTime, endTime time.Time,
+	disableFiat bool, priceCfg *fiat.PriceSourceConfig,
+	onChainCategories, offChainCategories []CustomCategory) (
+	*OnChainConfig, *OffChainConfig, error) {
+
+	if export.Version != ExportVersion {
+		return nil, nil, fmt.Errorf("%w: %v", ErrExportVersion,
+			export.Version)
+	}
+
+	if startTime.Before(export.StartTime) ||
+		endTime.After(export.EndTime) {
+
+		return nil, nil, fmt.Errorf("%w: audit [%v, %v), export "+
+			"[%v, %v)", ErrExportRange, startTime, endTime,
+			export.StartTime, export.EndTime)
+	}
+
+	common := func(categories []CustomCategory) CommonConfig {
+		return CommonConfig{
+			StartTime:      startTime,
+			EndTime:        endTime,
+			DisableFiat:    disableFiat,
+			PriceSourceCfg: priceCfg,
+			Categories:     categories,
+		}
+	}
+
+	var getFee getFeeFunc
+	if export.FeesAvailable {
+		getFee = func(txid chainhash.Hash) (btcutil.Amount, error) {
+			fee, ok := export.TxFees[txid.String()]
+			if !ok {
+				return 0, fmt.Errorf("fee for tx %v not in "+
+					"export", txid)
+			}
+
+			return fee, nil
+		}
+	}
+
+	onChain := &OnChainConfig{
+		CommonConfig: common(onChainCategories),
+		OpenChannels: func(_ context.Context) (
+			[]lndclient.ChannelInfo, error) {
+
+			return export.OpenChannels, nil
+		},
+		ClosedChannels: func(_ context.Context) (
+			[]lndclient.ClosedChannel, error) {
+
+			return export.ClosedChannels, nil
+		},
+		PendingChannels: func(_ context.Context) (
+			*lndclient.PendingChannels, error) {
+
+			return export.PendingChannels, nil
+		},
+		OnChainTransactions: func(_ context.Context) (
+			[]lndclient.Transaction, error) {
+
+			return export.Transactions, nil
+		},
+		ListSweeps: func(_ context.Context) ([]string, error) {
+			return export.Sweeps, nil
+		},
+		GetFee: getFee,
+	}
+
+	offChain := &OffChainConfig{
+		CommonConfig: common(offChainCategories),
+		ListInvoices: func(_ context.Context) ([]lndclient.Invoice,
+			error) {
+
+			return export.Invoices, nil
+		},
+		ListPayments: func(_ context.Context) ([]lndclient.Payment,
+			error) {
+
+			return export.Payments, nil
+		},
+		// Our off chain report expects forwards to be limited to
+		// the period, so we filter them because the export may cover
+		// a longer period than the audit.
+		ListForwards: func(_ context.Context) (
+			[]lndclient.ForwardingEvent, error) {
+
+			var forwards []lndclient.ForwardingEvent
+			for _, fwd := range export.Forwards {
+				if fwd.Timestamp.Before(startTime) ||
+					!fwd.Timestamp.Before(endTime) {
+
+					continue
+				}
+
+				forwards = append(forwards, fwd)
+			}
+
+			return forwards, nil
+		},
+		DecodePayReq: func(payReq string) (*lndclient.PaymentRequest,
+			error) {
+
+			decoded, ok := export.PaymentRequests[payReq]
+			if !ok {
+				return nil, fmt.Errorf("payment request %v "+
+					"not in export", payReq)
+			}
+
+			return decoded, nil
+		},
+		OwnPubKey: export.OwnPubKey,
+	}
+
+	return onChain, offChain, nil
+}
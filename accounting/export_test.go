@@ -0,0 +1,278 @@
+package accounting
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	invoicespkg "github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOfflineAudit tests that an audit produced from an export that has
+// been serialized matches the audit produced from live data.
+func TestOfflineAudit(t *testing.T) {
+	start := time.Unix(1_600_000_000, 0)
+	end := start.Add(time.Hour * 24)
+
+	ourKey := route.Vertex{1}
+	peerKey := route.Vertex{2}
+
+	closeAddr, err := btcutil.NewAddressPubKeyHash(
+		make([]byte, 20), &chaincfg.MainNetParams,
+	)
+	require.NoError(t, err)
+
+	// Our sweep is a transaction that pays to our wallet, which we look
+	// up fees for.
+	sweepTx := &wire.MsgTx{
+		Version: 2,
+		TxIn: []*wire.TxIn{
+			{PreviousOutPoint: wire.OutPoint{Index: 1}},
+		},
+		TxOut: []*wire.TxOut{
+			{Value: 10_000, PkScript: []byte{1, 2, 3}},
+		},
+	}
+	sweepHash := sweepTx.TxHash()
+
+	preimage := lntypes.Preimage{3}
+
+	invoices := []lndclient.Invoice{
+		{
+			Preimage:   &preimage,
+			Hash:       lntypes.Hash{1},
+			Memo:       "memo",
+			Amount:     1000,
+			AmountPaid: 1000,
+			SettleDate: start.Add(time.Hour * 2),
+			State:      invoicespkg.ContractSettled,
+		},
+	}
+
+	payments := []lndclient.Payment{
+		{
+			Hash:           lntypes.Hash{2},
+			Preimage:       &preimage,
+			PaymentRequest: "lnbc1",
+			Amount:         2000,
+			Fee:            10,
+			Status: &lndclient.PaymentStatus{
+				State: lnrpc.Payment_SUCCEEDED,
+			},
+			Htlcs: []*lnrpc.HTLCAttempt{
+				{
+					Status: lnrpc.HTLCAttempt_SUCCEEDED,
+					ResolveTimeNs: start.Add(
+						time.Hour * 3,
+					).UnixNano(),
+					Route: &lnrpc.Route{
+						Hops: []*lnrpc.Hop{{
+							PubKey: peerKey.String(),
+						}},
+					},
+				},
+			},
+		},
+	}
+
+	payReqs := map[string]*lndclient.PaymentRequest{
+		"lnbc1": {
+			Destination: peerKey,
+			Description: "payment",
+		},
+	}
+
+	forwards := []lndclient.ForwardingEvent{
+		{
+			Timestamp:     start.Add(time.Hour),
+			AmountMsatIn:  2000,
+			AmountMsatOut: 1000,
+			FeeMsat:       1000,
+		},
+		{
+			Timestamp:     start.Add(time.Hour * 10),
+			AmountMsatIn:  5000,
+			AmountMsatOut: 4000,
+			FeeMsat:       1000,
+		},
+	}
+
+	channels := []lndclient.ChannelInfo{
+		{
+			ChannelPoint: "a:1",
+			PubKeyBytes:  peerKey,
+			CloseAddr:    closeAddr,
+		},
+	}
+
+	txns := []lndclient.Transaction{
+		{
+			Tx:            sweepTx,
+			TxHash:        sweepHash.String(),
+			Timestamp:     start.Add(time.Hour * 4),
+			Amount:        10_000,
+			Confirmations: 1,
+		},
+	}
+
+	// liveConfigs returns configs which produce a report from our test
+	// data for a period, filtering forwards as lnd would.
+	liveConfigs := func(startTime, endTime time.Time) (*OnChainConfig,
+		*OffChainConfig) {
+
+		common := CommonConfig{
+			StartTime:   startTime,
+			EndTime:     endTime,
+			DisableFiat: true,
+		}
+
+		onChain := &OnChainConfig{
+			CommonConfig: common,
+			OpenChannels: func(_ context.Context) (
+				[]lndclient.ChannelInfo, error) {
+
+				return channels, nil
+			},
+			ClosedChannels: func(_ context.Context) (
+				[]lndclient.ClosedChannel, error) {
+
+				return nil, nil
+			},
+			PendingChannels: func(_ context.Context) (
+				*lndclient.PendingChannels, error) {
+
+				return &lndclient.PendingChannels{}, nil
+			},
+			OnChainTransactions: func(_ context.Context) (
+				[]lndclient.Transaction, error) {
+
+				return txns, nil
+			},
+			ListSweeps: func(_ context.Context) ([]string, error) {
+				return []string{sweepHash.String()}, nil
+			},
+			GetFee: func(hash chainhash.Hash) (btcutil.Amount,
+				error) {
+
+				require.Equal(t, sweepHash, hash)
+				return 150, nil
+			},
+		}
+
+		offChain := &OffChainConfig{
+			CommonConfig: common,
+			ListInvoices: func(_ context.Context) (
+				[]lndclient.Invoice, error) {
+
+				return invoices, nil
+			},
+			ListPayments: func(_ context.Context) (
+				[]lndclient.Payment, error) {
+
+				return payments, nil
+			},
+			ListForwards: func(_ context.Context) (
+				[]lndclient.ForwardingEvent, error) {
+
+				var filtered []lndclient.ForwardingEvent
+				for _, fwd := range forwards {
+					if inRange(
+						fwd.Timestamp, startTime,
+						endTime,
+					) {
+
+						filtered = append(filtered, fwd)
+					}
+				}
+
+				return filtered, nil
+			},
+			DecodePayReq: func(payReq string) (
+				*lndclient.PaymentRequest, error) {
+
+				return payReqs[payReq], nil
+			},
+			OwnPubKey: ourKey,
+		}
+
+		return onChain, offChain
+	}
+
+	onChain, offChain := liveConfigs(start, end)
+	export, err := NewExport(context.Background(), &ExportConfig{
+		OnChain:        onChain,
+		OffChain:       offChain,
+		FaradayVersion: "0.2.16-alpha",
+	})
+	require.NoError(t, err)
+	require.True(t, export.FeesAvailable)
+	require.Nil(t, export.OpenChannels[0].CloseAddr)
+
+	exportBytes, err := json.Marshal(export)
+	require.NoError(t, err)
+
+	decoded := &Export{}
+	require.NoError(t, json.Unmarshal(exportBytes, decoded))
+
+	audit := func(onChain *OnChainConfig,
+		offChain *OffChainConfig) Report {
+
+		ctx := context.Background()
+
+		onChainReport, err := OnChainReport(ctx, onChain)
+		require.NoError(t, err)
+
+		offChainReport, err := OffChainReport(ctx, offChain)
+		require.NoError(t, err)
+
+		return append(onChainReport, offChainReport...)
+	}
+
+	// We test an audit over the full export, and one over a sub-period
+	// which excludes our second forward.
+	for _, period := range [][2]time.Time{
+		{start, end},
+		{start, start.Add(time.Hour * 5)},
+	} {
+		live := audit(liveConfigs(period[0], period[1]))
+		require.NotEmpty(t, live)
+
+		offlineOnChain, offlineOffChain, err := NewOfflineConfigs(
+			decoded, period[0], period[1], true, nil, nil, nil,
+		)
+		require.NoError(t, err)
+
+		offline := audit(offlineOnChain, offlineOffChain)
+
+		require.Equal(t, len(live), len(offline))
+		for i := range live {
+			require.True(t, live[i].Timestamp.Equal(
+				offline[i].Timestamp,
+			))
+
+			offline[i].Timestamp = live[i].Timestamp
+			require.Equal(t, live[i], offline[i])
+		}
+	}
+
+	// Audits outside of our export's period and exports with unknown
+	// versions fail.
+	_, _, err = NewOfflineConfigs(
+		decoded, start.Add(-time.Second), end, true, nil, nil, nil,
+	)
+	require.ErrorIs(t, err, ErrExportRange)
+
+	decoded.Version = ExportVersion + 1
+	_, _, err = NewOfflineConfigs(decoded, start, end, true, nil, nil, nil)
+	require.ErrorIs(t, err, ErrExportVersion)
+}
@@ -0,0 +1,281 @@
+package accounting
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lightninglabs/faraday/progress"
+	"github.com/lightninglabs/lndclient"
+	"golang.org/x/sync/errgroup"
+)
+
+// DefaultFetchParallelism is the default number of queries to lnd and our
+// price source that we run concurrently when producing reports.
+const DefaultFetchParallelism = 4
+
+// NodeReport produces a report of our on chain and off chain activity using
+// live price data. All of the data that the reports require is queried
+// concurrently, with at most parallelism queries in flight at once, so that
+// our prices are fetched while we are still querying lnd. If any query fails,
+// the remaining queries are cancelled. Either config may be nil if that part
+// of the report is not required. If parallelism is not set,
+// DefaultFetchParallelism is used.
+func NodeReport(ctx context.Context, onChain *OnChainConfig,
+	offChain *OffChainConfig, parallelism int) (Report, error) {
+
+	group, groupCtx := newFetchGroup(ctx, parallelism)
+
+	var (
+		onChainPrices, offChainPrices *priceData
+		onChainInfo                   *onChainData
+		offChainInfo                  *offChainData
+	)
+
+	// We queue our price queries first so that they are not held up by
+	// our lnd queries when our parallelism is limited.
+	if onChain != nil {
+		onChainPrices = fetchPrices(
+			groupCtx, group, &onChain.CommonConfig,
+		)
+	}
+
+	if offChain != nil {
+		offChainPrices = onChainPrices
+		if onChain == nil || !samePrices(
+			&onChain.CommonConfig, &offChain.CommonConfig,
+		) {
+
+			offChainPrices = fetchPrices(
+				groupCtx, group, &offChain.CommonConfig,
+			)
+		}
+	}
+
+	if onChain != nil {
+		onChainInfo = fetchOnChain(groupCtx, group, onChain)
+	}
+
+	if offChain != nil {
+		offChainInfo = fetchOffChain(groupCtx, group, offChain)
+	}
+
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+
+	var report Report
+
+	if onChain != nil {
+		info, err := getOnChainInfo(
+			onChain, onChainInfo, onChainPrices.getPrice,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("on-chain report: gathering "+
+				"on-chain data failed: %w", err)
+		}
+
+		onChainReport, err := onChainReport(info)
+		if err != nil {
+			return nil, err
+		}
+
+		report = append(report, onChainReport...)
+	}
+
+	if offChain != nil {
+		offChainReport, err := offChainReportWithPrices(
+			offChain, offChainInfo, offChainPrices.getPrice,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		report = append(report, offChainReport...)
+	}
+
+	progress.FromContext(ctx).AddEntries(uint64(len(report)))
+
+	return report, nil
+}
+
+// offChainData contains the data we query to produce an off chain report.
+type offChainData struct {
+	invoices []lndclient.Invoice
+	payments []lndclient.Payment
+	forwards []lndclient.ForwardingEvent
+}
+
+// onChainData contains the data we query to produce an on chain report.
+type onChainData struct {
+	txns    []lndclient.Transaction
+	pending *lndclient.PendingChannels
+	open    []lndclient.ChannelInfo
+	closed  []lndclient.ClosedChannel
+	sweeps  []string
+}
+
+// priceData holds the price function for a report once its prices have been
+// fetched.
+type priceData struct {
+	getPrice fiatPrice
+}
+
+// newFetchGroup returns a group that runs at most parallelism queries at
+// once, and a context that is cancelled as soon as any query in the group
+// fails. If parallelism is not set, DefaultFetchParallelism is used.
+func newFetchGroup(ctx context.Context, parallelism int) (*errgroup.Group,
+	context.Context) {
+
+	if parallelism <= 0 {
+		parallelism = DefaultFetchParallelism
+	}
+
+	group, ctx := errgroup.WithContext(ctx)
+	group.SetLimit(parallelism)
+
+	return group, ctx
+}
+
+// fetchOffChain queues queries for all of the data required for an off chain
+// report in the group provided. The data returned may only be read once the
+// group has completed.
+func fetchOffChain(ctx context.Context, group *errgroup.Group,
+	cfg *OffChainConfig) *offChainData {
+
+	data := &offChainData{}
+
+	group.Go(func() error {
+		var err error
+		data.invoices, err = cfg.ListInvoices(ctx)
+		if err != nil {
+			return fmt.Errorf("off-chain report: listing invoices "+
+				"failed: %w", err)
+		}
+
+		return nil
+	})
+
+	group.Go(func() error {
+		var err error
+		data.payments, err = cfg.ListPayments(ctx)
+		if err != nil {
+			return fmt.Errorf("off-chain report: listing payments "+
+				"failed: %w", err)
+		}
+
+		return nil
+	})
+
+	group.Go(func() error {
+		var err error
+		data.forwards, err = cfg.ListForwards(ctx)
+		if err != nil {
+			return fmt.Errorf("off-chain report: listing forwards "+
+				"failed: %w", err)
+		}
+
+		return nil
+	})
+
+	return data
+}
+
+// fetchOnChain queues queries for all of the data required for an on chain
+// report in the group provided. The data returned may only be read once the
+// group has completed.
+func fetchOnChain(ctx context.Context, group *errgroup.Group,
+	cfg *OnChainConfig) *onChainData {
+
+	data := &onChainData{}
+
+	group.Go(func() error {
+		var err error
+		data.txns, err = cfg.OnChainTransactions(ctx)
+		if err != nil {
+			return fmt.Errorf("on-chain report: listing on-chain "+
+				"transactions failed: %w", err)
+		}
+
+		return nil
+	})
+
+	group.Go(func() error {
+		var err error
+		data.pending, err = cfg.PendingChannels(ctx)
+		if err != nil {
+			return fmt.Errorf("on-chain report: listing pending "+
+				"channels failed: %w", err)
+		}
+
+		return nil
+	})
+
+	group.Go(func() error {
+		var err error
+		data.open, err = cfg.OpenChannels(ctx)
+		if err != nil {
+			return fmt.Errorf("on-chain report: listing open "+
+				"channels failed: %w", err)
+		}
+
+		return nil
+	})
+
+	group.Go(func() error {
+		var err error
+		data.closed, err = cfg.ClosedChannels(ctx)
+		if err != nil {
+			return fmt.Errorf("on-chain report: listing closed "+
+				"channels failed: %w", err)
+		}
+
+		return nil
+	})
+
+	group.Go(func() error {
+		var err error
+		data.sweeps, err = cfg.ListSweeps(ctx)
+		if err != nil {
+			return fmt.Errorf("on-chain report: listing sweep "+
+				"transactions failed: %w", err)
+		}
+
+		return nil
+	})
+
+	return data
+}
+
+// fetchPrices queues a query for the prices covering the period of a report
+// in the group provided. The price function returned may only be used once
+// the group has completed.
+func fetchPrices(ctx context.Context, group *errgroup.Group,
+	cfg *CommonConfig) *priceData {
+
+	data := &priceData{}
+
+	group.Go(func() error {
+		var err error
+		data.getPrice, err = getConversion(
+			ctx, cfg.StartTime, cfg.EndTime, cfg.DisableFiat,
+			cfg.PriceSourceCfg,
+		)
+		if err != nil {
+			return fmt.Errorf("init conversion lookup for range "+
+				"[%v,%v) failed: %w", cfg.StartTime,
+				cfg.EndTime, err)
+		}
+
+		return nil
+	})
+
+	return data
+}
+
+// samePrices returns a boolean indicating whether two configs require the
+// same set of prices.
+func samePrices(a, b *CommonConfig) bool {
+	return a.StartTime.Equal(b.StartTime) && a.EndTime.Equal(b.EndTime) &&
+		a.DisableFiat == b.DisableFiat &&
+		a.PriceSourceCfg == b.PriceSourceCfg
+}
@@ -0,0 +1,184 @@
+package accounting
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/stretchr/testify/require"
+)
+
+// queryTracker records the number of queries that are running at once.
+type queryTracker struct {
+	running    int
+	maxRunning int
+	mtx        sync.Mutex
+}
+
+// query records a query that runs until the delay provided has passed or
+// the context provided is cancelled.
+func (q *queryTracker) query(ctx context.Context, delay time.Duration) error {
+	q.mtx.Lock()
+	q.running++
+	if q.running > q.maxRunning {
+		q.maxRunning = q.running
+	}
+	q.mtx.Unlock()
+
+	defer func() {
+		q.mtx.Lock()
+		q.running--
+		q.mtx.Unlock()
+	}()
+
+	select {
+	case <-time.After(delay):
+		return nil
+
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// trackedConfigs returns on chain and off chain configs with queries that are
+// recorded by the tracker provided and take the delay provided. If failing is
+// set, our on chain transactions query fails immediately with that error.
+func trackedConfigs(tracker *queryTracker, delay time.Duration,
+	failing error) (*OnChainConfig, *OffChainConfig) {
+
+	common := CommonConfig{
+		StartTime:   time.Unix(startTime, 0),
+		EndTime:     time.Unix(endTime, 0),
+		DisableFiat: true,
+	}
+
+	onChain := &OnChainConfig{
+		CommonConfig: common,
+		OpenChannels: func(ctx context.Context) (
+			[]lndclient.ChannelInfo, error) {
+
+			return nil, tracker.query(ctx, delay)
+		},
+		ClosedChannels: func(ctx context.Context) (
+			[]lndclient.ClosedChannel, error) {
+
+			return nil, tracker.query(ctx, delay)
+		},
+		PendingChannels: func(ctx context.Context) (
+			*lndclient.PendingChannels, error) {
+
+			return &lndclient.PendingChannels{},
+				tracker.query(ctx, delay)
+		},
+		OnChainTransactions: func(ctx context.Context) (
+			[]lndclient.Transaction, error) {
+
+			if failing != nil {
+				return nil, failing
+			}
+
+			return nil, tracker.query(ctx, delay)
+		},
+		ListSweeps: func(ctx context.Context) ([]string, error) {
+			return nil, tracker.query(ctx, delay)
+		},
+	}
+
+	offChain := &OffChainConfig{
+		CommonConfig: common,
+		ListInvoices: func(ctx context.Context) ([]lndclient.Invoice,
+			error) {
+
+			return nil, tracker.query(ctx, delay)
+		},
+		ListPayments: func(ctx context.Context) ([]lndclient.Payment,
+			error) {
+
+			return nil, tracker.query(ctx, delay)
+		},
+		ListForwards: func(ctx context.Context) (
+			[]lndclient.ForwardingEvent, error) {
+
+			return nil, tracker.query(ctx, delay)
+		},
+	}
+
+	return onChain, offChain
+}
+
+// TestNodeReportParallelism tests that our queries for a node report run
+// concurrently, and that the number of queries running at once is limited.
+func TestNodeReportParallelism(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		parallelism int
+	}{
+		{
+			name:        "sequential",
+			parallelism: 1,
+		},
+		{
+			name:        "limited",
+			parallelism: 3,
+		},
+		{
+			name:        "default",
+			parallelism: 0,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			tracker := &queryTracker{}
+			onChain, offChain := trackedConfigs(
+				tracker, time.Millisecond*20, nil,
+			)
+
+			report, err := NodeReport(
+				context.Background(), onChain, offChain,
+				test.parallelism,
+			)
+			require.NoError(t, err)
+			require.Empty(t, report)
+
+			limit := test.parallelism
+			if limit == 0 {
+				limit = DefaultFetchParallelism
+			}
+
+			require.LessOrEqual(t, tracker.maxRunning, limit)
+			if limit > 1 {
+				require.Greater(t, tracker.maxRunning, 1)
+			}
+		})
+	}
+}
+
+// TestNodeReportCancel tests that all of our queries are cancelled when one of
+// them fails.
+func TestNodeReportCancel(t *testing.T) {
+	t.Parallel()
+
+	errQuery := errors.New("query failed")
+
+	// Our remaining queries would take far longer than the test timeout
+	// if they were not cancelled.
+	tracker := &queryTracker{}
+	onChain, offChain := trackedConfigs(tracker, time.Hour, errQuery)
+
+	_, err := NodeReport(
+		context.Background(), onChain, offChain,
+		DefaultFetchParallelism,
+	)
+	require.ErrorIs(t, err, errQuery)
+	require.Zero(t, tracker.running)
+}
@@ -42,43 +42,22 @@ var (
 
 // OffChainReport gets a report of off chain activity using live price data.
 func OffChainReport(ctx context.Context, cfg *OffChainConfig) (Report, error) {
-	// Retrieve a function which can be used to query individual prices,
-	// or a no-op function if we do not want prices.
-	getPrice, err := getConversion(
-		ctx, cfg.StartTime, cfg.EndTime, cfg.DisableFiat,
-		cfg.PriceSourceCfg,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("off-chain report: init conversion "+
-			"lookup for range [%v,%v) failed: %w", cfg.StartTime,
-			cfg.EndTime, err)
-	}
-
-	return offChainReportWithPrices(cfg, getPrice)
+	return NodeReport(ctx, nil, cfg, DefaultFetchParallelism)
 }
 
-// offChainReportWithPrices produces off chain reports using the getPrice
-// function provided. This allows testing of our report creation without calling
-// the actual price API.
-func offChainReportWithPrices(cfg *OffChainConfig, getPrice fiatPrice) (Report,
-	error) {
+// offChainReportWithPrices produces off chain reports from the data provided
+// using the getPrice function provided. This allows testing of our report
+// creation without calling the actual price API.
+func offChainReportWithPrices(cfg *OffChainConfig, data *offChainData,
+	getPrice fiatPrice) (Report, error) {
 
-	invoices, err := cfg.ListInvoices()
-	if err != nil {
-		return nil, fmt.Errorf("off-chain report: listing invoices "+
-			"failed: %w", err)
-	}
+	invoices := data.invoices
 	filteredInvoices := filterInvoices(cfg.StartTime, cfg.EndTime, invoices)
 
 	log.Infof("Retrieved: %v invoices, %v filtered", len(invoices),
 		len(filteredInvoices))
 
-	payments, err := cfg.ListPayments()
-	if err != nil {
-		return nil, fmt.Errorf("off-chain report: listing payments "+
-			"failed: %w", err)
-	}
-
+	payments := data.payments
 	preProcessed, err := preProcessPayments(payments, cfg.DecodePayReq)
 	if err != nil {
 		return nil, fmt.Errorf("off-chain report: preprocessing %d "+
@@ -105,13 +84,9 @@ func offChainReportWithPrices(cfg *OffChainConfig, getPrice fiatPrice) (Report,
 	log.Infof("Retrieved: %v payments, %v filtered, %v circular",
 		len(payments), len(filteredPayments), len(paymentsToSelf))
 
-	// Get all our forwards, we do not need to filter them because they
-	// are already supplied over the relevant range for our query.
-	forwards, err := cfg.ListForwards()
-	if err != nil {
-		return nil, fmt.Errorf("off-chain report: listing forwards "+
-			"failed: %w", err)
-	}
+	// We do not need to filter our forwards because they are already
+	// supplied over the relevant range for our query.
+	forwards := data.forwards
 
 	log.Infof("Retrieved: %v forwards", len(forwards))
 
@@ -167,8 +167,8 @@ func TestOffChainReport(t *testing.T) {
 	tests := []struct {
 		name string
 
-		// Payments is the set of payments our ListPayments call should
-		// return.
+		// Payments is the set of payments that we produce our report
+		// from.
 		payments []lndclient.Payment
 
 		// err is the error we expect to be returned.
@@ -208,27 +208,19 @@ func TestOffChainReport(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 
-			// Create a config that returns our test set of
-			// payments.
 			cfg := &OffChainConfig{
-				ListInvoices: func() ([]lndclient.Invoice, error) {
-					return nil, nil
-				},
-				ListPayments: func() ([]lndclient.Payment, error) {
-					return test.payments, nil
-				},
-				ListForwards: func() ([]lndclient.ForwardingEvent,
-					error) {
-
-					return nil, nil
-				},
 				CommonConfig: CommonConfig{
 					StartTime: time.Unix(startTime, 0),
 					EndTime:   time.Unix(endTime, 0),
 				},
 			}
 
-			_, err := offChainReportWithPrices(cfg, mockPrice)
+			// Produce a report from our test set of payments.
+			data := &offChainData{
+				payments: test.payments,
+			}
+
+			_, err := offChainReportWithPrices(cfg, data, mockPrice)
 			require.Equal(t, test.err, err)
 		})
 	}
@@ -17,25 +17,7 @@ import (
 // GetTransactions in lnd. If a transaction is not included in this response
 // (eg, a remote party opening a channel to us), it will not be included.
 func OnChainReport(ctx context.Context, cfg *OnChainConfig) (Report, error) {
-	// Retrieve a function which can be used to query individual prices,
-	// or a no-op function if we do not want prices.
-	getPrice, err := getConversion(
-		ctx, cfg.StartTime, cfg.EndTime, cfg.DisableFiat,
-		cfg.PriceSourceCfg,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("on-chain report: init conversion "+
-			"lookup for range [%v,%v) failed: %w", cfg.StartTime,
-			cfg.EndTime, err)
-	}
-
-	info, err := getOnChainInfo(cfg, getPrice)
-	if err != nil {
-		return nil, fmt.Errorf("on-chain report: gathering on-chain "+
-			"data failed: %w", err)
-	}
-
-	return onChainReport(info)
+	return NodeReport(ctx, cfg, nil, DefaultFetchParallelism)
 }
 
 // onChainInformation contains all the information we require to produce an
@@ -77,11 +59,10 @@ func newChannelInfo(id lnwire.ShortChannelID, chanPoint *wire.OutPoint,
 	}
 }
 
-// getOnChainInfo queries lnd for all transactions relevant to our on chain
-// transactions, and produces the set of information that we will need to create
-// an on chain report.
-func getOnChainInfo(cfg *OnChainConfig, getPrice fiatPrice) (*onChainInformation,
-	error) {
+// getOnChainInfo produces the set of information that we will need to create
+// an on chain report from the data that we queried from lnd.
+func getOnChainInfo(cfg *OnChainConfig, data *onChainData,
+	getPrice fiatPrice) (*onChainInformation, error) {
 
 	// Create an info struct to hold all the elements we need.
 	info := &onChainInformation{
@@ -95,16 +76,11 @@ func getOnChainInfo(cfg *OnChainConfig, getPrice fiatPrice) (*onChainInformation
 		closedChannels: make(map[string]closedChannelInfo),
 	}
 
-	onChainTxns, err := cfg.OnChainTransactions()
-	if err != nil {
-		return nil, fmt.Errorf("on-chain report: listing on-chain "+
-			"transactions failed: %w", err)
-	}
-
 	// Filter our on chain transactions by start and end time. If we have
 	// no confirmed on chain transactions over this period, we can return
 	// early.
-	info.txns, err = filterOnChain(cfg.StartTime, cfg.EndTime, onChainTxns)
+	var err error
+	info.txns, err = filterOnChain(cfg.StartTime, cfg.EndTime, data.txns)
 	if err != nil {
 		return nil, fmt.Errorf("on-chain report: filtering "+
 			"transactions for range [%v,%v) failed: %w",
@@ -115,16 +91,12 @@ func getOnChainInfo(cfg *OnChainConfig, getPrice fiatPrice) (*onChainInformation
 		return info, nil
 	}
 
-	// Get our pending channels so that we do not miss channel transactions
-	// that may have confirmed on chain, and will thus be included in our
-	// set of transactions, but are still considered pending by lnd (this
-	// is the case for channel opens that require more than one conf, or for
-	// closing channels that are awaiting resolution).
-	pending, err := cfg.PendingChannels()
-	if err != nil {
-		return nil, fmt.Errorf("on-chain report: listing pending "+
-			"channels failed: %w", err)
-	}
+	// We use our pending channels so that we do not miss channel
+	// transactions that may have confirmed on chain, and will thus be
+	// included in our set of transactions, but are still considered
+	// pending by lnd (this is the case for channel opens that require more
+	// than one conf, or for closing channels that are awaiting resolution).
+	pending := data.pending
 
 	// We add our pending force close channels to opened and closed channels
 	// because it is possible that our channel was opened and closed in the
@@ -174,16 +146,10 @@ func getOnChainInfo(cfg *OnChainConfig, getPrice fiatPrice) (*onChainInformation
 		info.openedChannels[c.ChannelPoint.Hash.String()] = inf
 	}
 
-	// Get our opened channels and create a map of closing txid to the
-	// channel entry. This will be used to separate channel opens out from
-	// other on chain transactions.
-	openRPCChannels, err := cfg.OpenChannels()
-	if err != nil {
-		return nil, fmt.Errorf("on-chain report: listing open "+
-			"channels failed: %w", err)
-	}
-
-	for _, channel := range openRPCChannels {
+	// Create a map of our opened channels' txids to the channel entry.
+	// This will be used to separate channel opens out from other on chain
+	// transactions.
+	for _, channel := range data.open {
 		outpoint, err := utils.GetOutPointFromString(
 			channel.ChannelPoint,
 		)
@@ -207,19 +173,12 @@ func getOnChainInfo(cfg *OnChainConfig, getPrice fiatPrice) (*onChainInformation
 		info.openedChannels[outpoint.Hash.String()] = inf
 	}
 
-	// Get our closed channels and create a map of closing txid to closed
-	// channel. This will be used to separate out channel closes from other
-	// on chain transactions.
-	closedRPCChannels, err := cfg.ClosedChannels()
-	if err != nil {
-		return nil, fmt.Errorf("on-chain report: listing closed "+
-			"channels failed: %w", err)
-	}
-
-	// Add our already closed channels open and closed transactions to our
+	// Create a map of closing txid to closed channel. This will be used to
+	// separate out channel closes from other on chain transactions. We
+	// add our already closed channels open and closed transactions to our
 	// on chain info so that we will be able to detect channels that were
 	// opened and closed within our period.
-	for _, closed := range closedRPCChannels {
+	for _, closed := range data.closed {
 		outpoint, err := utils.GetOutPointFromString(
 			closed.ChannelPoint,
 		)
@@ -244,15 +203,9 @@ func getOnChainInfo(cfg *OnChainConfig, getPrice fiatPrice) (*onChainInformation
 		}
 	}
 
-	// Finally, get our list of known sweeps from lnd so that we can
+	// Finally, add our list of known sweeps from lnd so that we can
 	// identify them separately to other on chain transactions.
-	sweeps, err := cfg.ListSweeps()
-	if err != nil {
-		return nil, fmt.Errorf("on-chain report: listing sweep "+
-			"transactions failed: %w", err)
-	}
-
-	for _, sweep := range sweeps {
+	for _, sweep := range data.sweeps {
 		info.sweeps[sweep] = true
 	}
 
@@ -0,0 +1,266 @@
+package accounting
+
+import (
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+// SummaryClass describes how an entry type is presented in an income
+// statement.
+type SummaryClass int
+
+const (
+	// SummaryClassIncome includes entries that increase our earnings:
+	// forwarding fees and receipts.
+	SummaryClassIncome SummaryClass = iota
+
+	// SummaryClassExpense includes entries that decrease our earnings:
+	// payments and the off chain and on chain fees we pay.
+	SummaryClassExpense
+
+	// SummaryClassTransfer includes entries that move funds between our
+	// own balances, such as channel opens, closes, sweeps and circular
+	// payments. These entries do not affect our earnings.
+	SummaryClassTransfer
+)
+
+// String returns the string representation of a summary class.
+func (s SummaryClass) String() string {
+	switch s {
+	case SummaryClassIncome:
+		return "income"
+
+	case SummaryClassExpense:
+		return "expense"
+
+	default:
+		return "transfer"
+	}
+}
+
+// summaryClass returns the class that an entry type is summarized under.
+func summaryClass(entryType EntryType) SummaryClass {
+	switch entryType {
+	case EntryTypeReceipt, EntryTypeForwardFee:
+		return SummaryClassIncome
+
+	case EntryTypePayment, EntryTypeFee, EntryTypeChannelOpenFee,
+		EntryTypeChannelCloseFee, EntryTypeSweepFee,
+		EntryTypeCircularPaymentFee:
+
+		return SummaryClassExpense
+
+	default:
+		return SummaryClassTransfer
+	}
+}
+
+// SummaryLine aggregates all the entries of a report that share an entry
+// type, custom category and chain.
+type SummaryLine struct {
+	// Type is the type of the entries aggregated.
+	Type EntryType
+
+	// Category is the custom category of the entries aggregated, or the
+	// empty string if they have no custom category.
+	Category string
+
+	// OnChain indicates whether the entries aggregated occurred on chain.
+	OnChain bool
+
+	// Count is the number of entries aggregated.
+	Count int
+
+	// Amount is the net amount of the entries in msat. Credits are
+	// positive and debits are negative. The fees paid by on chain
+	// payments and receipts are excluded from their amounts, because
+	// they are aggregated in fee lines.
+	Amount int64
+
+	// FiatValue is the net fiat value of the entries, signed in the same
+	// way as amount.
+	FiatValue decimal.Decimal
+}
+
+// add aggregates an entry into a summary line.
+func (s *SummaryLine) add(entry *HarmonyEntry) {
+	amt := int64(entry.Amount)
+	fiatValue := entry.FiatValue
+
+	if !entry.Credit {
+		amt *= -1
+		fiatValue = fiatValue.Neg()
+	}
+
+	s.Count++
+	s.Amount += amt
+	s.FiatValue = s.FiatValue.Add(fiatValue)
+}
+
+// removeFee removes an on chain fee from the amount of a summary line that
+// aggregates the transaction that paid it, without changing its count.
+func (s *SummaryLine) removeFee(fee *HarmonyEntry) {
+	// Fees are debits, so we add them back to the line's amount.
+	s.Amount += int64(fee.Amount)
+	s.FiatValue = s.FiatValue.Add(fee.FiatValue)
+}
+
+// SummarySection contains the lines for a single summary class, and their
+// subtotal.
+type SummarySection struct {
+	// Lines contains the lines in the section, sorted by entry type,
+	// category and then chain.
+	Lines []*SummaryLine
+
+	// Count is the total number of entries in the section.
+	Count int
+
+	// Amount is the net amount of the section in msat.
+	Amount int64
+
+	// FiatValue is the net fiat value of the section.
+	FiatValue decimal.Decimal
+}
+
+// Summary is an income statement for a report, which aggregates its entries
+// by type, custom category and chain.
+type Summary struct {
+	// Income contains forwarding fees and receipts.
+	Income SummarySection
+
+	// Expenses contains payments and the fees we paid.
+	Expenses SummarySection
+
+	// Transfers contains entries that moved funds between our own
+	// balances.
+	Transfers SummarySection
+
+	// Currency is the fiat currency that fiat values are expressed in.
+	// This value is empty if the report has no fiat prices.
+	Currency string
+}
+
+// NetAmount returns our net earnings in msat for the period summarized,
+// which is our income less our expenses.
+func (s *Summary) NetAmount() int64 {
+	return s.Income.Amount + s.Expenses.Amount
+}
+
+// NetFiat returns the fiat value of our net earnings for the period
+// summarized.
+func (s *Summary) NetFiat() decimal.Decimal {
+	return s.Income.FiatValue.Add(s.Expenses.FiatValue)
+}
+
+// summaryKey is the key that we aggregate entries on.
+type summaryKey struct {
+	entryType EntryType
+	category  string
+	onChain   bool
+}
+
+// Summarize produces an income statement for a report.
+func Summarize(report Report) *Summary {
+	var (
+		summary = &Summary{}
+		lines   = make(map[summaryKey]*SummaryLine)
+
+		// txLines maps the transaction ids of on chain payments and
+		// receipts to the lines that aggregate them.
+		txLines = make(map[string]*SummaryLine)
+		fees    []*HarmonyEntry
+	)
+
+	for _, entry := range report {
+		if summary.Currency == "" && entry.BTCPrice != nil {
+			summary.Currency = entry.BTCPrice.Currency
+		}
+
+		key := summaryKey{
+			entryType: entry.Type,
+			category:  entry.Category,
+			onChain:   entry.OnChain,
+		}
+
+		line, ok := lines[key]
+		if !ok {
+			line = &SummaryLine{
+				Type:     entry.Type,
+				Category: entry.Category,
+				OnChain:  entry.OnChain,
+			}
+			lines[key] = line
+		}
+
+		line.add(entry)
+
+		if !entry.OnChain || entry.TxID == "" {
+			continue
+		}
+
+		switch entry.Type {
+		case EntryTypePayment, EntryTypeReceipt:
+			txLines[entry.TxID] = line
+
+		case EntryTypeFee:
+			fees = append(fees, entry)
+		}
+	}
+
+	// On chain fee entries are created alongside the payment or receipt
+	// of the transaction that paid them, and that entry's amount already
+	// includes the fee. We remove the fee from the transaction's line so
+	// that it is only counted once. Fees for transactions that have no
+	// other entry, such as utxo management, are left as is.
+	for _, fee := range fees {
+		if line, ok := txLines[fee.TxID]; ok {
+			line.removeFee(fee)
+		}
+	}
+
+	for _, line := range lines {
+		var section *SummarySection
+		switch summaryClass(line.Type) {
+		case SummaryClassIncome:
+			section = &summary.Income
+
+		case SummaryClassExpense:
+			section = &summary.Expenses
+
+		default:
+			section = &summary.Transfers
+		}
+
+		section.Lines = append(section.Lines, line)
+		section.Count += line.Count
+		section.Amount += line.Amount
+		section.FiatValue = section.FiatValue.Add(line.FiatValue)
+	}
+
+	for _, section := range []*SummarySection{
+		&summary.Income, &summary.Expenses, &summary.Transfers,
+	} {
+		sortLines(section.Lines)
+	}
+
+	return summary
+}
+
+// sortLines sorts summary lines by entry type, category and then chain, with
+// off chain lines first.
+func sortLines(lines []*SummaryLine) {
+	sort.Slice(lines, func(i, j int) bool {
+		a, b := lines[i], lines[j]
+
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+
+		if a.Category != b.Category {
+			return a.Category < b.Category
+		}
+
+		return !a.OnChain && b.OnChain
+	})
+}
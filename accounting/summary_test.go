@@ -0,0 +1,158 @@
+package accounting
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/faraday/fiat"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSummarize tests aggregation of a report into an income statement.
+func TestSummarize(t *testing.T) {
+	price := &fiat.Price{
+		Price:    decimal.NewFromInt(10),
+		Currency: "USD",
+	}
+
+	entry := func(entryType EntryType, amt int64, category string,
+		onChain bool) *HarmonyEntry {
+
+		credit := amt >= 0
+		if !credit {
+			amt *= -1
+		}
+
+		return &HarmonyEntry{
+			Amount:    lnwire.MilliSatoshi(amt),
+			FiatValue: decimal.NewFromInt(amt),
+			Type:      entryType,
+			Category:  category,
+			OnChain:   onChain,
+			Credit:    credit,
+			BTCPrice:  price,
+		}
+	}
+
+	report := Report{
+		entry(EntryTypeForwardFee, 10, "", false),
+		entry(EntryTypeForwardFee, 20, "", false),
+		entry(EntryTypeReceipt, 500, "", true),
+		entry(EntryTypeReceipt, 300, "loop", false),
+		entry(EntryTypeReceipt, 200, "", false),
+		entry(EntryTypePayment, -100, "", false),
+		entry(EntryTypeFee, -3, "", false),
+		entry(EntryTypeFee, -7, "", true),
+		entry(EntryTypeChannelOpenFee, -50, "", true),
+		entry(EntryTypeLocalChannelOpen, -1000, "", true),
+		entry(EntryTypeForward, 0, "", false),
+	}
+
+	summary := Summarize(report)
+	require.Equal(t, "USD", summary.Currency)
+
+	lineValues := func(lines []*SummaryLine) []SummaryLine {
+		values := make([]SummaryLine, len(lines))
+		for i, line := range lines {
+			values[i] = *line
+			values[i].FiatValue = decimal.Decimal{}
+			require.Equal(t, line.Amount, line.FiatValue.IntPart())
+		}
+
+		return values
+	}
+
+	require.Equal(t, []SummaryLine{
+		{Type: EntryTypeReceipt, Count: 1, Amount: 200},
+		{Type: EntryTypeReceipt, OnChain: true, Count: 1, Amount: 500},
+		{
+			Type: EntryTypeReceipt, Category: "loop", Count: 1,
+			Amount: 300,
+		},
+		{Type: EntryTypeForwardFee, Count: 2, Amount: 30},
+	}, lineValues(summary.Income.Lines))
+	require.Equal(t, 5, summary.Income.Count)
+	require.EqualValues(t, 1030, summary.Income.Amount)
+
+	require.Equal(t, []SummaryLine{
+		{
+			Type: EntryTypeChannelOpenFee, OnChain: true, Count: 1,
+			Amount: -50,
+		},
+		{Type: EntryTypePayment, Count: 1, Amount: -100},
+		{Type: EntryTypeFee, Count: 1, Amount: -3},
+		{Type: EntryTypeFee, OnChain: true, Count: 1, Amount: -7},
+	}, lineValues(summary.Expenses.Lines))
+	require.Equal(t, 4, summary.Expenses.Count)
+	require.EqualValues(t, -160, summary.Expenses.Amount)
+
+	require.Equal(t, []SummaryLine{
+		{
+			Type: EntryTypeLocalChannelOpen, OnChain: true,
+			Count: 1, Amount: -1000,
+		},
+		{Type: EntryTypeForward, Count: 1},
+	}, lineValues(summary.Transfers.Lines))
+
+	require.EqualValues(t, 870, summary.NetAmount())
+	require.Equal(t, "870", summary.NetFiat().String())
+}
+
+// TestSummarizeOnChainFees tests that the on chain fees paid by payments and
+// receipts are only counted once in an income statement, even though the
+// transactions' amounts include them.
+func TestSummarizeOnChainFees(t *testing.T) {
+	entry := func(entryType EntryType, amt int64,
+		txid string) *HarmonyEntry {
+
+		credit := amt >= 0
+		if !credit {
+			amt *= -1
+		}
+
+		return &HarmonyEntry{
+			Amount:    lnwire.MilliSatoshi(amt),
+			FiatValue: decimal.NewFromInt(amt),
+			TxID:      txid,
+			Type:      entryType,
+			OnChain:   true,
+			Credit:    credit,
+		}
+	}
+
+	// Our payment sent 1000 and paid a fee of 7, our receipt received 502
+	// and paid a fee of 2, and our utxo management transaction only paid
+	// a fee of 5.
+	report := Report{
+		entry(EntryTypePayment, -1007, "a"),
+		entry(EntryTypeFee, -7, "a"),
+		entry(EntryTypeFee, -2, "b"),
+		entry(EntryTypeReceipt, 500, "b"),
+		entry(EntryTypeFee, -5, "c"),
+	}
+
+	summary := Summarize(report)
+
+	require.Len(t, summary.Income.Lines, 1)
+	require.Equal(t, 1, summary.Income.Lines[0].Count)
+	require.EqualValues(t, 502, summary.Income.Amount)
+	require.Equal(t, "502", summary.Income.FiatValue.String())
+
+	require.Len(t, summary.Expenses.Lines, 2)
+	payments, fees := summary.Expenses.Lines[0], summary.Expenses.Lines[1]
+	require.Equal(t, EntryTypePayment, payments.Type)
+	require.Equal(t, 1, payments.Count)
+	require.EqualValues(t, -1000, payments.Amount)
+	require.Equal(t, EntryTypeFee, fees.Type)
+	require.Equal(t, 3, fees.Count)
+	require.EqualValues(t, -14, fees.Amount)
+
+	require.Equal(t, 4, summary.Expenses.Count)
+	require.EqualValues(t, -1014, summary.Expenses.Amount)
+	require.Equal(t, "-1014", summary.Expenses.FiatValue.String())
+
+	// Our net earnings are the change in our on chain balance.
+	require.EqualValues(t, -512, summary.NetAmount())
+	require.Equal(t, "-512", summary.NetFiat().String())
+}
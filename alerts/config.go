@@ -0,0 +1,142 @@
+package alerts
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lightninglabs/faraday/recommend"
+)
+
+const (
+	// DefaultInterval is the default interval at which we evaluate our
+	// alert rules.
+	DefaultInterval = time.Hour
+
+	// DefaultCooldown is the default minimum amount of time between
+	// repeated alerts for the same rule and channel.
+	DefaultCooldown = time.Hour * 24
+
+	// DefaultWindow is the default window that our uptime and revenue
+	// rules are evaluated over.
+	DefaultWindow = time.Hour * 24 * 7
+)
+
+var (
+	// errSMTPAddressRequired is returned if an SMTP server is set without
+	// the addresses to send alerts from and to.
+	errSMTPAddressRequired = errors.New("alerts.smtpfrom and " +
+		"alerts.smtpto required when alerts.smtphost is set")
+
+	// errPositiveDuration is returned if a duration that must be
+	// positive is not.
+	errPositiveDuration = errors.New("duration must be positive")
+)
+
+// Config defines exported config options for our alert rules and the
+// channels that alerts are delivered through.
+type Config struct {
+	Interval          time.Duration `long:"interval" description:"The interval at which alert rules are evaluated. Valid time units are {s, m, h}."`
+	Cooldown          time.Duration `long:"cooldown" description:"The minimum amount of time between repeated alerts for the same rule and channel. Valid time units are {s, m, h}."`
+	MinUptime         float64       `long:"minuptime" description:"Alert when the uptime ratio of a channel's peer over --alerts.uptimewindow is below this value, for example 0.8. Disabled if not set."`
+	UptimeWindow      time.Duration `long:"uptimewindow" description:"The window that peer uptime is evaluated over. Valid time units are {s, m, h}."`
+	NoForwards        time.Duration `long:"noforwards" description:"Alert when a channel has not forwarded any payments for this amount of time, for example 336h. Disabled if not set."`
+	RevenueDrop       float64       `long:"revenuedrop" description:"Alert when our forwarding fees over --alerts.revenuewindow drop by this fraction compared to the previous window, for example 0.5. Disabled if not set."`
+	RevenueWindow     time.Duration `long:"revenuewindow" description:"The window that forwarding fees are compared over. Valid time units are {s, m, h}."`
+	OutlierMetric     string        `long:"outliermetric" description:"Alert when a channel is flagged as a lower outlier for this metric by outlier close recommendations. Disabled if not set." choice:"uptime" choice:"revenue" choice:"incoming_volume" choice:"outgoing_volume" choice:"total_volume"`
+	OutlierMultiplier float64       `long:"outliermultiplier" description:"The number of inter-quartile ranges a channel must lie below the lower quartile to be flagged as an outlier."`
+	Webhook           string        `long:"webhook" description:"A URL that alerts are posted to as JSON."`
+	SMTPHost          string        `long:"smtphost" description:"host:port of an SMTP server that alerts are emailed through."`
+	SMTPUser          string        `long:"smtpuser" description:"The user name used to authenticate with the SMTP server, if required."`
+	SMTPPassword      string        `long:"smtppassword" description:"The password used to authenticate with the SMTP server, if required."`
+	SMTPFrom          string        `long:"smtpfrom" description:"The address that alert emails are sent from."`
+	SMTPTo            []string      `long:"smtpto" description:"An address that alert emails are sent to. May be set multiple times."`
+}
+
+// DefaultConfig is the default config for our alerts, which has no rules
+// enabled.
+var DefaultConfig = &Config{
+	Interval:          DefaultInterval,
+	Cooldown:          DefaultCooldown,
+	UptimeWindow:      DefaultWindow,
+	RevenueWindow:     DefaultWindow,
+	OutlierMultiplier: recommend.DefaultOutlierMultiplier,
+}
+
+// Enabled returns a boolean indicating whether any alert rules are enabled.
+func (c *Config) Enabled() bool {
+	return c.MinUptime != 0 || c.NoForwards != 0 || c.RevenueDrop != 0 ||
+		c.OutlierMetric != ""
+}
+
+// Validate checks that the values set in our config are sane.
+func (c *Config) Validate() error {
+	if !c.Enabled() {
+		return nil
+	}
+
+	durations := []struct {
+		name  string
+		value time.Duration
+	}{
+		{"alerts.interval", c.Interval},
+		{"alerts.uptimewindow", c.UptimeWindow},
+		{"alerts.revenuewindow", c.RevenueWindow},
+	}
+	for _, duration := range durations {
+		if duration.value <= 0 {
+			return fmt.Errorf("%v: %w, got: %v", duration.name,
+				errPositiveDuration, duration.value)
+		}
+	}
+
+	if c.Cooldown < 0 || c.NoForwards < 0 {
+		return fmt.Errorf("alerts.cooldown and alerts.noforwards " +
+			"must not be negative")
+	}
+
+	if c.MinUptime < 0 || c.MinUptime > 1 {
+		return fmt.Errorf("alerts.minuptime must be in [0, 1], got: %v",
+			c.MinUptime)
+	}
+
+	if c.RevenueDrop < 0 || c.RevenueDrop > 1 {
+		return fmt.Errorf("alerts.revenuedrop must be in [0, 1], got: "+
+			"%v", c.RevenueDrop)
+	}
+
+	if c.OutlierMetric != "" && c.OutlierMultiplier <= 0 {
+		return fmt.Errorf("alerts.outliermultiplier must be positive, "+
+			"got: %v", c.OutlierMultiplier)
+	}
+
+	if c.SMTPHost != "" && (c.SMTPFrom == "" || len(c.SMTPTo) == 0) {
+		return errSMTPAddressRequired
+	}
+
+	return nil
+}
+
+// outlierMetric returns the recommendation metric for the outlier metric
+// set in our config.
+func outlierMetric(metric string) (recommend.Metric, error) {
+	switch metric {
+	case "uptime":
+		return recommend.UptimeMetric, nil
+
+	case "revenue":
+		return recommend.RevenueMetric, nil
+
+	case "incoming_volume":
+		return recommend.IncomingVolume, nil
+
+	case "outgoing_volume":
+		return recommend.OutgoingVolume, nil
+
+	case "total_volume":
+		return recommend.Volume, nil
+
+	default:
+		return 0, fmt.Errorf("unknown outlier metric: %v", metric)
+	}
+}
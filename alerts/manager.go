@@ -0,0 +1,226 @@
+package alerts
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lightninglabs/faraday/insights"
+	"github.com/lightninglabs/faraday/revenue"
+	"github.com/lightningnetwork/lnd/subscribe"
+)
+
+// errManagerAlreadyStarted is returned if the alert manager is started more
+// than once.
+var errManagerAlreadyStarted = errors.New("alert manager already started")
+
+// ManagerConfig provides the alert manager with its rules and the data
+// required to evaluate them.
+type ManagerConfig struct {
+	// Rules is the config that sets which rules are enabled.
+	Rules *Config
+
+	// ChannelInsights returns insights for our currently open channels.
+	ChannelInsights func() ([]*insights.ChannelInfo, error)
+
+	// RevenueReport returns a report of the revenue our channels produced
+	// over [start, end).
+	RevenueReport func(start, end time.Time) (*revenue.Report, error)
+
+	// MinimumMonitored is the minimum amount of time that a channel must
+	// be monitored for before it is considered by our outlier rule.
+	MinimumMonitored time.Duration
+
+	// Notifiers are the external services that alerts are delivered to.
+	// Alerts are always delivered to subscribers.
+	Notifiers []Notifier
+}
+
+// Manager periodically evaluates our alert rules, and delivers alerts to our
+// notifiers and subscribers. Alerts are deduplicated by rule and channel, and
+// an alert is not repeated until our cooldown has passed, even if its
+// condition holds the whole time.
+type Manager struct {
+	started int32 // To be used atomically.
+
+	cfg   *ManagerConfig
+	rules []rule
+
+	// lastSent holds the time at which we last delivered an alert for
+	// each rule and channel. It is only accessed by our main goroutine.
+	lastSent map[string]time.Time
+
+	subscribers *subscribe.Server
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewManager returns an alert manager. Note that the manager is not running,
+// and should be started using Start().
+func NewManager(cfg *ManagerConfig) (*Manager, error) {
+	rules, err := rulesFromConfig(cfg.Rules, cfg.MinimumMonitored)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Manager{
+		cfg:         cfg,
+		rules:       rules,
+		lastSent:    make(map[string]time.Time),
+		subscribers: subscribe.NewServer(),
+		quit:        make(chan struct{}),
+	}, nil
+}
+
+// Start starts evaluating our alert rules.
+func (m *Manager) Start() error {
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return errManagerAlreadyStarted
+	}
+
+	if err := m.subscribers.Start(); err != nil {
+		return err
+	}
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		m.run()
+	}()
+
+	return nil
+}
+
+// Stop stops evaluating our rules and waits for the manager to exit. Our
+// subscribers are notified that we have shut down.
+func (m *Manager) Stop() {
+	if atomic.LoadInt32(&m.started) == 0 {
+		return
+	}
+
+	close(m.quit)
+	m.wg.Wait()
+
+	if err := m.subscribers.Stop(); err != nil {
+		log.Errorf("Could not stop alert subscriptions: %v", err)
+	}
+}
+
+// SubscribeAlerts returns a subscription that delivers each alert that we
+// raise from now on as an *Alert. The caller must cancel the subscription
+// once it is no longer required.
+func (m *Manager) SubscribeAlerts() (*subscribe.Client, error) {
+	return m.subscribers.Subscribe()
+}
+
+// run evaluates our rules immediately, and then on each tick of our interval
+// until we are stopped.
+func (m *Manager) run() {
+	ticker := time.NewTicker(m.cfg.Rules.Interval)
+	defer ticker.Stop()
+
+	for {
+		alerts, err := m.evaluate(time.Now())
+		if err != nil {
+			log.Errorf("Could not evaluate alert rules: %v", err)
+		}
+		m.deliver(alerts)
+
+		select {
+		case <-ticker.C:
+
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// evaluate evaluates all of our rules at the time provided and returns the
+// alerts that should be delivered, which excludes duplicates and alerts that
+// are within their cooldown. A failure of one rule does not prevent the
+// evaluation of the others.
+func (m *Manager) evaluate(now time.Time) ([]*Alert, error) {
+	channels, err := m.cfg.ChannelInsights()
+	if err != nil {
+		return nil, err
+	}
+
+	eval := &evaluation{
+		now:           now,
+		channels:      channels,
+		revenueReport: m.cfg.RevenueReport,
+	}
+
+	var (
+		alerts []*Alert
+		raised = make(map[string]bool)
+	)
+	for _, rule := range m.rules {
+		ruleAlerts, err := rule.evaluate(eval)
+		if err != nil {
+			log.Errorf("Could not evaluate %v rule: %v",
+				rule.name(), err)
+
+			continue
+		}
+
+		for _, alert := range ruleAlerts {
+			key := alert.key()
+			if raised[key] {
+				continue
+			}
+			raised[key] = true
+
+			lastSent, ok := m.lastSent[key]
+			if ok && now.Sub(lastSent) < m.cfg.Rules.Cooldown {
+				log.Debugf("Alert %v in cooldown, last sent: "+
+					"%v", key, lastSent)
+
+				continue
+			}
+
+			m.lastSent[key] = now
+			alerts = append(alerts, alert)
+		}
+	}
+
+	// Once an alert's cooldown has passed, we no longer need to track it.
+	for key, lastSent := range m.lastSent {
+		if now.Sub(lastSent) >= m.cfg.Rules.Cooldown {
+			delete(m.lastSent, key)
+		}
+	}
+
+	return alerts, nil
+}
+
+// deliver sends alerts to our subscribers and notifiers. Failures to deliver
+// alerts are logged, because we do not want one failing notifier to prevent
+// delivery to the others.
+func (m *Manager) deliver(alerts []*Alert) {
+	for _, alert := range alerts {
+		log.Infof("Alert raised by %v rule for %v: %v", alert.Rule,
+			alert.ChannelPoint, alert.Message)
+
+		if err := m.subscribers.SendUpdate(alert); err != nil {
+			log.Errorf("Could not send alert to subscribers: %v",
+				err)
+		}
+
+		for _, notifier := range m.cfg.Notifiers {
+			ctx, cancel := context.WithTimeout(
+				context.Background(), notifyTimeout,
+			)
+			err := notifier.Notify(ctx, alert)
+			cancel()
+
+			if err != nil {
+				log.Errorf("Could not deliver alert with %v: "+
+					"%v", notifier.Name(), err)
+			}
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package alerts
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/faraday/insights"
+	"github.com/stretchr/testify/require"
+)
+
+// defaultTimeout is the amount of time we wait for alerts to be delivered to
+// subscribers.
+const defaultTimeout = time.Second * 5
+
+// mockNotifier records the alerts it is notified of, and fails with the error
+// provided.
+type mockNotifier struct {
+	alerts []*Alert
+	err    error
+}
+
+// Name returns a human readable name for the notifier.
+func (m *mockNotifier) Name() string {
+	return "mock"
+}
+
+// Notify records an alert.
+func (m *mockNotifier) Notify(_ context.Context, alert *Alert) error {
+	m.alerts = append(m.alerts, alert)
+	return m.err
+}
+
+// TestManagerCooldown tests that alerts are deduplicated and not repeated
+// within their cooldown.
+func TestManagerCooldown(t *testing.T) {
+	t.Parallel()
+
+	cooldown := time.Hour * 24
+	start := time.Unix(1_000_000, 0)
+
+	// Our channel is always monitored for long enough for our uptime rule
+	// to alert on its lifetime uptime. Our second channel is only
+	// unhealthy at times.
+	channels := []*insights.ChannelInfo{
+		{
+			ChannelPoint: "a:1",
+			MonitoredFor: DefaultWindow * 2,
+		},
+		{
+			ChannelPoint: "b:1",
+			MonitoredFor: DefaultWindow * 2,
+			Uptime:       DefaultWindow * 2,
+		},
+	}
+
+	manager, err := NewManager(&ManagerConfig{
+		Rules: &Config{
+			Cooldown:     cooldown,
+			MinUptime:    0.5,
+			UptimeWindow: DefaultWindow,
+		},
+		ChannelInsights: func() ([]*insights.ChannelInfo, error) {
+			return channels, nil
+		},
+	})
+	require.NoError(t, err)
+
+	alerts, err := manager.evaluate(start)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a:1"}, alertChannels(alerts))
+
+	// Our alert should not be repeated within its cooldown, but our
+	// second channel's new alert should be raised.
+	channels[1].Uptime = 0
+	alerts, err = manager.evaluate(start.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, []string{"b:1"}, alertChannels(alerts))
+
+	// Once the cooldown of our first alert has passed, it is repeated.
+	alerts, err = manager.evaluate(start.Add(cooldown))
+	require.NoError(t, err)
+	require.Equal(t, []string{"a:1"}, alertChannels(alerts))
+	require.Len(t, manager.lastSent, 2)
+
+	// Alerts that are no longer in their cooldown are not tracked.
+	channels = nil
+	alerts, err = manager.evaluate(start.Add(cooldown * 3))
+	require.NoError(t, err)
+	require.Empty(t, alerts)
+	require.Empty(t, manager.lastSent)
+}
+
+// TestManagerDeliver tests delivery of alerts to our subscribers and
+// notifiers.
+func TestManagerDeliver(t *testing.T) {
+	t.Parallel()
+
+	failing := &mockNotifier{err: errors.New("delivery failed")}
+	working := &mockNotifier{}
+
+	manager, err := NewManager(&ManagerConfig{
+		Rules:     &Config{},
+		Notifiers: []Notifier{failing, working},
+	})
+	require.NoError(t, err)
+	require.NoError(t, manager.subscribers.Start())
+	defer func() {
+		require.NoError(t, manager.subscribers.Stop())
+	}()
+
+	subscription, err := manager.SubscribeAlerts()
+	require.NoError(t, err)
+	defer subscription.Cancel()
+
+	alerts := []*Alert{
+		{Rule: RuleRevenueDrop},
+		{Rule: RuleUptime, ChannelPoint: "a:1"},
+	}
+	manager.deliver(alerts)
+
+	// A failure to deliver with one notifier should not prevent delivery
+	// with the others.
+	require.Equal(t, alerts, failing.alerts)
+	require.Equal(t, alerts, working.alerts)
+
+	for _, expected := range alerts {
+		select {
+		case update := <-subscription.Updates():
+			require.Equal(t, expected, update)
+
+		case <-time.After(defaultTimeout):
+			t.Fatalf("alert not delivered to subscriber")
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// notifyTimeout is the maximum amount of time we wait for an alert to be
+// delivered by a webhook.
+const notifyTimeout = time.Second * 30
+
+// Notifier delivers alerts to an external service.
+type Notifier interface {
+	// Name returns a human readable name for the notifier.
+	Name() string
+
+	// Notify delivers an alert.
+	Notify(ctx context.Context, alert *Alert) error
+}
+
+// NewNotifiers returns the notifiers that are configured in our config.
+func NewNotifiers(cfg *Config) []Notifier {
+	var notifiers []Notifier
+
+	if cfg.Webhook != "" {
+		notifiers = append(notifiers, &webhookNotifier{
+			url: cfg.Webhook,
+			client: &http.Client{
+				Timeout: notifyTimeout,
+			},
+		})
+	}
+
+	if cfg.SMTPHost != "" {
+		notifiers = append(notifiers, &smtpNotifier{
+			host:     cfg.SMTPHost,
+			user:     cfg.SMTPUser,
+			password: cfg.SMTPPassword,
+			from:     cfg.SMTPFrom,
+			to:       cfg.SMTPTo,
+			send:     smtp.SendMail,
+		})
+	}
+
+	return notifiers
+}
+
+// webhookNotifier posts alerts to a url as JSON.
+type webhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// Name returns a human readable name for the notifier.
+func (w *webhookNotifier) Name() string {
+	return "webhook"
+}
+
+// Notify posts an alert to our webhook, failing if it does not respond with a
+// success status code.
+func (w *webhookNotifier) Notify(ctx context.Context, alert *Alert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, w.url, bytes.NewReader(body),
+	)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded with status: %v",
+			resp.Status)
+	}
+
+	return nil
+}
+
+// smtpNotifier emails alerts through an SMTP server.
+type smtpNotifier struct {
+	host     string
+	user     string
+	password string
+	from     string
+	to       []string
+
+	// send sends an email, and is set to smtp.SendMail outside of tests.
+	send func(addr string, auth smtp.Auth, from string, to []string,
+		msg []byte) error
+}
+
+// Name returns a human readable name for the notifier.
+func (s *smtpNotifier) Name() string {
+	return "smtp"
+}
+
+// Notify emails an alert to our recipients. We only authenticate with our
+// server if a user is set.
+func (s *smtpNotifier) Notify(_ context.Context, alert *Alert) error {
+	var auth smtp.Auth
+	if s.user != "" {
+		host, _, err := net.SplitHostPort(s.host)
+		if err != nil {
+			return err
+		}
+
+		auth = smtp.PlainAuth("", s.user, s.password, host)
+	}
+
+	return s.send(s.host, auth, s.from, s.to, s.message(alert))
+}
+
+// message formats an alert as an email.
+func (s *smtpNotifier) message(alert *Alert) []byte {
+	subject := fmt.Sprintf("faraday alert: %v", alert.Rule)
+	if alert.ChannelPoint != "" {
+		subject = fmt.Sprintf("%v for %v", subject, alert.ChannelPoint)
+	}
+
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "From: %v\r\n", s.from)
+	fmt.Fprintf(&msg, "To: %v\r\n", strings.Join(s.to, ", "))
+	fmt.Fprintf(&msg, "Subject: %v\r\n", subject)
+	fmt.Fprintf(&msg, "Date: %v\r\n", alert.Timestamp.Format(time.RFC1123Z))
+	fmt.Fprintf(&msg, "Content-Type: text/plain; charset=UTF-8\r\n")
+	fmt.Fprintf(&msg, "\r\n%v\r\n", alert.Message)
+
+	return []byte(msg.String())
+}
@@ -0,0 +1,108 @@
+package alerts
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestWebhookNotifier tests posting alerts to a webhook.
+func TestWebhookNotifier(t *testing.T) {
+	t.Parallel()
+
+	var (
+		received []*Alert
+		status   = http.StatusOK
+	)
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, http.MethodPost, r.Method)
+			require.Equal(
+				t, "application/json",
+				r.Header.Get("Content-Type"),
+			)
+
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+
+			alert := &Alert{}
+			require.NoError(t, json.Unmarshal(body, alert))
+			received = append(received, alert)
+
+			w.WriteHeader(status)
+		},
+	))
+	defer server.Close()
+
+	notifiers := NewNotifiers(&Config{
+		Webhook: server.URL,
+	})
+	require.Len(t, notifiers, 1)
+
+	alert := &Alert{
+		Rule:         RuleNoForwards,
+		ChannelPoint: "a:1",
+		Message:      "no forwards in 14 days",
+		Timestamp:    time.Unix(1_000_000, 0).UTC(),
+	}
+
+	ctx := context.Background()
+	require.NoError(t, notifiers[0].Notify(ctx, alert))
+	require.Equal(t, []*Alert{alert}, received)
+
+	// We should fail if our webhook does not accept our alert.
+	status = http.StatusInternalServerError
+	require.Error(t, notifiers[0].Notify(ctx, alert))
+}
+
+// TestSMTPNotifier tests emailing alerts.
+func TestSMTPNotifier(t *testing.T) {
+	t.Parallel()
+
+	notifiers := NewNotifiers(&Config{
+		SMTPHost:     "smtp.example.com:587",
+		SMTPUser:     "user",
+		SMTPPassword: "password",
+		SMTPFrom:     "faraday@example.com",
+		SMTPTo:       []string{"a@example.com", "b@example.com"},
+	})
+	require.Len(t, notifiers, 1)
+
+	notifier := notifiers[0].(*smtpNotifier)
+
+	var sent string
+	notifier.send = func(addr string, auth smtp.Auth, from string,
+		to []string, msg []byte) error {
+
+		require.Equal(t, "smtp.example.com:587", addr)
+		require.NotNil(t, auth)
+		require.Equal(t, "faraday@example.com", from)
+		require.Equal(t, []string{"a@example.com", "b@example.com"}, to)
+
+		sent = string(msg)
+
+		return nil
+	}
+
+	alert := &Alert{
+		Rule:         RuleUptime,
+		ChannelPoint: "a:1",
+		Message:      "peer uptime of 50.0% over 7 days is below 80.0%",
+		Timestamp:    time.Unix(1_000_000, 0),
+	}
+	require.NoError(t, notifier.Notify(context.Background(), alert))
+
+	require.Contains(t, sent, "To: a@example.com, b@example.com\r\n")
+	require.Contains(t, sent, "Subject: faraday alert: uptime for a:1\r\n")
+	require.True(t, strings.HasSuffix(
+		sent, "\r\n\r\n"+alert.Message+"\r\n",
+	))
+}
@@ -0,0 +1,406 @@
+package alerts
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lightninglabs/faraday/dataset"
+	"github.com/lightninglabs/faraday/insights"
+	"github.com/lightninglabs/faraday/recommend"
+	"github.com/lightninglabs/faraday/revenue"
+)
+
+const (
+	// RuleUptime is the name of the rule that alerts when a channel's peer
+	// has low uptime.
+	RuleUptime = "uptime"
+
+	// RuleNoForwards is the name of the rule that alerts when a channel
+	// has not forwarded any payments.
+	RuleNoForwards = "no_forwards"
+
+	// RuleRevenueDrop is the name of the rule that alerts when our
+	// forwarding fees drop.
+	RuleRevenueDrop = "revenue_drop"
+
+	// RuleOutlier is the name of the rule that alerts when a channel is
+	// flagged as an outlier by our close recommendations.
+	RuleOutlier = "outlier"
+)
+
+// Alert is raised when the condition of one of our rules holds.
+type Alert struct {
+	// Rule is the name of the rule that raised the alert.
+	Rule string `json:"rule"`
+
+	// ChannelPoint is the channel that the alert is for. It is empty for
+	// alerts about our node as a whole.
+	ChannelPoint string `json:"chan_point,omitempty"`
+
+	// Message is a human readable description of the alert.
+	Message string `json:"message"`
+
+	// Timestamp is the time at which the alert was raised.
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// key returns the key that we deduplicate alerts by.
+func (a *Alert) key() string {
+	return a.Rule + "/" + a.ChannelPoint
+}
+
+// evaluation contains the data that our rules are evaluated against.
+type evaluation struct {
+	// now is the time of our evaluation.
+	now time.Time
+
+	// channels contains insights for our currently open channels.
+	channels []*insights.ChannelInfo
+
+	// revenueReport returns a report of our revenue over [start, end).
+	revenueReport func(start, end time.Time) (*revenue.Report, error)
+}
+
+// rule is a condition that we alert on.
+type rule interface {
+	// name returns the name of the rule.
+	name() string
+
+	// evaluate returns an alert for each channel that our condition holds
+	// for, or a single alert for rules about our node as a whole.
+	evaluate(eval *evaluation) ([]*Alert, error)
+}
+
+// rulesFromConfig returns the rules enabled in our config.
+func rulesFromConfig(cfg *Config, minimumMonitored time.Duration) ([]rule,
+	error) {
+
+	var rules []rule
+
+	if cfg.MinUptime != 0 {
+		rules = append(rules, &uptimeRule{
+			minUptime: cfg.MinUptime,
+			window:    cfg.UptimeWindow,
+			samples:   make(map[string][]uptimeSample),
+		})
+	}
+
+	if cfg.NoForwards != 0 {
+		rules = append(rules, &noForwardsRule{
+			window: cfg.NoForwards,
+		})
+	}
+
+	if cfg.RevenueDrop != 0 {
+		rules = append(rules, &revenueDropRule{
+			drop:   cfg.RevenueDrop,
+			window: cfg.RevenueWindow,
+		})
+	}
+
+	if cfg.OutlierMetric != "" {
+		metric, err := outlierMetric(cfg.OutlierMetric)
+		if err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, &outlierRule{
+			metricName:       cfg.OutlierMetric,
+			metric:           metric,
+			multiplier:       cfg.OutlierMultiplier,
+			minimumMonitored: minimumMonitored,
+		})
+	}
+
+	return rules, nil
+}
+
+// uptimeSample records the uptime that lnd reported for a channel at a
+// point in time.
+type uptimeSample struct {
+	timestamp time.Time
+	uptime    time.Duration
+	monitored time.Duration
+}
+
+// uptimeRule alerts when the uptime ratio of a channel's peer over our window
+// is below our minimum. Since lnd only reports uptime over the lifetime of a
+// channel, we sample the uptime of our channels each time we are evaluated
+// and calculate uptime over our window from the difference between samples.
+type uptimeRule struct {
+	minUptime float64
+	window    time.Duration
+
+	// samples holds the samples that cover our window for each of our
+	// channels, ordered by ascending timestamp.
+	samples map[string][]uptimeSample
+}
+
+// name returns the name of the rule.
+func (u *uptimeRule) name() string {
+	return RuleUptime
+}
+
+// evaluate records an uptime sample for each of our channels and alerts for
+// channels that have low uptime over our window.
+func (u *uptimeRule) evaluate(eval *evaluation) ([]*Alert, error) {
+	var (
+		alerts  []*Alert
+		cutoff  = eval.now.Add(-u.window)
+		samples = make(map[string][]uptimeSample, len(eval.channels))
+	)
+
+	// We rebuild our samples so that we stop tracking closed channels.
+	for _, channel := range eval.channels {
+		channelSamples := append(
+			u.samples[channel.ChannelPoint], uptimeSample{
+				timestamp: eval.now,
+				uptime:    channel.Uptime,
+				monitored: channel.MonitoredFor,
+			},
+		)
+		channelSamples = pruneSamples(channelSamples, cutoff)
+		samples[channel.ChannelPoint] = channelSamples
+
+		ratio, ok := windowUptime(channelSamples, cutoff, u.window)
+		if !ok || ratio >= u.minUptime {
+			continue
+		}
+
+		alerts = append(alerts, &Alert{
+			Rule:         RuleUptime,
+			ChannelPoint: channel.ChannelPoint,
+			Message: fmt.Sprintf("peer uptime of %.1f%% over %v "+
+				"is below %.1f%%", ratio*100,
+				formatWindow(u.window), u.minUptime*100),
+			Timestamp: eval.now,
+		})
+	}
+
+	u.samples = samples
+
+	return alerts, nil
+}
+
+// pruneSamples removes the samples that we no longer need to calculate uptime
+// over a window starting at the cutoff provided. We keep the most recent
+// sample at or before the cutoff as the start of our window.
+func pruneSamples(samples []uptimeSample, cutoff time.Time) []uptimeSample {
+	start := 0
+	for i, sample := range samples {
+		if sample.timestamp.After(cutoff) {
+			break
+		}
+
+		start = i
+	}
+
+	return samples[start:]
+}
+
+// windowUptime returns the uptime ratio of a channel over a window starting
+// at the cutoff provided. If we have sampled the channel for the full window,
+// we use the difference between our first and last samples. Otherwise, we
+// use the channel's lifetime uptime ratio, provided that lnd has monitored
+// the channel for at least the length of our window. The boolean returned is
+// false if we cannot calculate an uptime ratio for the window.
+func windowUptime(samples []uptimeSample, cutoff time.Time,
+	window time.Duration) (float64, bool) {
+
+	if len(samples) == 0 {
+		return 0, false
+	}
+
+	first, last := samples[0], samples[len(samples)-1]
+
+	// lnd's uptime monitoring restarts when lnd restarts, so we can only
+	// use our samples if monitoring has not been reset since our first
+	// sample.
+	monitored := last.monitored - first.monitored
+	uptime := last.uptime - first.uptime
+	if !first.timestamp.After(cutoff) && monitored > 0 && uptime >= 0 {
+		return uptime.Seconds() / monitored.Seconds(), true
+	}
+
+	if last.monitored < window {
+		return 0, false
+	}
+
+	return last.uptime.Seconds() / last.monitored.Seconds(), true
+}
+
+// noForwardsRule alerts when a channel has not forwarded any payments within
+// our window. Channels that lnd has monitored for less than our window are
+// not considered, so that we do not alert for new channels.
+type noForwardsRule struct {
+	window time.Duration
+}
+
+// name returns the name of the rule.
+func (n *noForwardsRule) name() string {
+	return RuleNoForwards
+}
+
+// evaluate alerts for channels that have not forwarded any payments within
+// our window.
+func (n *noForwardsRule) evaluate(eval *evaluation) ([]*Alert, error) {
+	report, err := eval.revenueReport(eval.now.Add(-n.window), eval.now)
+	if err != nil {
+		return nil, err
+	}
+
+	var alerts []*Alert
+	for _, channel := range eval.channels {
+		if channel.MonitoredFor < n.window {
+			continue
+		}
+
+		if forwarded(report, channel.ChannelPoint) {
+			continue
+		}
+
+		alerts = append(alerts, &Alert{
+			Rule:         RuleNoForwards,
+			ChannelPoint: channel.ChannelPoint,
+			Message: fmt.Sprintf("no forwards in %v",
+				formatWindow(n.window)),
+			Timestamp: eval.now,
+		})
+	}
+
+	return alerts, nil
+}
+
+// forwarded returns a boolean indicating whether a channel was part of any
+// forwards in a revenue report.
+func forwarded(report *revenue.Report, channel string) bool {
+	for _, rev := range report.ChannelPairs[channel] {
+		if rev.AmountIncoming != 0 || rev.AmountOutgoing != 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// revenueDropRule alerts when the forwarding fees that our node has earned
+// over our window have dropped by at least our drop fraction compared to the
+// window before it.
+type revenueDropRule struct {
+	drop   float64
+	window time.Duration
+}
+
+// name returns the name of the rule.
+func (r *revenueDropRule) name() string {
+	return RuleRevenueDrop
+}
+
+// evaluate compares our fees for our current and previous windows.
+func (r *revenueDropRule) evaluate(eval *evaluation) ([]*Alert, error) {
+	start := eval.now.Add(-r.window)
+
+	current, err := eval.revenueReport(start, eval.now)
+	if err != nil {
+		return nil, err
+	}
+
+	previous, err := eval.revenueReport(start.Add(-r.window), start)
+	if err != nil {
+		return nil, err
+	}
+
+	currentFees, _ := current.Totals()
+	previousFees, _ := previous.Totals()
+
+	// We cannot calculate a drop if we earned nothing previously.
+	if previousFees == 0 || currentFees >= previousFees {
+		return nil, nil
+	}
+
+	drop := 1 - float64(currentFees)/float64(previousFees)
+	if drop < r.drop {
+		return nil, nil
+	}
+
+	return []*Alert{
+		{
+			Rule: RuleRevenueDrop,
+			Message: fmt.Sprintf("forwarding fees of %v over the "+
+				"last %v dropped %.1f%% from %v over the "+
+				"previous %v", currentFees,
+				formatWindow(r.window), drop*100, previousFees,
+				formatWindow(r.window)),
+			Timestamp: eval.now,
+		},
+	}, nil
+}
+
+// outlierRule alerts when a channel is flagged as a lower outlier by our
+// outlier close recommendations.
+type outlierRule struct {
+	metricName       string
+	metric           recommend.Metric
+	multiplier       float64
+	minimumMonitored time.Duration
+}
+
+// name returns the name of the rule.
+func (o *outlierRule) name() string {
+	return RuleOutlier
+}
+
+// evaluate gets outlier recommendations for our channels and alerts for
+// those that are recommended for close.
+func (o *outlierRule) evaluate(eval *evaluation) ([]*Alert, error) {
+	report, err := recommend.OutlierRecommendations(
+		&recommend.CloseRecommendationConfig{
+			ChannelInsights: func() ([]*insights.ChannelInfo,
+				error) {
+
+				return eval.channels, nil
+			},
+			Metric:           o.metric,
+			MinimumMonitored: o.minimumMonitored,
+		}, &dataset.OutlierConfig{
+			Method:     dataset.IQRMethod,
+			Multiplier: o.multiplier,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var alerts []*Alert
+	for _, channel := range eval.channels {
+		rec, ok := report.Recommendations[channel.ChannelPoint]
+		if !ok || !rec.RecommendClose {
+			continue
+		}
+
+		alerts = append(alerts, &Alert{
+			Rule:         RuleOutlier,
+			ChannelPoint: channel.ChannelPoint,
+			Message: fmt.Sprintf("flagged as a %v outlier with "+
+				"value %.4f", o.metricName, rec.Value),
+			Timestamp: eval.now,
+		})
+	}
+
+	return alerts, nil
+}
+
+// formatWindow formats a window in days if it is a whole number of days.
+func formatWindow(window time.Duration) string {
+	day := time.Hour * 24
+	if window%day != 0 {
+		return window.String()
+	}
+
+	days := int64(window / day)
+	if days == 1 {
+		return "1 day"
+	}
+
+	return fmt.Sprintf("%v days", days)
+}
@@ -0,0 +1,270 @@
+package alerts
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/faraday/insights"
+	"github.com/lightninglabs/faraday/recommend"
+	"github.com/lightninglabs/faraday/revenue"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// alertChannels returns the channel points of the alerts provided.
+func alertChannels(alerts []*Alert) []string {
+	var channels []string
+	for _, alert := range alerts {
+		channels = append(channels, alert.ChannelPoint)
+	}
+
+	return channels
+}
+
+// TestUptimeRule tests calculating uptime over our window from our samples,
+// and falling back to lifetime uptime before we have sampled a full window.
+func TestUptimeRule(t *testing.T) {
+	t.Parallel()
+
+	start := time.Unix(1_000_000, 0)
+	window := time.Hour * 10
+
+	rule := &uptimeRule{
+		minUptime: 0.8,
+		window:    window,
+		samples:   make(map[string][]uptimeSample),
+	}
+
+	// evaluate evaluates our rule for a single channel with the uptime and
+	// monitored time provided, at an offset from our start time.
+	evaluate := func(offset, uptime, monitored time.Duration) []*Alert {
+		alerts, err := rule.evaluate(&evaluation{
+			now: start.Add(offset),
+			channels: []*insights.ChannelInfo{
+				{
+					ChannelPoint: "a:1",
+					Uptime:       uptime,
+					MonitoredFor: monitored,
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		return alerts
+	}
+
+	// Our channel has not been monitored for our window, so we do not
+	// have enough data to alert on its low lifetime uptime.
+	require.Empty(t, evaluate(0, time.Hour, time.Hour*5))
+
+	// Once lnd has monitored the channel for our window, we use its
+	// lifetime uptime until we have sampled it for the full window.
+	require.Len(t, evaluate(time.Hour*5, time.Hour*6, time.Hour*10), 1)
+
+	// Once we have sampled the channel for our full window, we use the
+	// uptime since our first sample. Over the last 10 hours the peer has
+	// been online for 9 hours, so we do not alert, even though the
+	// channel's lifetime uptime is below our minimum.
+	require.Empty(t, evaluate(time.Hour*10, time.Hour*10, time.Hour*15))
+	require.Len(t, rule.samples["a:1"], 3)
+
+	// Our first sample is no longer required once a later sample covers
+	// our window. Over the last 10 hours the peer has been online for 6,
+	// so we alert.
+	alerts := evaluate(time.Hour*15, time.Hour*12, time.Hour*20)
+	require.Len(t, alerts, 1)
+	require.Equal(t, RuleUptime, alerts[0].Rule)
+	require.Equal(t, "a:1", alerts[0].ChannelPoint)
+	require.Len(t, rule.samples["a:1"], 3)
+	require.True(t, rule.samples["a:1"][0].timestamp.Equal(
+		start.Add(time.Hour*5),
+	))
+
+	// If lnd restarts, its monitoring is reset, so we fall back to
+	// lifetime uptime for the channel, which is sufficient.
+	require.Empty(t, evaluate(time.Hour*26, time.Hour*10, time.Hour*11))
+
+	// Closed channels are no longer tracked.
+	_, err := rule.evaluate(&evaluation{now: start.Add(time.Hour * 30)})
+	require.NoError(t, err)
+	require.Empty(t, rule.samples)
+}
+
+// TestNoForwardsRule tests alerting for channels that have not forwarded any
+// payments over our window.
+func TestNoForwardsRule(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_000_000, 0)
+	window := time.Hour * 24
+
+	channels := []*insights.ChannelInfo{
+		{
+			ChannelPoint: "forwarded:1",
+			MonitoredFor: window,
+		},
+		{
+			ChannelPoint: "idle:1",
+			MonitoredFor: window,
+		},
+		{
+			ChannelPoint: "new:1",
+			MonitoredFor: time.Hour,
+		},
+	}
+
+	report := &revenue.Report{
+		ChannelPairs: map[string]map[string]revenue.Revenue{
+			"forwarded:1": {
+				"other:1": {AmountIncoming: 1000},
+			},
+		},
+	}
+
+	rule := &noForwardsRule{window: window}
+	alerts, err := rule.evaluate(&evaluation{
+		now:      now,
+		channels: channels,
+		revenueReport: func(start, end time.Time) (*revenue.Report,
+			error) {
+
+			require.True(t, start.Equal(now.Add(-window)))
+			require.True(t, end.Equal(now))
+
+			return report, nil
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"idle:1"}, alertChannels(alerts))
+	require.Equal(t, "no forwards in 1 day", alerts[0].Message)
+}
+
+// TestRevenueDropRule tests comparing our fees over our current and previous
+// windows.
+func TestRevenueDropRule(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_000_000, 0)
+	window := time.Hour * 24 * 7
+	errReport := errors.New("report failed")
+
+	tests := []struct {
+		name         string
+		previousFees int64
+		currentFees  int64
+		err          error
+		alert        bool
+	}{
+		{
+			name:         "no previous fees",
+			previousFees: 0,
+			currentFees:  0,
+		},
+		{
+			name:         "fees increased",
+			previousFees: 100,
+			currentFees:  200,
+		},
+		{
+			name:         "small drop",
+			previousFees: 100,
+			currentFees:  60,
+		},
+		{
+			name:         "drop at threshold",
+			previousFees: 100,
+			currentFees:  50,
+			alert:        true,
+		},
+		{
+			name:         "no current fees",
+			previousFees: 100,
+			alert:        true,
+		},
+		{
+			name: "report fails",
+			err:  errReport,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			rule := &revenueDropRule{
+				drop:   0.5,
+				window: window,
+			}
+
+			alerts, err := rule.evaluate(&evaluation{
+				now: now,
+				revenueReport: func(start, _ time.Time) (
+					*revenue.Report, error) {
+
+					if test.err != nil {
+						return nil, test.err
+					}
+
+					fee := test.currentFees
+					if start.Before(now.Add(-window)) {
+						fee = test.previousFees
+					}
+
+					return feeReport(fee), nil
+				},
+			})
+			require.ErrorIs(t, err, test.err)
+			require.Equal(t, test.alert, len(alerts) == 1)
+		})
+	}
+}
+
+// feeReport returns a revenue report with a single forward that earned the
+// fee provided.
+func feeReport(fee int64) *revenue.Report {
+	return &revenue.Report{
+		Unattributed: []revenue.UnattributedForward{
+			{
+				AmountIn:  1_000_000,
+				AmountOut: 1_000_000 - lnwire.MilliSatoshi(fee),
+			},
+		},
+	}
+}
+
+// TestOutlierRule tests alerting for channels that are flagged as outliers.
+func TestOutlierRule(t *testing.T) {
+	t.Parallel()
+
+	var channels []*insights.ChannelInfo
+	for i, uptime := range []time.Duration{10, 10, 10, 10, 9, 9, 1} {
+		channels = append(channels, &insights.ChannelInfo{
+			ChannelPoint: string(rune('a'+i)) + ":1",
+			MonitoredFor: 10,
+			Uptime:       uptime,
+		})
+	}
+
+	rule := &outlierRule{
+		metricName:       "uptime",
+		metric:           recommend.UptimeMetric,
+		multiplier:       recommend.DefaultOutlierMultiplier,
+		minimumMonitored: 1,
+	}
+
+	alerts, err := rule.evaluate(&evaluation{channels: channels})
+	require.NoError(t, err)
+	require.Equal(t, []string{"g:1"}, alertChannels(alerts))
+}
+
+// TestFormatWindow tests formatting of rule windows.
+func TestFormatWindow(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "1 day", formatWindow(time.Hour*24))
+	require.Equal(t, "14 days", formatWindow(time.Hour*24*14))
+	require.Equal(t, "36h0m0s", formatWindow(time.Hour*36))
+}
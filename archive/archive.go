@@ -0,0 +1,94 @@
+// Package archive records the invoices, payments and forwards that lnd
+// provides, so that audits remain complete after lnd has deleted payments or
+// compacted its forwarding log.
+package archive
+
+import (
+	"sort"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+// MergeInvoices merges archived invoices into a set of live invoices. Live
+// invoices are preferred, since they have lnd's latest state, so archived
+// invoices are only included if lnd no longer has them. The merged set is
+// sorted by add index.
+func MergeInvoices(live,
+	archived []lndclient.Invoice) []lndclient.Invoice {
+
+	known := make(map[lntypes.Hash]bool, len(live))
+	for _, invoice := range live {
+		known[invoice.Hash] = true
+	}
+
+	merged := append([]lndclient.Invoice{}, live...)
+	for _, invoice := range archived {
+		if known[invoice.Hash] {
+			continue
+		}
+
+		merged = append(merged, invoice)
+	}
+
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].AddIndex < merged[j].AddIndex
+	})
+
+	return merged
+}
+
+// MergePayments merges archived payments into a set of live payments. Live
+// payments are preferred, since they have lnd's latest state, so archived
+// payments are only included if lnd no longer has them. The merged set is
+// sorted by sequence number.
+func MergePayments(live,
+	archived []lndclient.Payment) []lndclient.Payment {
+
+	known := make(map[lntypes.Hash]bool, len(live))
+	for _, payment := range live {
+		known[payment.Hash] = true
+	}
+
+	merged := append([]lndclient.Payment{}, live...)
+	for _, payment := range archived {
+		if known[payment.Hash] {
+			continue
+		}
+
+		merged = append(merged, payment)
+	}
+
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].SequenceNumber < merged[j].SequenceNumber
+	})
+
+	return merged
+}
+
+// MergeForwards merges archived forwards into a set of live forwards,
+// including archived forwards that lnd no longer has. The merged set is
+// sorted by timestamp.
+func MergeForwards(live,
+	archived []lndclient.ForwardingEvent) []lndclient.ForwardingEvent {
+
+	known := make(map[string]bool, len(live))
+	for _, forward := range live {
+		known[string(forwardKey(forward))] = true
+	}
+
+	merged := append([]lndclient.ForwardingEvent{}, live...)
+	for _, forward := range archived {
+		if known[string(forwardKey(forward))] {
+			continue
+		}
+
+		merged = append(merged, forward)
+	}
+
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].Timestamp.Before(merged[j].Timestamp)
+	})
+
+	return merged
+}
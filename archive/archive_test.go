@@ -0,0 +1,118 @@
+package archive
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	invoicespkg "github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMergeInvoices tests merging of archived invoices with live invoices.
+func TestMergeInvoices(t *testing.T) {
+	var (
+		open = lndclient.Invoice{
+			Hash:     lntypes.Hash{1},
+			AddIndex: 2,
+			State:    invoicespkg.ContractOpen,
+		}
+		settled = lndclient.Invoice{
+			Hash:     lntypes.Hash{1},
+			AddIndex: 2,
+			State:    invoicespkg.ContractSettled,
+		}
+		deleted = lndclient.Invoice{
+			Hash:     lntypes.Hash{2},
+			AddIndex: 1,
+		}
+	)
+
+	tests := []struct {
+		name     string
+		live     []lndclient.Invoice
+		archived []lndclient.Invoice
+		expected []lndclient.Invoice
+	}{
+		{
+			name:     "no archive",
+			live:     []lndclient.Invoice{settled},
+			expected: []lndclient.Invoice{settled},
+		},
+		{
+			name:     "live state preferred",
+			live:     []lndclient.Invoice{settled},
+			archived: []lndclient.Invoice{open},
+			expected: []lndclient.Invoice{settled},
+		},
+		{
+			name:     "deleted invoice included in order",
+			live:     []lndclient.Invoice{settled},
+			archived: []lndclient.Invoice{open, deleted},
+			expected: []lndclient.Invoice{deleted, settled},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			merged := MergeInvoices(test.live, test.archived)
+			require.Equal(t, test.expected, merged)
+		})
+	}
+}
+
+// TestMergePayments tests merging of archived payments with live payments.
+func TestMergePayments(t *testing.T) {
+	var (
+		live = lndclient.Payment{
+			Hash:           lntypes.Hash{1},
+			SequenceNumber: 2,
+			Fee:            10,
+		}
+		stale = lndclient.Payment{
+			Hash:           lntypes.Hash{1},
+			SequenceNumber: 2,
+		}
+		deleted = lndclient.Payment{
+			Hash:           lntypes.Hash{2},
+			SequenceNumber: 1,
+		}
+	)
+
+	merged := MergePayments(
+		[]lndclient.Payment{live},
+		[]lndclient.Payment{stale, deleted},
+	)
+	require.Equal(t, []lndclient.Payment{deleted, live}, merged)
+}
+
+// TestMergeForwards tests merging of archived forwards with live forwards.
+func TestMergeForwards(t *testing.T) {
+	start := time.Unix(1_600_000_000, 0)
+
+	var (
+		compacted = lndclient.ForwardingEvent{
+			Timestamp:  start,
+			ChannelIn:  1,
+			ChannelOut: 2,
+		}
+		current = lndclient.ForwardingEvent{
+			Timestamp:  start.Add(time.Hour),
+			ChannelIn:  1,
+			ChannelOut: 2,
+		}
+	)
+
+	merged := MergeForwards(
+		[]lndclient.ForwardingEvent{current},
+		[]lndclient.ForwardingEvent{compacted, current},
+	)
+	require.Equal(
+		t, []lndclient.ForwardingEvent{compacted, current}, merged,
+	)
+}
@@ -0,0 +1,259 @@
+package archive
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	invoicespkg "github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+// errArchiverAlreadyStarted is returned if the archiver is started more than
+// once.
+var errArchiverAlreadyStarted = errors.New("archiver already started")
+
+// defaultInterval is the default interval at which we archive lnd's data.
+const defaultInterval = time.Hour
+
+// ArchiverConfig provides the functions required to archive lnd's data.
+type ArchiverConfig struct {
+	// ListInvoices returns lnd's invoices with an add index after the
+	// offset provided.
+	ListInvoices func(ctx context.Context, offset uint64) (
+		[]lndclient.Invoice, error)
+
+	// ListPayments returns lnd's payments, including incomplete payments,
+	// with a payment index after the offset provided.
+	ListPayments func(ctx context.Context, offset uint64) (
+		[]lndclient.Payment, error)
+
+	// ListForwards returns lnd's forwards from the start time provided
+	// until the present.
+	ListForwards func(ctx context.Context, startTime time.Time) (
+		[]lndclient.ForwardingEvent, error)
+
+	// Store is the archive that we persist data in.
+	Store *Store
+
+	// Interval is the interval at which we archive lnd's data. If this
+	// value is not set, a default of one hour is used.
+	Interval time.Duration
+}
+
+// Archiver periodically copies lnd's invoices, payments and forwards into
+// our archive.
+type Archiver struct {
+	started int32 // To be used atomically.
+
+	cfg *ArchiverConfig
+
+	// ctx is the context for our queries to lnd, which is cancelled when
+	// we are stopped.
+	ctx    context.Context
+	cancel func()
+
+	wg sync.WaitGroup
+}
+
+// NewArchiver returns an archiver. Note that the archiver is not running,
+// and should be started using Start().
+func NewArchiver(cfg *ArchiverConfig) *Archiver {
+	if cfg.Interval == 0 {
+		cfg.Interval = defaultInterval
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &Archiver{
+		cfg:    cfg,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Start starts archiving lnd's data.
+func (a *Archiver) Start() error {
+	if !atomic.CompareAndSwapInt32(&a.started, 0, 1) {
+		return errArchiverAlreadyStarted
+	}
+
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+		a.run()
+	}()
+
+	return nil
+}
+
+// Stop stops the archiver and waits for it to exit.
+func (a *Archiver) Stop() {
+	if atomic.LoadInt32(&a.started) == 0 {
+		return
+	}
+
+	a.cancel()
+	a.wg.Wait()
+}
+
+// run archives lnd's data immediately, and then on each tick of our interval
+// until we are stopped.
+func (a *Archiver) run() {
+	ticker := time.NewTicker(a.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := a.archive(); err != nil {
+			log.Errorf("Could not archive lnd data: %v", err)
+		}
+
+		select {
+		case <-ticker.C:
+
+		case <-a.ctx.Done():
+			return
+		}
+	}
+}
+
+// archive copies lnd's invoices, payments and forwards into our archive.
+// Audits only include settled invoices and succeeded payments, which do not
+// change once they have reached that state, so we only archive invoices and
+// payments once they have been resolved. We record the index that we have
+// resolved all invoices and payments up to, so that we only query lnd for
+// newer items. Forwards do not change once they have been recorded, so we
+// only query forwards from the timestamp of the latest forward that we have
+// archived.
+func (a *Archiver) archive() error {
+	invoices, err := a.archiveInvoices()
+	if err != nil {
+		return err
+	}
+
+	payments, err := a.archivePayments()
+	if err != nil {
+		return err
+	}
+
+	latest, err := a.cfg.Store.LatestForward()
+	if err != nil {
+		return err
+	}
+
+	forwards, err := a.cfg.ListForwards(a.ctx, latest)
+	if err != nil {
+		return err
+	}
+
+	if err := a.cfg.Store.AddForwards(forwards); err != nil {
+		return err
+	}
+
+	log.Debugf("Archived %v invoices, %v payments and %v forwards",
+		invoices, payments, len(forwards))
+
+	return nil
+}
+
+// archiveInvoices archives the invoices that lnd has settled since our last
+// archive, returning the number of invoices archived.
+func (a *Archiver) archiveInvoices() (int, error) {
+	index, err := a.cfg.Store.InvoiceIndex()
+	if err != nil {
+		return 0, err
+	}
+
+	invoices, err := a.cfg.ListInvoices(a.ctx, index)
+	if err != nil {
+		return 0, err
+	}
+
+	// Invoices are returned in ascending add index order. We advance our
+	// index until we reach the first invoice that is not yet settled or
+	// cancelled, so that we query it again in our next archive.
+	var (
+		settled  []lndclient.Invoice
+		resolved = true
+	)
+	for _, invoice := range invoices {
+		switch invoice.State {
+		case invoicespkg.ContractSettled:
+			settled = append(settled, invoice)
+
+		case invoicespkg.ContractCanceled:
+
+		default:
+			resolved = false
+		}
+
+		if resolved {
+			index = invoice.AddIndex
+		}
+	}
+
+	if err := a.cfg.Store.AddInvoices(settled); err != nil {
+		return 0, err
+	}
+
+	if err := a.cfg.Store.SetInvoiceIndex(index); err != nil {
+		return 0, err
+	}
+
+	return len(settled), nil
+}
+
+// archivePayments archives the payments that have succeeded since our last
+// archive, returning the number of payments archived.
+func (a *Archiver) archivePayments() (int, error) {
+	index, err := a.cfg.Store.PaymentIndex()
+	if err != nil {
+		return 0, err
+	}
+
+	payments, err := a.cfg.ListPayments(a.ctx, index)
+	if err != nil {
+		return 0, err
+	}
+
+	// Payments are returned in ascending payment index order. We advance
+	// our index until we reach the first payment that has not yet
+	// succeeded or failed, so that we query it again in our next archive.
+	var (
+		succeeded []lndclient.Payment
+		resolved  = true
+	)
+	for _, payment := range payments {
+		var state lnrpc.Payment_PaymentStatus
+		if payment.Status != nil {
+			state = payment.Status.State
+		}
+
+		switch state {
+		case lnrpc.Payment_SUCCEEDED:
+			succeeded = append(succeeded, payment)
+
+		case lnrpc.Payment_FAILED:
+
+		default:
+			resolved = false
+		}
+
+		if resolved {
+			index = payment.SequenceNumber
+		}
+	}
+
+	if err := a.cfg.Store.AddPayments(succeeded); err != nil {
+		return 0, err
+	}
+
+	if err := a.cfg.Store.SetPaymentIndex(index); err != nil {
+		return 0, err
+	}
+
+	return len(succeeded), nil
+}
@@ -0,0 +1,190 @@
+package archive
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	invoicespkg "github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestArchive tests archiving of lnd's data, and that we only query lnd for
+// the data that we have not yet archived.
+func TestArchive(t *testing.T) {
+	store := newTestStore(t)
+
+	start := time.Unix(1_600_000_000, 0)
+
+	invoice := func(index uint64,
+		state invoicespkg.ContractState) lndclient.Invoice {
+
+		return lndclient.Invoice{
+			Hash:       lntypes.Hash{byte(index)},
+			AddIndex:   index,
+			State:      state,
+			SettleDate: start,
+		}
+	}
+
+	payment := func(index uint64,
+		state lnrpc.Payment_PaymentStatus) lndclient.Payment {
+
+		return lndclient.Payment{
+			Hash:           lntypes.Hash{byte(index)},
+			SequenceNumber: index,
+			Status: &lndclient.PaymentStatus{
+				State: state,
+			},
+		}
+	}
+
+	var (
+		invoices = []lndclient.Invoice{
+			invoice(1, invoicespkg.ContractSettled),
+			invoice(2, invoicespkg.ContractCanceled),
+			invoice(3, invoicespkg.ContractOpen),
+			invoice(4, invoicespkg.ContractSettled),
+		}
+		payments = []lndclient.Payment{
+			payment(1, lnrpc.Payment_FAILED),
+			payment(2, lnrpc.Payment_SUCCEEDED),
+			payment(3, lnrpc.Payment_IN_FLIGHT),
+		}
+		forwards = []lndclient.ForwardingEvent{
+			{Timestamp: start},
+			{Timestamp: start.Add(time.Hour)},
+		}
+
+		listErr         error
+		invoiceOffsets  []uint64
+		paymentOffsets  []uint64
+		forwardsQueried []time.Time
+	)
+
+	archiver := NewArchiver(&ArchiverConfig{
+		ListInvoices: func(_ context.Context, offset uint64) (
+			[]lndclient.Invoice, error) {
+
+			invoiceOffsets = append(invoiceOffsets, offset)
+
+			var after []lndclient.Invoice
+			for _, invoice := range invoices {
+				if invoice.AddIndex > offset {
+					after = append(after, invoice)
+				}
+			}
+
+			return after, listErr
+		},
+		ListPayments: func(_ context.Context, offset uint64) (
+			[]lndclient.Payment, error) {
+
+			paymentOffsets = append(paymentOffsets, offset)
+
+			var after []lndclient.Payment
+			for _, payment := range payments {
+				if payment.SequenceNumber > offset {
+					after = append(after, payment)
+				}
+			}
+
+			return after, nil
+		},
+		ListForwards: func(_ context.Context, startTime time.Time) (
+			[]lndclient.ForwardingEvent, error) {
+
+			forwardsQueried = append(forwardsQueried, startTime)
+			return forwards, nil
+		},
+		Store: store,
+	})
+
+	// Our first archive should query everything, only archive our settled
+	// invoices and succeeded payments, and stop our indexes before the
+	// first invoice and payment that have not been resolved.
+	require.NoError(t, archiver.archive())
+	require.Equal(t, []uint64{0}, invoiceOffsets)
+	require.Equal(t, []uint64{0}, paymentOffsets)
+
+	archivedInvoices, err := store.ListInvoices(start, start.Add(1))
+	require.NoError(t, err)
+	require.Len(t, archivedInvoices, 2)
+	require.Equal(t, uint64(1), archivedInvoices[0].AddIndex)
+	require.Equal(t, uint64(4), archivedInvoices[1].AddIndex)
+
+	archivedPayments, err := store.ListPayments(time.Unix(0, 0), start)
+	require.NoError(t, err)
+	require.Len(t, archivedPayments, 1)
+	require.Equal(t, uint64(2), archivedPayments[0].SequenceNumber)
+
+	// Now our open invoice and in flight payment resolve. Our next
+	// archive should only query lnd from them onwards, and our forwards
+	// from our latest archived forward.
+	invoices[2] = invoice(3, invoicespkg.ContractSettled)
+	payments[2] = payment(3, lnrpc.Payment_SUCCEEDED)
+
+	require.NoError(t, archiver.archive())
+	require.Equal(t, []uint64{0, 2}, invoiceOffsets)
+	require.Equal(t, []uint64{0, 2}, paymentOffsets)
+
+	archivedInvoices, err = store.ListInvoices(start, start.Add(1))
+	require.NoError(t, err)
+	require.Len(t, archivedInvoices, 3)
+
+	archivedPayments, err = store.ListPayments(time.Unix(0, 0), start)
+	require.NoError(t, err)
+	require.Len(t, archivedPayments, 2)
+
+	// Everything has been resolved, so we should not query lnd for any
+	// of the data that we have already archived.
+	require.NoError(t, archiver.archive())
+	require.Equal(t, []uint64{0, 2, 4}, invoiceOffsets)
+	require.Equal(t, []uint64{0, 2, 3}, paymentOffsets)
+
+	require.Len(t, forwardsQueried, 3)
+	require.True(t, forwardsQueried[0].Equal(time.Unix(0, 0)))
+	require.True(t, forwardsQueried[1].Equal(start.Add(time.Hour)))
+
+	archivedForwards, err := store.ListForwards(
+		start, start.Add(time.Hour*2),
+	)
+	require.NoError(t, err)
+	require.Len(t, archivedForwards, 2)
+
+	// Failed queries should be surfaced.
+	listErr = errors.New("failed")
+	require.ErrorIs(t, archiver.archive(), listErr)
+}
+
+// TestArchiverStop tests that stopping the archiver cancels its queries to
+// lnd.
+func TestArchiverStop(t *testing.T) {
+	queried := make(chan struct{})
+
+	archiver := NewArchiver(&ArchiverConfig{
+		ListInvoices: func(ctx context.Context, _ uint64) (
+			[]lndclient.Invoice, error) {
+
+			close(queried)
+			<-ctx.Done()
+
+			return nil, ctx.Err()
+		},
+		Store: newTestStore(t),
+	})
+	require.NoError(t, archiver.Start())
+
+	select {
+	case <-queried:
+	case <-time.After(time.Second * 5):
+		t.Fatal("invoices not queried")
+	}
+
+	// Stop should cancel our blocked query and exit.
+	archiver.Stop()
+}
@@ -0,0 +1,416 @@
+package archive
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+var (
+	// invoicesBucket is the top level bucket that archived invoices are
+	// stored in. Invoices are keyed by settle date and payment hash so
+	// that they are sorted by the time they were settled.
+	invoicesBucket = []byte("archive-invoices")
+
+	// paymentsBucket is the top level bucket that archived payments are
+	// stored in. Payments are keyed by settle time and payment hash so
+	// that they are sorted by the time they were settled.
+	paymentsBucket = []byte("archive-payments")
+
+	// forwardsBucket is the top level bucket that archived forwards are
+	// stored in. Forwards are keyed by timestamp, channels and amounts so
+	// that they are sorted by time.
+	forwardsBucket = []byte("archive-forwards")
+
+	// indexesBucket is the top level bucket that stores the lnd indexes
+	// that we have archived invoices and payments up to.
+	indexesBucket = []byte("archive-indexes")
+
+	// invoiceIndexKey is the key that stores the add index that we have
+	// archived invoices up to.
+	invoiceIndexKey = []byte("invoice-add-index")
+
+	// paymentIndexKey is the key that stores the payment index that we
+	// have archived payments up to.
+	paymentIndexKey = []byte("payment-index")
+
+	// errBucketNotFound is returned if one of our archive buckets has not
+	// been created.
+	errBucketNotFound = errors.New("archive bucket not found")
+)
+
+// forwardKeyLength is the length of our forward keys: an 8 byte timestamp
+// followed by the 8 byte incoming and outgoing channel ids, and the 8 byte
+// incoming, outgoing and fee amounts.
+const forwardKeyLength = 48
+
+// Store persists the invoices, payments and forwards that we have archived
+// from lnd.
+type Store struct {
+	db kvdb.Backend
+}
+
+// NewStore creates an archive backed by the database provided, creating our
+// buckets if they do not yet exist.
+func NewStore(db kvdb.Backend) (*Store, error) {
+	err := kvdb.Update(db, func(tx kvdb.RwTx) error {
+		for _, bucket := range [][]byte{
+			invoicesBucket, paymentsBucket, forwardsBucket,
+			indexesBucket,
+		} {
+			_, err := tx.CreateTopLevelBucket(bucket)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}, func() {})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Store{
+		db: db,
+	}, nil
+}
+
+// AddInvoices archives a set of settled invoices. Settled invoices do not
+// change, so archiving an invoice again replaces it with an identical copy.
+func (s *Store) AddInvoices(invoices []lndclient.Invoice) error {
+	return s.put(invoicesBucket, len(invoices), func(i int) ([]byte,
+		interface{}) {
+
+		invoice := invoices[i]
+		return hashKey(invoice.SettleDate, invoice.Hash), invoice
+	})
+}
+
+// AddPayments archives a set of succeeded payments. Succeeded payments do not
+// change, so archiving a payment again replaces it with an identical copy.
+func (s *Store) AddPayments(payments []lndclient.Payment) error {
+	return s.put(paymentsBucket, len(payments), func(i int) ([]byte,
+		interface{}) {
+
+		payment := payments[i]
+		return hashKey(paymentSettleTime(payment), payment.Hash),
+			payment
+	})
+}
+
+// AddForwards archives a set of forwards.
+func (s *Store) AddForwards(forwards []lndclient.ForwardingEvent) error {
+	return s.put(forwardsBucket, len(forwards), func(i int) ([]byte,
+		interface{}) {
+
+		return forwardKey(forwards[i]), forwards[i]
+	})
+}
+
+// ListInvoices returns our archived invoices that were settled in
+// [startTime, endTime), sorted by settle date.
+func (s *Store) ListInvoices(startTime, endTime time.Time) (
+	[]lndclient.Invoice, error) {
+
+	var invoices []lndclient.Invoice
+
+	err := s.list(
+		invoicesBucket, timestampKey(startTime), timestampKey(endTime),
+		func(v []byte) error {
+			var invoice lndclient.Invoice
+			if err := json.Unmarshal(v, &invoice); err != nil {
+				return err
+			}
+
+			invoices = append(invoices, invoice)
+
+			return nil
+		}, func() {
+			invoices = nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return invoices, nil
+}
+
+// ListPayments returns our archived payments that were settled in
+// [startTime, endTime), sorted by settle time.
+func (s *Store) ListPayments(startTime, endTime time.Time) (
+	[]lndclient.Payment, error) {
+
+	var payments []lndclient.Payment
+
+	err := s.list(
+		paymentsBucket, timestampKey(startTime), timestampKey(endTime),
+		func(v []byte) error {
+			var payment lndclient.Payment
+			if err := json.Unmarshal(v, &payment); err != nil {
+				return err
+			}
+
+			payments = append(payments, payment)
+
+			return nil
+		}, func() {
+			payments = nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return payments, nil
+}
+
+// ListForwards returns our archived forwards in [startTime, endTime), sorted
+// by timestamp.
+func (s *Store) ListForwards(startTime, endTime time.Time) (
+	[]lndclient.ForwardingEvent, error) {
+
+	var forwards []lndclient.ForwardingEvent
+
+	err := s.list(
+		forwardsBucket, timestampKey(startTime), timestampKey(endTime),
+		func(v []byte) error {
+			var forward lndclient.ForwardingEvent
+			if err := json.Unmarshal(v, &forward); err != nil {
+				return err
+			}
+
+			forwards = append(forwards, forward)
+
+			return nil
+		}, func() {
+			forwards = nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return forwards, nil
+}
+
+// LatestForward returns the timestamp of our most recently archived forward,
+// or the unix epoch if we have not archived any forwards.
+func (s *Store) LatestForward() (time.Time, error) {
+	latest := time.Unix(0, 0)
+
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(forwardsBucket)
+		if bucket == nil {
+			return errBucketNotFound
+		}
+
+		key, _ := bucket.ReadCursor().Last()
+		if len(key) != forwardKeyLength {
+			return nil
+		}
+
+		latest = time.Unix(
+			0, int64(binary.BigEndian.Uint64(key[:8])),
+		)
+
+		return nil
+	}, func() {
+		latest = time.Unix(0, 0)
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return latest, nil
+}
+
+// InvoiceIndex returns the add index that we have archived invoices up to,
+// or zero if we have not archived any invoices.
+func (s *Store) InvoiceIndex() (uint64, error) {
+	return s.getIndex(invoiceIndexKey)
+}
+
+// SetInvoiceIndex records the add index that we have archived invoices up
+// to.
+func (s *Store) SetInvoiceIndex(index uint64) error {
+	return s.setIndex(invoiceIndexKey, index)
+}
+
+// PaymentIndex returns the payment index that we have archived payments up
+// to, or zero if we have not archived any payments.
+func (s *Store) PaymentIndex() (uint64, error) {
+	return s.getIndex(paymentIndexKey)
+}
+
+// SetPaymentIndex records the payment index that we have archived payments
+// up to.
+func (s *Store) SetPaymentIndex(index uint64) error {
+	return s.setIndex(paymentIndexKey, index)
+}
+
+// getIndex reads an index from our indexes bucket, returning zero if it has
+// not been set.
+func (s *Store) getIndex(key []byte) (uint64, error) {
+	var index uint64
+
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(indexesBucket)
+		if bucket == nil {
+			return errBucketNotFound
+		}
+
+		value := bucket.Get(key)
+		if len(value) != 8 {
+			return nil
+		}
+
+		index = binary.BigEndian.Uint64(value)
+
+		return nil
+	}, func() {
+		index = 0
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return index, nil
+}
+
+// setIndex writes an index to our indexes bucket.
+func (s *Store) setIndex(key []byte, index uint64) error {
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(indexesBucket)
+		if bucket == nil {
+			return errBucketNotFound
+		}
+
+		value := make([]byte, 8)
+		binary.BigEndian.PutUint64(value, index)
+
+		return bucket.Put(key, value)
+	}, func() {})
+}
+
+// put json encodes and stores count values in a bucket, using get to obtain
+// the key and value for each index.
+func (s *Store) put(bucketKey []byte, count int,
+	get func(i int) ([]byte, interface{})) error {
+
+	if count == 0 {
+		return nil
+	}
+
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(bucketKey)
+		if bucket == nil {
+			return errBucketNotFound
+		}
+
+		for i := 0; i < count; i++ {
+			key, item := get(i)
+
+			value, err := json.Marshal(item)
+			if err != nil {
+				return err
+			}
+
+			if err := bucket.Put(key, value); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}, func() {})
+}
+
+// list calls read for each value in a bucket with a key in [start, end).
+func (s *Store) list(bucketKey, start, end []byte, read func([]byte) error,
+	reset func()) error {
+
+	return kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(bucketKey)
+		if bucket == nil {
+			return errBucketNotFound
+		}
+
+		cursor := bucket.ReadCursor()
+		for k, v := cursor.Seek(start); k != nil; k, v = cursor.Next() {
+			if string(k) >= string(end) {
+				return nil
+			}
+
+			if err := read(v); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}, reset)
+}
+
+// timestampKey returns the 8 byte prefix of our forward keys for a
+// timestamp. Timestamps before the unix epoch are clamped to zero so that
+// they sort first.
+func timestampKey(timestamp time.Time) []byte {
+	var ts uint64
+	if nanos := timestamp.UnixNano(); nanos > 0 {
+		ts = uint64(nanos)
+	}
+
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, ts)
+
+	return key
+}
+
+// hashKey returns the key that we store an invoice or payment under: the
+// timestamp that it was settled at, followed by its payment hash.
+func hashKey(timestamp time.Time, hash lntypes.Hash) []byte {
+	key := make([]byte, 8+lntypes.HashSize)
+	copy(key, timestampKey(timestamp))
+	copy(key[8:], hash[:])
+
+	return key
+}
+
+// paymentSettleTime returns the time that a payment settled, which is the
+// latest resolve time of its succeeded htlcs. Payments that have no
+// succeeded htlcs return the unix epoch.
+func paymentSettleTime(payment lndclient.Payment) time.Time {
+	var latestTimeNs int64
+	for _, htlc := range payment.Htlcs {
+		if htlc.Status != lnrpc.HTLCAttempt_SUCCEEDED {
+			continue
+		}
+
+		if htlc.ResolveTimeNs > latestTimeNs {
+			latestTimeNs = htlc.ResolveTimeNs
+		}
+	}
+
+	return time.Unix(0, latestTimeNs)
+}
+
+// forwardKey returns the key that we store a forward under. lnd only
+// provides forward timestamps to the second, so we include the forward's
+// channels and amounts to distinguish between forwards that have the same
+// timestamp.
+func forwardKey(forward lndclient.ForwardingEvent) []byte {
+	key := make([]byte, forwardKeyLength)
+	copy(key, timestampKey(forward.Timestamp))
+	binary.BigEndian.PutUint64(key[8:16], forward.ChannelIn)
+	binary.BigEndian.PutUint64(key[16:24], forward.ChannelOut)
+	binary.BigEndian.PutUint64(key[24:32], uint64(forward.AmountMsatIn))
+	binary.BigEndian.PutUint64(key[32:40], uint64(forward.AmountMsatOut))
+	binary.BigEndian.PutUint64(key[40:], uint64(forward.FeeMsat))
+
+	return key
+}
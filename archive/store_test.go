@@ -0,0 +1,205 @@
+package archive
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	invoicespkg "github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestStore creates an archive backed by a temporary database.
+func newTestStore(t *testing.T) *Store {
+	db, err := kvdb.GetBoltBackend(&kvdb.BoltBackendConfig{
+		DBPath:     t.TempDir(),
+		DBFileName: "test.db",
+		DBTimeout:  time.Second,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	store, err := NewStore(db)
+	require.NoError(t, err)
+
+	return store
+}
+
+// TestStore tests archiving of invoices, payments and forwards.
+func TestStore(t *testing.T) {
+	store := newTestStore(t)
+
+	start := time.Unix(1_600_000_000, 0)
+
+	// We have not archived any forwards yet, so our latest forward should
+	// be the unix epoch.
+	latest, err := store.LatestForward()
+	require.NoError(t, err)
+	require.True(t, latest.Equal(time.Unix(0, 0)))
+
+	// Archive a settled invoice, and check that we only list it when we
+	// query a range that includes its settle date.
+	preimage := lntypes.Preimage{1}
+	invoice := lndclient.Invoice{
+		Preimage:     &preimage,
+		Hash:         preimage.Hash(),
+		Memo:         "memo",
+		Amount:       1000,
+		AmountPaid:   1000,
+		CreationDate: start,
+		SettleDate:   start.Add(time.Minute),
+		State:        invoicespkg.ContractSettled,
+		AddIndex:     1,
+	}
+	require.NoError(t, store.AddInvoices([]lndclient.Invoice{invoice}))
+
+	invoices, err := store.ListInvoices(start, start.Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, invoices, 1)
+	require.Equal(t, invoicespkg.ContractSettled, invoices[0].State)
+	require.True(t, invoice.SettleDate.Equal(invoices[0].SettleDate))
+
+	invoices, err = store.ListInvoices(start, start.Add(time.Minute))
+	require.NoError(t, err)
+	require.Empty(t, invoices)
+
+	// Archive a succeeded payment, which is keyed by the latest resolve
+	// time of its succeeded htlcs.
+	payment := lndclient.Payment{
+		Hash:           lntypes.Hash{2},
+		Preimage:       &preimage,
+		Amount:         2000,
+		Fee:            10,
+		SequenceNumber: 3,
+		Status: &lndclient.PaymentStatus{
+			State: lnrpc.Payment_SUCCEEDED,
+		},
+		Htlcs: []*lnrpc.HTLCAttempt{
+			{
+				Status:        lnrpc.HTLCAttempt_SUCCEEDED,
+				ResolveTimeNs: start.UnixNano(),
+			},
+			{
+				Status: lnrpc.HTLCAttempt_FAILED,
+				ResolveTimeNs: start.Add(
+					time.Hour,
+				).UnixNano(),
+			},
+		},
+	}
+	require.NoError(t, store.AddPayments([]lndclient.Payment{payment}))
+
+	payments, err := store.ListPayments(start, start.Add(time.Second))
+	require.NoError(t, err)
+	require.Len(t, payments, 1)
+	require.Equal(t, payment.Hash, payments[0].Hash)
+	require.Equal(t, payment.SequenceNumber, payments[0].SequenceNumber)
+
+	payments, err = store.ListPayments(
+		start.Add(time.Second), start.Add(time.Hour*2),
+	)
+	require.NoError(t, err)
+	require.Empty(t, payments)
+
+	// Our indexes should be zero until they are set.
+	index, err := store.InvoiceIndex()
+	require.NoError(t, err)
+	require.Zero(t, index)
+
+	require.NoError(t, store.SetInvoiceIndex(5))
+	require.NoError(t, store.SetPaymentIndex(7))
+
+	index, err = store.InvoiceIndex()
+	require.NoError(t, err)
+	require.EqualValues(t, 5, index)
+
+	index, err = store.PaymentIndex()
+	require.NoError(t, err)
+	require.EqualValues(t, 7, index)
+
+	// Add two forwards with the same timestamp and channels, which should
+	// both be archived, and a forward an hour later.
+	forwards := []lndclient.ForwardingEvent{
+		{
+			Timestamp:  start,
+			ChannelIn:  1,
+			ChannelOut: 2,
+			FeeMsat:    1000,
+		},
+		{
+			Timestamp:  start,
+			ChannelIn:  1,
+			ChannelOut: 2,
+			FeeMsat:    2000,
+		},
+		{
+			Timestamp:  start.Add(time.Hour),
+			ChannelIn:  1,
+			ChannelOut: 2,
+			FeeMsat:    3000,
+		},
+	}
+	require.NoError(t, store.AddForwards(forwards))
+
+	latest, err = store.LatestForward()
+	require.NoError(t, err)
+	require.True(t, latest.Equal(start.Add(time.Hour)))
+
+	tests := []struct {
+		name     string
+		start    time.Time
+		end      time.Time
+		expected []lndclient.ForwardingEvent
+	}{
+		{
+			name:     "all forwards",
+			start:    start,
+			end:      start.Add(time.Hour * 2),
+			expected: forwards,
+		},
+		{
+			name:     "end exclusive",
+			start:    start,
+			end:      start.Add(time.Hour),
+			expected: forwards[:2],
+		},
+		{
+			name:     "start inclusive",
+			start:    start.Add(time.Hour),
+			end:      start.Add(time.Hour * 2),
+			expected: forwards[2:],
+		},
+		{
+			name:  "no forwards",
+			start: start.Add(time.Second),
+			end:   start.Add(time.Minute),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			archived, err := store.ListForwards(
+				test.start, test.end,
+			)
+			require.NoError(t, err)
+			require.Len(t, archived, len(test.expected))
+
+			for i, forward := range test.expected {
+				require.True(t, forward.Timestamp.Equal(
+					archived[i].Timestamp,
+				))
+				require.Equal(t, forward.FeeMsat,
+					archived[i].FeeMsat)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package attestation
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/tv42/zbase32"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	// signedMsgPrefix is the prefix that lnd prepends to messages before
+	// signing them with SignMessage, so that signatures can't be used
+	// as signatures over arbitrary data.
+	signedMsgPrefix = []byte("Lightning Signed Message:")
+
+	// ErrInvalidSignature is returned when a signature is not valid for
+	// the digest provided.
+	ErrInvalidSignature = errors.New("invalid signature")
+
+	// ErrPubkeyMismatch is returned when a signature is valid, but was
+	// not produced by the public key provided.
+	ErrPubkeyMismatch = errors.New("signature not produced by pubkey")
+)
+
+// Digest returns the sha256 digest of a report's canonical encoding, prefixed
+// with our digest domain and the encoding version. We do not use the report's
+// protobuf serialization, since it is not guaranteed to be stable across
+// protobuf libraries or languages. Any signature included in the report must
+// be cleared before its digest is calculated.
+func Digest(report proto.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.Write(digestDomain)
+	writeUint32(&buf, EncodingVersion)
+
+	if err := encodeMessage(&buf, report.ProtoReflect()); err != nil {
+		return nil, err
+	}
+
+	digest := sha256.Sum256(buf.Bytes())
+
+	return digest[:], nil
+}
+
+// Verify checks that a zbase32 encoded signature produced by lnd's
+// SignMessage over a digest was produced by the compressed public key
+// provided. Since lnd's signatures are pubkey recoverable, verification does
+// not require a connection to lnd.
+func Verify(digest []byte, signature string, pubkey []byte) error {
+	sig, err := zbase32.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("could not decode signature: %w", err)
+	}
+
+	msg := append(append([]byte{}, signedMsgPrefix...), digest...)
+
+	recovered, _, err := ecdsa.RecoverCompact(
+		sig, chainhash.DoubleHashB(msg),
+	)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+
+	if !bytes.Equal(recovered.SerializeCompressed(), pubkey) {
+		return ErrPubkeyMismatch
+	}
+
+	return nil
+}
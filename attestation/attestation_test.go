@@ -0,0 +1,130 @@
+package attestation
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/stretchr/testify/require"
+	"github.com/tv42/zbase32"
+)
+
+// TestVerify tests verification of signatures produced in the same way as
+// lnd's SignMessage.
+func TestVerify(t *testing.T) {
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	pubkey := privKey.PubKey().SerializeCompressed()
+
+	otherKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	otherPubkey := otherKey.PubKey().SerializeCompressed()
+
+	report := &frdrpc.CloseReportResponse{
+		ChannelPoint: "txid:1",
+		CloseFee:     "100",
+	}
+
+	digest, err := Digest(report)
+	require.NoError(t, err)
+
+	msg := append(append([]byte{}, signedMsgPrefix...), digest...)
+	sig := ecdsa.SignCompact(privKey, chainhash.DoubleHashB(msg), true)
+	signature := zbase32.EncodeToString(sig)
+
+	// Editing the report changes its digest.
+	report.CloseFee = "10"
+	editedDigest, err := Digest(report)
+	require.NoError(t, err)
+	require.NotEqual(t, digest, editedDigest)
+
+	tests := []struct {
+		name      string
+		digest    []byte
+		signature string
+		pubkey    []byte
+		err       error
+	}{
+		{
+			name:      "valid",
+			digest:    digest,
+			signature: signature,
+			pubkey:    pubkey,
+		},
+		{
+			name:      "wrong pubkey",
+			digest:    digest,
+			signature: signature,
+			pubkey:    otherPubkey,
+			err:       ErrPubkeyMismatch,
+		},
+		{
+			// An edited report recovers a different key from the
+			// signature.
+			name:      "edited report",
+			digest:    editedDigest,
+			signature: signature,
+			pubkey:    pubkey,
+			err:       ErrPubkeyMismatch,
+		},
+		{
+			name:      "invalid signature",
+			digest:    digest,
+			signature: zbase32.EncodeToString([]byte{1, 2, 3}),
+			pubkey:    pubkey,
+			err:       ErrInvalidSignature,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := Verify(test.digest, test.signature, test.pubkey)
+			require.ErrorIs(t, err, test.err)
+		})
+	}
+}
+
+// TestDigest tests that report digests are calculated over our canonical
+// encoding, so that they can be reproduced without a protobuf library.
+func TestDigest(t *testing.T) {
+	report := &frdrpc.NodeAuditResponse{
+		Reports: []*frdrpc.ReportEntry{
+			{
+				Timestamp: 10,
+				Credit:    true,
+				Type:      frdrpc.EntryType_RECEIPT,
+				Reference: "ref",
+			},
+		},
+	}
+
+	// Our entry's populated fields, in field number order: timestamp (1),
+	// credit (4), type (6) and reference (9).
+	entry := []byte{
+		0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 10,
+		0, 0, 0, 4, 1,
+		0, 0, 0, 6, 0, 0, 0, 0, 0, 0, 0,
+		byte(frdrpc.EntryType_RECEIPT),
+		0, 0, 0, 9, 0, 0, 0, 3, 'r', 'e', 'f',
+	}
+
+	// Our report has a single repeated field, reports (1), which contains
+	// our entry.
+	expected := append([]byte("faraday report"), 0, 0, 0, 1)
+	expected = append(expected, 0, 0, 0, 1, 0, 0, 0, 1)
+	expected = append(expected, 0, 0, 0, byte(len(entry)))
+	expected = append(expected, entry...)
+
+	digest, err := Digest(report)
+	require.NoError(t, err)
+
+	expectedDigest := sha256.Sum256(expected)
+	require.Equal(t, expectedDigest[:], digest)
+}
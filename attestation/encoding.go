@@ -0,0 +1,210 @@
+package attestation
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// EncodingVersion is the version of the canonical encoding that we calculate
+// report digests over. It is included in the signature of each report so
+// that verifiers know which encoding to use, and must be incremented if the
+// encoding changes.
+const EncodingVersion uint32 = 1
+
+// digestDomain is prepended to the canonical encoding of a report, followed
+// by the encoding version, so that report digests can't be confused with
+// digests of other data.
+var digestDomain = []byte("faraday report")
+
+// encodeMessage writes the canonical encoding of a message, which does not
+// depend on the protobuf library or language used to produce it. Version 1
+// of the encoding is defined as follows, where all integers are big endian:
+//   - A message is the concatenation of its populated fields in ascending
+//     field number order. Fields with their proto3 default value, empty
+//     repeated fields and unset messages are not populated, and unknown
+//     fields are not included.
+//   - Each field is its field number as a uint32, followed by its value.
+//   - Repeated fields are their number of elements as a uint32, followed by
+//     each element in order.
+//   - Maps are their number of entries as a uint32, followed by each key and
+//     value, ordered by the bytes of their encoded keys.
+//   - Bools are a single byte, 0 or 1.
+//   - Signed integers and enums are 8 byte two's complement values, unsigned
+//     integers are 8 byte values and floats are 8 byte IEEE 754 doubles.
+//   - Strings, bytes and nested messages are their length in bytes as a
+//     uint32, followed by their bytes or encoding.
+func encodeMessage(w *bytes.Buffer, msg protoreflect.Message) error {
+	fields := msg.Descriptor().Fields()
+
+	ordered := make([]protoreflect.FieldDescriptor, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		ordered[i] = fields.Get(i)
+	}
+
+	sort.Slice(ordered, func(i, j int) bool {
+		return ordered[i].Number() < ordered[j].Number()
+	})
+
+	for _, field := range ordered {
+		if !msg.Has(field) {
+			continue
+		}
+
+		writeUint32(w, uint32(field.Number()))
+
+		if err := encodeField(w, field, msg.Get(field)); err != nil {
+			return fmt.Errorf("field %v: %w", field.FullName(), err)
+		}
+	}
+
+	return nil
+}
+
+// encodeField writes the canonical encoding of a populated field's value.
+func encodeField(w *bytes.Buffer, field protoreflect.FieldDescriptor,
+	value protoreflect.Value) error {
+
+	switch {
+	case field.IsList():
+		list := value.List()
+
+		writeUint32(w, uint32(list.Len()))
+		for i := 0; i < list.Len(); i++ {
+			err := encodeValue(w, field, list.Get(i))
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+
+	case field.IsMap():
+		return encodeMap(w, field, value.Map())
+
+	default:
+		return encodeValue(w, field, value)
+	}
+}
+
+// encodeMap writes the canonical encoding of a map, with its entries ordered
+// by their encoded keys.
+func encodeMap(w *bytes.Buffer, field protoreflect.FieldDescriptor,
+	entries protoreflect.Map) error {
+
+	type entry struct {
+		key   []byte
+		value protoreflect.Value
+	}
+
+	var (
+		sorted []entry
+		err    error
+	)
+	entries.Range(func(key protoreflect.MapKey,
+		value protoreflect.Value) bool {
+
+		var encoded bytes.Buffer
+		err = encodeValue(&encoded, field.MapKey(), key.Value())
+		if err != nil {
+			return false
+		}
+
+		sorted = append(sorted, entry{
+			key:   encoded.Bytes(),
+			value: value,
+		})
+
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].key, sorted[j].key) < 0
+	})
+
+	writeUint32(w, uint32(len(sorted)))
+	for _, e := range sorted {
+		w.Write(e.key)
+
+		err := encodeValue(w, field.MapValue(), e.value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// encodeValue writes the canonical encoding of a single value of a field.
+func encodeValue(w *bytes.Buffer, field protoreflect.FieldDescriptor,
+	value protoreflect.Value) error {
+
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		if value.Bool() {
+			w.WriteByte(1)
+		} else {
+			w.WriteByte(0)
+		}
+
+	case protoreflect.EnumKind:
+		writeUint64(w, uint64(value.Enum()))
+
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind,
+		protoreflect.Sfixed32Kind, protoreflect.Int64Kind,
+		protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+
+		writeUint64(w, uint64(value.Int()))
+
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+
+		writeUint64(w, value.Uint())
+
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		writeUint64(w, math.Float64bits(value.Float()))
+
+	case protoreflect.StringKind:
+		writeUint32(w, uint32(len(value.String())))
+		w.WriteString(value.String())
+
+	case protoreflect.BytesKind:
+		writeUint32(w, uint32(len(value.Bytes())))
+		w.Write(value.Bytes())
+
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		var nested bytes.Buffer
+		if err := encodeMessage(&nested, value.Message()); err != nil {
+			return err
+		}
+
+		writeUint32(w, uint32(nested.Len()))
+		w.Write(nested.Bytes())
+
+	default:
+		return fmt.Errorf("unsupported kind: %v", field.Kind())
+	}
+
+	return nil
+}
+
+// writeUint32 writes a big endian uint32.
+func writeUint32(w *bytes.Buffer, value uint32) {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], value)
+	w.Write(b[:])
+}
+
+// writeUint64 writes a big endian uint64.
+func writeUint64(w *bytes.Buffer, value uint64) {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], value)
+	w.Write(b[:])
+}
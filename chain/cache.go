@@ -0,0 +1,220 @@
+package chain
+
+import (
+	"container/list"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+const (
+	// DefaultTxCacheSize is the default number of transactions that we
+	// cache in memory.
+	DefaultTxCacheSize = 10000
+
+	// TxCacheDBName is the name of the database that we persist confirmed
+	// transactions in.
+	TxCacheDBName = "txcache.db"
+
+	// txCacheDBOpenTimeout is how long we wait for acquiring the lock on
+	// the transaction cache database before we give up with an error.
+	txCacheDBOpenTimeout = time.Second * 5
+)
+
+var (
+	// txCacheBucket is the top level bucket that we persist confirmed
+	// transactions in, keyed by transaction hash.
+	txCacheBucket = []byte("tx-cache")
+
+	// errCacheBucketNotFound is returned if our cache bucket has not been
+	// created.
+	errCacheBucketNotFound = errors.New("tx cache bucket not found")
+)
+
+// cacheEntry is a transaction held in our lru cache.
+type cacheEntry struct {
+	hash chainhash.Hash
+	tx   *btcjson.TxRawResult
+}
+
+// txCache caches confirmed transactions. The most recently used transactions
+// are held in a bounded in memory lru cache, and all transactions are
+// persisted to disk if the cache has a database so that they survive
+// restarts. Only confirmed transactions should be cached, because we would
+// otherwise never look up their confirmation.
+type txCache struct {
+	sync.Mutex
+
+	// size is the maximum number of transactions held in memory.
+	size int
+
+	// entries holds the list element for each transaction in memory.
+	entries map[chainhash.Hash]*list.Element
+
+	// order holds our transactions in memory, with the most recently used
+	// transaction at the front of the list.
+	order *list.List
+
+	// db is the database that we persist transactions in. It is nil if we
+	// do not have a disk cache.
+	db kvdb.Backend
+}
+
+// newTxCache creates a transaction cache holding up to size transactions in
+// memory. If a database is provided, transactions are also persisted to it.
+func newTxCache(size int, db kvdb.Backend) (*txCache, error) {
+	if size <= 0 {
+		size = DefaultTxCacheSize
+	}
+
+	if db != nil {
+		err := kvdb.Update(db, func(tx kvdb.RwTx) error {
+			_, err := tx.CreateTopLevelBucket(txCacheBucket)
+			return err
+		}, func() {})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &txCache{
+		size:    size,
+		entries: make(map[chainhash.Hash]*list.Element),
+		order:   list.New(),
+		db:      db,
+	}, nil
+}
+
+// get returns a cached transaction, checking our memory cache before our
+// disk cache. Transactions found on disk are added to our memory cache.
+func (c *txCache) get(hash chainhash.Hash) (*btcjson.TxRawResult, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	if elem, ok := c.entries[hash]; ok {
+		c.order.MoveToFront(elem)
+		return elem.Value.(*cacheEntry).tx, true
+	}
+
+	if c.db == nil {
+		return nil, false
+	}
+
+	tx, err := c.read(hash)
+	if err != nil {
+		log.Warnf("Could not read tx %v from disk cache: %v", hash,
+			err)
+
+		return nil, false
+	}
+
+	if tx == nil {
+		return nil, false
+	}
+
+	c.addToMemory(hash, tx)
+
+	return tx, true
+}
+
+// add caches a confirmed transaction in memory and on disk. Failures to
+// persist a transaction are logged, since we can look it up again.
+func (c *txCache) add(hash chainhash.Hash, tx *btcjson.TxRawResult) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.addToMemory(hash, tx)
+
+	if c.db == nil {
+		return
+	}
+
+	if err := c.write(hash, tx); err != nil {
+		log.Warnf("Could not write tx %v to disk cache: %v", hash,
+			err)
+	}
+}
+
+// addToMemory adds a transaction to our lru cache, evicting our least
+// recently used transaction if we are at capacity. It must be called with
+// the cache's mutex held.
+func (c *txCache) addToMemory(hash chainhash.Hash, tx *btcjson.TxRawResult) {
+	if elem, ok := c.entries[hash]; ok {
+		elem.Value.(*cacheEntry).tx = tx
+		c.order.MoveToFront(elem)
+
+		return
+	}
+
+	if c.order.Len() >= c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).hash)
+	}
+
+	c.entries[hash] = c.order.PushFront(&cacheEntry{
+		hash: hash,
+		tx:   tx,
+	})
+}
+
+// read looks up a transaction in our disk cache, returning nil if it is not
+// present.
+func (c *txCache) read(hash chainhash.Hash) (*btcjson.TxRawResult, error) {
+	var tx *btcjson.TxRawResult
+
+	err := kvdb.View(c.db, func(dbTx kvdb.RTx) error {
+		bucket := dbTx.ReadBucket(txCacheBucket)
+		if bucket == nil {
+			return errCacheBucketNotFound
+		}
+
+		value := bucket.Get(hash[:])
+		if value == nil {
+			return nil
+		}
+
+		tx = &btcjson.TxRawResult{}
+
+		return json.Unmarshal(value, tx)
+	}, func() {
+		tx = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// write persists a transaction to our disk cache.
+func (c *txCache) write(hash chainhash.Hash, tx *btcjson.TxRawResult) error {
+	value, err := json.Marshal(tx)
+	if err != nil {
+		return err
+	}
+
+	return kvdb.Update(c.db, func(dbTx kvdb.RwTx) error {
+		bucket := dbTx.ReadWriteBucket(txCacheBucket)
+		if bucket == nil {
+			return errCacheBucketNotFound
+		}
+
+		return bucket.Put(hash[:], value)
+	}, func() {})
+}
+
+// OpenTxCacheDB opens the database that confirmed transactions are persisted
+// in, creating it in the directory provided if it does not exist.
+func OpenTxCacheDB(dir string) (kvdb.Backend, error) {
+	return kvdb.GetBoltBackend(&kvdb.BoltBackendConfig{
+		DBPath:     dir,
+		DBFileName: TxCacheDBName,
+		DBTimeout:  txCacheDBOpenTimeout,
+	})
+}
@@ -0,0 +1,106 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTxCacheEviction tests that our memory cache evicts the least recently
+// used transaction when it is full.
+func TestTxCacheEviction(t *testing.T) {
+	cache, err := newTxCache(2, nil)
+	require.NoError(t, err)
+
+	var (
+		hash0 = chainhash.Hash{0}
+		hash1 = chainhash.Hash{1}
+		hash2 = chainhash.Hash{2}
+	)
+
+	cache.add(hash0, &btcjson.TxRawResult{Txid: "0"})
+	cache.add(hash1, &btcjson.TxRawResult{Txid: "1"})
+
+	// Use our first transaction so that our second transaction is the
+	// least recently used.
+	_, ok := cache.get(hash0)
+	require.True(t, ok)
+
+	cache.add(hash2, &btcjson.TxRawResult{Txid: "2"})
+
+	_, ok = cache.get(hash1)
+	require.False(t, ok)
+
+	tx, ok := cache.get(hash0)
+	require.True(t, ok)
+	require.Equal(t, "0", tx.Txid)
+
+	tx, ok = cache.get(hash2)
+	require.True(t, ok)
+	require.Equal(t, "2", tx.Txid)
+}
+
+// TestTxCacheDisk tests that transactions are persisted to disk, so that
+// they are available to a new cache and when they have been evicted from
+// memory.
+func TestTxCacheDisk(t *testing.T) {
+	db, err := kvdb.GetBoltBackend(&kvdb.BoltBackendConfig{
+		DBPath:     t.TempDir(),
+		DBFileName: TxCacheDBName,
+		DBTimeout:  txCacheDBOpenTimeout,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	cache, err := newTxCache(1, db)
+	require.NoError(t, err)
+
+	var (
+		hash0 = chainhash.Hash{0}
+		hash1 = chainhash.Hash{1}
+		tx0   = &btcjson.TxRawResult{
+			Txid:      "0",
+			BlockHash: "abcd",
+			Vin: []btcjson.Vin{
+				{
+					Txid: "1",
+					Vout: 1,
+				},
+			},
+			Vout: []btcjson.Vout{
+				{
+					Value: 0.1,
+				},
+			},
+		}
+	)
+
+	cache.add(hash0, tx0)
+	cache.add(hash1, &btcjson.TxRawResult{Txid: "1"})
+
+	// Our first transaction has been evicted from memory, but should
+	// still be on disk.
+	tx, ok := cache.get(hash0)
+	require.True(t, ok)
+	require.Equal(t, tx0, tx)
+
+	// A new cache should find both of our transactions on disk.
+	cache, err = newTxCache(1, db)
+	require.NoError(t, err)
+
+	tx, ok = cache.get(hash0)
+	require.True(t, ok)
+	require.Equal(t, tx0, tx)
+
+	tx, ok = cache.get(hash1)
+	require.True(t, ok)
+	require.Equal(t, "1", tx.Txid)
+
+	_, ok = cache.get(chainhash.Hash{2})
+	require.False(t, ok)
+}
@@ -1,13 +1,15 @@
 package chain
 
 import (
+	"errors"
+	"fmt"
 	"io/ioutil"
 	"os"
-	"sync"
 
 	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/lightningnetwork/lnd/kvdb"
 )
 
 // BitcoinClient is an interface which represents a connection to a bitcoin
@@ -15,36 +17,88 @@ import (
 type BitcoinClient interface {
 	// GetTxDetail looks up a transaction.
 	GetTxDetail(txHash *chainhash.Hash) (*btcjson.TxRawResult, error)
+
+	// GetTxDetails looks up a set of transactions, returning them in the
+	// order that they were requested. Backends that support batching
+	// look up all of the transactions in a single round trip.
+	GetTxDetails(txHashes []*chainhash.Hash) ([]*btcjson.TxRawResult,
+		error)
+}
+
+const (
+	// BackendRPC looks up transactions using bitcoind or btcd's json-rpc
+	// api.
+	BackendRPC = "rpc"
+
+	// BackendEsplora looks up transactions using an esplora compatible
+	// REST api.
+	BackendEsplora = "esplora"
+
+	// BackendElectrum looks up transactions using an electrum server.
+	BackendElectrum = "electrum"
+)
+
+var (
+	// ErrUnknownBackend is returned if an unknown backend is configured.
+	ErrUnknownBackend = errors.New("unknown bitcoin backend")
+
+	// ErrEsploraURLRequired is returned if the esplora backend is
+	// configured without an api url.
+	ErrEsploraURLRequired = errors.New("bitcoin.esploraurl required " +
+		"for esplora backend")
+)
+
+// txLookup is implemented by the backends that we can look up transactions
+// with.
+type txLookup interface {
+	// GetRawTransactionVerbose looks up a transaction, returning it in
+	// the format that bitcoind's getrawtransaction does.
+	GetRawTransactionVerbose(txHash *chainhash.Hash) (
+		*btcjson.TxRawResult, error)
+
+	// Shutdown closes any connections held by the backend.
+	Shutdown()
+}
+
+// batchTxLookup is implemented by backends that can look up a set of
+// transactions in a single round trip.
+type batchTxLookup interface {
+	// GetRawTransactionsVerbose looks up a set of transactions, returning
+	// them in the order that they were requested.
+	GetRawTransactionsVerbose(txHashes []*chainhash.Hash) (
+		[]*btcjson.TxRawResult, error)
 }
 
 // BitcoinConfig defines exported config options for the connection to the
 // btcd/bitcoind backend.
 type BitcoinConfig struct {
-	Host         string `long:"host" description:"host:port of the bitcoind/btcd instance address"`
+	Backend      string `long:"backend" description:"The backend used to look up transactions: rpc for bitcoind/btcd, esplora for an esplora compatible REST api or electrum for an electrum server" choice:"rpc" choice:"esplora" choice:"electrum"`
+	EsploraURL   string `long:"esploraurl" description:"The base url of the esplora api, for example https://blockstream.info/api, used by the esplora backend"`
+	Host         string `long:"host" description:"host:port of the bitcoind/btcd instance address, or of the electrum server for the electrum backend"`
 	User         string `long:"user" description:"bitcoind/btcd user name"`
 	Password     string `long:"password" description:"bitcoind/btcd password"`
 	HTTPPostMode bool   `long:"httppostmode" description:"Use HTTP POST mode? bitcoind only supports this mode"`
-	UseTLS       bool   `long:"usetls" description:"Use TLS to connect? bitcoind only supports non-TLS connections"`
-	TLSPath      string `long:"tlspath" description:"Path to btcd tls certificate, bitcoind only supports non-TLS connections"`
+	UseTLS       bool   `long:"usetls" description:"Use TLS to connect? bitcoind only supports non-TLS connections. Also applies to electrum servers"`
+	TLSPath      string `long:"tlspath" description:"Path to btcd tls certificate, bitcoind only supports non-TLS connections. For electrum servers, the certificate to trust if it is not signed by a system root"`
+	TxCacheSize  int    `long:"txcachesize" description:"The number of confirmed transactions to cache in memory. All confirmed transactions are also cached on disk"`
 }
 
 // DefaultConfig is the default config that we use to
 var DefaultConfig = &BitcoinConfig{
+	Backend:      BackendRPC,
 	Host:         "localhost:8332",
 	UseTLS:       false,
 	HTTPPostMode: true,
+	TxCacheSize:  DefaultTxCacheSize,
 }
 
-// bitcoinClient is a wrapper around the RPC connection to the chain backend
+// bitcoinClient is a wrapper around the connection to the chain backend
 // and allows transactions to be queried.
 type bitcoinClient struct {
-	sync.Mutex
-
-	rpcClient *rpcclient.Client
+	rpcClient txLookup
 
-	// txDetailCache holds a cache of transactions we have previously looked
-	// up.
-	txDetailCache map[string]*btcjson.TxRawResult
+	// cache holds the confirmed transactions we have previously looked up.
+	cache *txCache
 }
 
 // GetTxDetail fetches a single transaction from the chain and returns it
@@ -53,10 +107,7 @@ type bitcoinClient struct {
 func (c *bitcoinClient) GetTxDetail(txHash *chainhash.Hash) (
 	*btcjson.TxRawResult, error) {
 
-	c.Lock()
-	cachedTx, ok := c.txDetailCache[txHash.String()]
-	c.Unlock()
-	if ok {
+	if cachedTx, ok := c.cache.get(*txHash); ok {
 		return cachedTx, nil
 	}
 
@@ -65,37 +116,144 @@ func (c *bitcoinClient) GetTxDetail(txHash *chainhash.Hash) (
 		return nil, err
 	}
 
-	// Do not cache the transaction if it has not confirmed yet. If we do,
-	// we won't ever lookup the confirmed transaction because it is already
-	// cached.
-	if tx.BlockHash == "" {
-		return tx, nil
+	c.maybeCache(txHash, tx)
+
+	return tx, nil
+}
+
+// GetTxDetails fetches a set of transactions from the chain, returning them
+// in the order that they were requested. Transactions that are not cached
+// are looked up in a single batch if our backend supports batching, and
+// individually if not.
+func (c *bitcoinClient) GetTxDetails(txHashes []*chainhash.Hash) (
+	[]*btcjson.TxRawResult, error) {
+
+	var (
+		txs     = make([]*btcjson.TxRawResult, len(txHashes))
+		missing []*chainhash.Hash
+		indexes = make(map[chainhash.Hash][]int)
+	)
+
+	for i, txHash := range txHashes {
+		if cachedTx, ok := c.cache.get(*txHash); ok {
+			txs[i] = cachedTx
+			continue
+		}
+
+		// Only look up each transaction once, even if it is requested
+		// multiple times.
+		if _, ok := indexes[*txHash]; !ok {
+			missing = append(missing, txHash)
+		}
+		indexes[*txHash] = append(indexes[*txHash], i)
 	}
 
-	c.Lock()
-	c.txDetailCache[txHash.String()] = tx
-	c.Unlock()
+	if len(missing) == 0 {
+		return txs, nil
+	}
 
-	return tx, nil
+	lookedUp, err := c.lookupBatch(missing)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, txHash := range missing {
+		c.maybeCache(txHash, lookedUp[i])
+
+		for _, index := range indexes[*txHash] {
+			txs[index] = lookedUp[i]
+		}
+	}
+
+	return txs, nil
+}
+
+// lookupBatch looks up a set of transactions from our backend, using a
+// single batch if it is supported.
+func (c *bitcoinClient) lookupBatch(txHashes []*chainhash.Hash) (
+	[]*btcjson.TxRawResult, error) {
+
+	if batch, ok := c.rpcClient.(batchTxLookup); ok {
+		return batch.GetRawTransactionsVerbose(txHashes)
+	}
+
+	txs := make([]*btcjson.TxRawResult, len(txHashes))
+	for i, txHash := range txHashes {
+		tx, err := c.rpcClient.GetRawTransactionVerbose(txHash)
+		if err != nil {
+			return nil, err
+		}
+
+		txs[i] = tx
+	}
+
+	return txs, nil
 }
 
-// NewBitcoinClient attempts to connect to a bitcoin rpcclient with the config
-// provided and returns a BitcoinClient wrapper which can be used to access the
-// chain connection.
-func NewBitcoinClient(cfg *BitcoinConfig) (BitcoinClient, error) {
-	client, err := getBitcoinConn(cfg)
+// maybeCache caches a transaction if it has confirmed. If we cache
+// unconfirmed transactions, we won't ever lookup the confirmed transaction
+// because it is already cached.
+func (c *bitcoinClient) maybeCache(txHash *chainhash.Hash,
+	tx *btcjson.TxRawResult) {
+
+	if tx.BlockHash == "" {
+		return
+	}
+
+	c.cache.add(*txHash, tx)
+}
+
+// NewBitcoinClient attempts to connect to the bitcoin backend selected by
+// the config provided and returns a BitcoinClient wrapper which can be used
+// to access the chain connection. If a cache database is provided, confirmed
+// transactions are persisted to it so that they do not need to be looked up
+// again after a restart.
+func NewBitcoinClient(cfg *BitcoinConfig,
+	cacheDB kvdb.Backend) (BitcoinClient, error) {
+
+	var (
+		client txLookup
+		err    error
+	)
+
+	switch cfg.Backend {
+	case BackendRPC, "":
+		client, err = newRPCClient(cfg)
+
+	case BackendEsplora:
+		if cfg.EsploraURL == "" {
+			return nil, ErrEsploraURLRequired
+		}
+
+		client = newEsploraClient(cfg.EsploraURL)
+
+	case BackendElectrum:
+		client, err = newElectrumClient(
+			cfg.Host, cfg.UseTLS, cfg.TLSPath,
+		)
+
+	default:
+		return nil, fmt.Errorf("%w: %v", ErrUnknownBackend, cfg.Backend)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	cache, err := newTxCache(cfg.TxCacheSize, cacheDB)
 	if err != nil {
+		client.Shutdown()
 		return nil, err
 	}
 
 	return &bitcoinClient{
-		rpcClient:     client,
-		txDetailCache: make(map[string]*btcjson.TxRawResult),
+		rpcClient: client,
+		cache:     cache,
 	}, nil
 }
 
-// getBitcoinConn gets a bitcoin rpc client from the config details provided.
-func getBitcoinConn(cfg *BitcoinConfig) (*rpcclient.Client, error) {
+// getBitcoinConn gets the config for a bitcoin rpc client from the config
+// details provided.
+func getBitcoinConn(cfg *BitcoinConfig) (*rpcclient.ConnConfig, error) {
 	// In case we use TLS and a certificate argument is provided, we need to
 	// read that file and provide it to the RPC connection as byte slice.
 	var rpcCert []byte
@@ -123,9 +281,7 @@ func getBitcoinConn(cfg *BitcoinConfig) (*rpcclient.Client, error) {
 		Certificates: rpcCert,
 	}
 
-	// Notice the notification parameter is nil since notifications are
-	// not supported in HTTP POST mode.
-	return rpcclient.New(connCfg, nil)
+	return connCfg, nil
 }
 
 // Stop closes the connection to the chain backend and should always be
@@ -0,0 +1,340 @@
+package chain
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// electrumTimeout is the timeout we use for requests to an electrum server.
+const electrumTimeout = time.Second * 30
+
+// electrumRequest is a json-rpc request to an electrum server.
+type electrumRequest struct {
+	JSONRPC string        `json:"jsonrpc"`
+	ID      uint64        `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+// electrumError is an error returned by an electrum server.
+type electrumError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// electrumResponse is a json-rpc response from an electrum server.
+// Notifications do not have an id, and are ignored.
+type electrumResponse struct {
+	ID     *uint64         `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *electrumError  `json:"error"`
+}
+
+// electrumHistory is an entry in the history of a script hash. Unconfirmed
+// transactions have a height of zero or less.
+type electrumHistory struct {
+	TxHash string `json:"tx_hash"`
+	Height int64  `json:"height"`
+}
+
+// electrumTip is the current chain tip reported by an electrum server.
+type electrumTip struct {
+	Height uint64 `json:"height"`
+}
+
+// electrumClient looks up transactions using the electrum protocol. Requests
+// are serialized over a single connection, which is re-established if it
+// fails.
+type electrumClient struct {
+	sync.Mutex
+
+	host string
+
+	// tlsConfig is set if we connect to the server over tls.
+	tlsConfig *tls.Config
+
+	conn   net.Conn
+	reader *bufio.Reader
+	nextID uint64
+}
+
+// newElectrumClient returns a client for the electrum server at the host
+// provided. If useTLS is set, we connect over tls, trusting the certificate
+// at tlsPath if it is provided, or our system's root certificates if not.
+func newElectrumClient(host string, useTLS bool,
+	tlsPath string) (*electrumClient, error) {
+
+	client := &electrumClient{
+		host: host,
+	}
+
+	if !useTLS {
+		return client, nil
+	}
+
+	client.tlsConfig = &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if tlsPath != "" {
+		cert, err := os.ReadFile(tlsPath)
+		if err != nil {
+			return nil, err
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(cert) {
+			return nil, fmt.Errorf("could not parse electrum "+
+				"certificate: %v", tlsPath)
+		}
+
+		client.tlsConfig.RootCAs = pool
+	}
+
+	return client, nil
+}
+
+// GetRawTransactionVerbose looks up a transaction and its confirmation
+// status, and returns it in the format that bitcoind would. Electrum servers
+// only index transactions by script, so we find the block that our
+// transaction confirmed in using the history of its outputs' scripts.
+func (e *electrumClient) GetRawTransactionVerbose(txHash *chainhash.Hash) (
+	*btcjson.TxRawResult, error) {
+
+	var rawTx string
+	err := e.call(
+		"blockchain.transaction.get", []interface{}{txHash.String()},
+		&rawTx,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := decodeRawTx(txHash, rawTx)
+	if err != nil {
+		return nil, err
+	}
+
+	conf, err := e.confirmation(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return txRawResult(tx, conf)
+}
+
+// confirmation looks up the block that a transaction confirmed in. If none
+// of the transaction's outputs have a history that includes it, the
+// transaction is treated as unconfirmed.
+func (e *electrumClient) confirmation(tx *wire.MsgTx) (*txConfirmation,
+	error) {
+
+	txid := tx.TxHash().String()
+
+	var height int64
+	for _, out := range tx.TxOut {
+		var history []electrumHistory
+		err := e.call(
+			"blockchain.scripthash.get_history",
+			[]interface{}{scriptHash(out.PkScript)}, &history,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entry := range history {
+			if entry.TxHash == txid {
+				height = entry.Height
+				break
+			}
+		}
+
+		if height != 0 {
+			break
+		}
+	}
+
+	if height <= 0 {
+		return &txConfirmation{}, nil
+	}
+
+	var headerHex string
+	err := e.call(
+		"blockchain.block.header", []interface{}{height}, &headerHex,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	headerBytes, err := hex.DecodeString(headerHex)
+	if err != nil {
+		return nil, err
+	}
+
+	header := &wire.BlockHeader{}
+	err = header.Deserialize(bytes.NewReader(headerBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	var tip electrumTip
+	err = e.call("blockchain.headers.subscribe", []interface{}{}, &tip)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txConfirmation{
+		blockHash:     header.BlockHash().String(),
+		confirmations: confirmations(tip.Height, uint64(height)),
+		blockTime:     header.Timestamp.Unix(),
+	}, nil
+}
+
+// Shutdown closes our connection to the electrum server.
+func (e *electrumClient) Shutdown() {
+	e.Lock()
+	defer e.Unlock()
+
+	e.disconnect()
+}
+
+// call makes a request to our electrum server and decodes its result into
+// the value provided.
+func (e *electrumClient) call(method string, params []interface{},
+	result interface{}) error {
+
+	e.Lock()
+	defer e.Unlock()
+
+	resp, err := e.roundTrip(method, params)
+	if err != nil {
+		// We drop our connection on failure so that we reconnect
+		// for our next request, rather than reading stale responses.
+		e.disconnect()
+
+		return fmt.Errorf("electrum %v: %w", method, err)
+	}
+
+	if resp.Error != nil {
+		return fmt.Errorf("electrum %v: %v (%v)", method,
+			resp.Error.Message, resp.Error.Code)
+	}
+
+	return json.Unmarshal(resp.Result, result)
+}
+
+// roundTrip sends a request to our server and waits for its response,
+// connecting to the server if we do not have a connection. It must be called
+// with the client's mutex held.
+func (e *electrumClient) roundTrip(method string,
+	params []interface{}) (*electrumResponse, error) {
+
+	if e.conn == nil {
+		if err := e.connect(); err != nil {
+			return nil, err
+		}
+	}
+
+	e.nextID++
+	req, err := json.Marshal(&electrumRequest{
+		JSONRPC: "2.0",
+		ID:      e.nextID,
+		Method:  method,
+		Params:  params,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.conn.SetDeadline(time.Now().Add(electrumTimeout))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := e.conn.Write(append(req, '\n')); err != nil {
+		return nil, err
+	}
+
+	// Servers may send notifications for our header subscription, so we
+	// skip any messages that are not a response to our request.
+	for {
+		line, err := e.reader.ReadBytes('\n')
+		if err != nil {
+			return nil, err
+		}
+
+		resp := &electrumResponse{}
+		if err := json.Unmarshal(line, resp); err != nil {
+			return nil, err
+		}
+
+		if resp.ID == nil || *resp.ID != e.nextID {
+			continue
+		}
+
+		return resp, nil
+	}
+}
+
+// connect dials our electrum server.
+func (e *electrumClient) connect() error {
+	dialer := &net.Dialer{
+		Timeout: electrumTimeout,
+	}
+
+	var (
+		conn net.Conn
+		err  error
+	)
+	if e.tlsConfig != nil {
+		conn, err = tls.DialWithDialer(
+			dialer, "tcp", e.host, e.tlsConfig,
+		)
+	} else {
+		conn, err = dialer.Dial("tcp", e.host)
+	}
+	if err != nil {
+		return err
+	}
+
+	e.conn = conn
+	e.reader = bufio.NewReader(conn)
+
+	return nil
+}
+
+// disconnect closes our connection, if we have one.
+func (e *electrumClient) disconnect() {
+	if e.conn == nil {
+		return
+	}
+
+	_ = e.conn.Close()
+	e.conn = nil
+	e.reader = nil
+}
+
+// scriptHash returns the electrum script hash for an output script, which
+// is the reversed sha256 of the script, hex encoded.
+func scriptHash(pkScript []byte) string {
+	hash := sha256.Sum256(pkScript)
+	for i, j := 0, len(hash)-1; i < j; i, j = i+1, j-1 {
+		hash[i], hash[j] = hash[j], hash[i]
+	}
+
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,168 @@
+package chain
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+)
+
+// stubElectrum serves electrum requests from a set of canned results, keyed
+// by method and json encoded params.
+type stubElectrum struct {
+	listener net.Listener
+	results  map[string]interface{}
+}
+
+// newStubElectrum starts a stub electrum server.
+func newStubElectrum(t *testing.T,
+	results map[string]interface{}) *stubElectrum {
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	stub := &stubElectrum{
+		listener: listener,
+		results:  results,
+	}
+	t.Cleanup(func() {
+		require.NoError(t, listener.Close())
+	})
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go stub.serve(conn)
+		}
+	}()
+
+	return stub
+}
+
+// serve responds to requests on a connection. We send a header notification
+// before each response to check that clients skip notifications.
+func (s *stubElectrum) serve(conn net.Conn) {
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil {
+			return
+		}
+
+		var req electrumRequest
+		if err := json.Unmarshal(line, &req); err != nil {
+			return
+		}
+
+		params, _ := json.Marshal(req.Params)
+
+		resp := map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      req.ID,
+		}
+
+		result, ok := s.results[req.Method+string(params)]
+		if ok {
+			resp["result"] = result
+		} else {
+			resp["error"] = map[string]interface{}{
+				"code":    2,
+				"message": "not found",
+			}
+		}
+
+		notification, _ := json.Marshal(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"method":  "blockchain.headers.subscribe",
+			"params":  []interface{}{map[string]int{"height": 1}},
+		})
+		respBytes, _ := json.Marshal(resp)
+
+		_, err = conn.Write(append(notification, '\n'))
+		if err != nil {
+			return
+		}
+
+		if _, err := conn.Write(append(respBytes, '\n')); err != nil {
+			return
+		}
+	}
+}
+
+// TestElectrum tests looking up transactions from a stub electrum server.
+func TestElectrum(t *testing.T) {
+	confirmedTx, confirmedHex := testTx(
+		t, wire.OutPoint{Hash: chainhash.Hash{1}, Index: 1}, 10_000,
+	)
+	confirmedHash := confirmedTx.TxHash()
+
+	pendingTx, pendingHex := testTx(
+		t, wire.OutPoint{Hash: confirmedHash}, 9_000,
+	)
+	pendingHash := pendingTx.TxHash()
+
+	header := &wire.BlockHeader{
+		Version:   1,
+		Timestamp: time.Unix(1_600_000_000, 0),
+	}
+	var headerBuf bytes.Buffer
+	require.NoError(t, header.Serialize(&headerBuf))
+
+	// Both of our transactions pay to the same script, so share a
+	// history.
+	history := []electrumHistory{
+		{TxHash: confirmedHash.String(), Height: 100},
+		{TxHash: pendingHash.String(), Height: 0},
+	}
+	var (
+		getTx      = "blockchain.transaction.get"
+		getHistory = "blockchain.scripthash.get_history"
+		script     = scriptHash(confirmedTx.TxOut[0].PkScript)
+	)
+
+	stub := newStubElectrum(t, map[string]interface{}{
+		getTx + `["` + confirmedHash.String() + `"]`: confirmedHex,
+		getTx + `["` + pendingHash.String() + `"]`:   pendingHex,
+		getHistory + `["` + script + `"]`:            history,
+		"blockchain.block.header[100]": hex.EncodeToString(
+			headerBuf.Bytes(),
+		),
+		"blockchain.headers.subscribe[]": electrumTip{Height: 109},
+	})
+
+	client, err := NewBitcoinClient(&BitcoinConfig{
+		Backend: BackendElectrum,
+		Host:    stub.listener.Addr().String(),
+	}, nil)
+	require.NoError(t, err)
+
+	tx, err := client.GetTxDetail(&confirmedHash)
+	require.NoError(t, err)
+	require.Equal(t, confirmedHash.String(), tx.Txid)
+	require.Equal(t, header.BlockHash().String(), tx.BlockHash)
+	require.EqualValues(t, 10, tx.Confirmations)
+	require.EqualValues(t, 1_600_000_000, tx.Blocktime)
+	require.Len(t, tx.Vout, 1)
+
+	tx, err = client.GetTxDetail(&pendingHash)
+	require.NoError(t, err)
+	require.Empty(t, tx.BlockHash)
+	require.Zero(t, tx.Confirmations)
+
+	// Errors returned by the server should be surfaced.
+	_, err = client.GetTxDetail(&chainhash.Hash{2})
+	require.ErrorContains(t, err, "not found")
+}
@@ -0,0 +1,129 @@
+package chain
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// esploraTimeout is the timeout we use for requests to an esplora server.
+const esploraTimeout = time.Second * 30
+
+// esploraStatus is the confirmation status that esplora returns for a
+// transaction.
+type esploraStatus struct {
+	Confirmed   bool   `json:"confirmed"`
+	BlockHeight uint64 `json:"block_height"`
+	BlockHash   string `json:"block_hash"`
+	BlockTime   int64  `json:"block_time"`
+}
+
+// esploraClient looks up transactions using an esplora compatible REST api.
+type esploraClient struct {
+	// url is the base url of the api, for example
+	// https://blockstream.info/api.
+	url string
+
+	client *http.Client
+}
+
+// newEsploraClient returns a client for the esplora api at the url
+// provided.
+func newEsploraClient(url string) *esploraClient {
+	return &esploraClient{
+		url: strings.TrimSuffix(url, "/"),
+		client: &http.Client{
+			Timeout: esploraTimeout,
+		},
+	}
+}
+
+// GetRawTransactionVerbose looks up a transaction and its confirmation
+// status, and returns it in the format that bitcoind would.
+func (e *esploraClient) GetRawTransactionVerbose(txHash *chainhash.Hash) (
+	*btcjson.TxRawResult, error) {
+
+	rawTx, err := e.get(fmt.Sprintf("/tx/%v/hex", txHash))
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := decodeRawTx(txHash, strings.TrimSpace(string(rawTx)))
+	if err != nil {
+		return nil, err
+	}
+
+	statusBytes, err := e.get(fmt.Sprintf("/tx/%v/status", txHash))
+	if err != nil {
+		return nil, err
+	}
+
+	var status esploraStatus
+	if err := json.Unmarshal(statusBytes, &status); err != nil {
+		return nil, err
+	}
+
+	conf := &txConfirmation{}
+	if status.Confirmed {
+		tipBytes, err := e.get("/blocks/tip/height")
+		if err != nil {
+			return nil, err
+		}
+
+		tip, err := strconv.ParseUint(
+			strings.TrimSpace(string(tipBytes)), 10, 64,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		conf.blockHash = status.BlockHash
+		conf.blockTime = status.BlockTime
+		conf.confirmations = confirmations(tip, status.BlockHeight)
+	}
+
+	return txRawResult(tx, conf)
+}
+
+// Shutdown is a no-op for esplora, because we do not hold a connection
+// open.
+func (e *esploraClient) Shutdown() {}
+
+// get performs a GET request for the api path provided and returns the
+// response body.
+func (e *esploraClient) get(path string) ([]byte, error) {
+	resp, err := e.client.Get(e.url + path)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("esplora %v: %v: %v", path, resp.Status,
+			strings.TrimSpace(string(body)))
+	}
+
+	return body, nil
+}
+
+// confirmations returns the number of confirmations that a transaction
+// confirmed at the height provided has, given our current chain tip.
+func confirmations(tip, height uint64) uint64 {
+	if height > tip {
+		return 0
+	}
+
+	return tip - height + 1
+}
@@ -0,0 +1,121 @@
+package chain
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+)
+
+// testTx returns a transaction spending the outpoint provided to a single
+// output, and its hex encoding.
+func testTx(t *testing.T, prevOut wire.OutPoint, value int64) (*wire.MsgTx,
+	string) {
+
+	tx := &wire.MsgTx{
+		Version: 2,
+		TxIn: []*wire.TxIn{
+			{
+				PreviousOutPoint: prevOut,
+				SignatureScript:  []byte{1},
+				Sequence:         wire.MaxTxInSequenceNum,
+			},
+		},
+		TxOut: []*wire.TxOut{
+			{
+				Value:    value,
+				PkScript: []byte{0, 20, 1, 2, 3},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, tx.Serialize(&buf))
+
+	return tx, hex.EncodeToString(buf.Bytes())
+}
+
+// TestEsplora tests looking up transactions from a stub esplora server.
+func TestEsplora(t *testing.T) {
+	confirmedTx, confirmedHex := testTx(
+		t, wire.OutPoint{Hash: chainhash.Hash{1}, Index: 1}, 10_000,
+	)
+	confirmedHash := confirmedTx.TxHash()
+
+	pendingTx, pendingHex := testTx(
+		t, wire.OutPoint{Hash: confirmedHash}, 9_000,
+	)
+	pendingHash := pendingTx.TxHash()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/tx/"+confirmedHash.String()+"/hex",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, confirmedHex)
+		},
+	)
+	mux.HandleFunc("/api/tx/"+confirmedHash.String()+"/status",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"confirmed":true,"block_height":100,`+
+				`"block_hash":"abcd","block_time":1600000000}`)
+		},
+	)
+	mux.HandleFunc("/api/tx/"+pendingHash.String()+"/hex",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, pendingHex)
+		},
+	)
+	mux.HandleFunc("/api/tx/"+pendingHash.String()+"/status",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"confirmed":false}`)
+		},
+	)
+	mux.HandleFunc("/api/blocks/tip/height",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, "105")
+		},
+	)
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := NewBitcoinClient(&BitcoinConfig{
+		Backend:    BackendEsplora,
+		EsploraURL: server.URL + "/api/",
+	}, nil)
+	require.NoError(t, err)
+
+	tx, err := client.GetTxDetail(&confirmedHash)
+	require.NoError(t, err)
+	require.Equal(t, confirmedHash.String(), tx.Txid)
+	require.Equal(t, confirmedHex, tx.Hex)
+	require.Equal(t, "abcd", tx.BlockHash)
+	require.EqualValues(t, 6, tx.Confirmations)
+	require.EqualValues(t, 1_600_000_000, tx.Blocktime)
+	require.Len(t, tx.Vin, 1)
+	require.Equal(t, chainhash.Hash{1}.String(), tx.Vin[0].Txid)
+	require.EqualValues(t, 1, tx.Vin[0].Vout)
+	require.Len(t, tx.Vout, 1)
+	require.Equal(t, btcutil.Amount(10_000).ToBTC(), tx.Vout[0].Value)
+
+	tx, err = client.GetTxDetail(&pendingHash)
+	require.NoError(t, err)
+	require.Empty(t, tx.BlockHash)
+	require.Zero(t, tx.Confirmations)
+
+	// Unknown transactions should fail.
+	_, err = client.GetTxDetail(&chainhash.Hash{2})
+	require.Error(t, err)
+
+	// The esplora backend requires an api url.
+	_, err = NewBitcoinClient(&BitcoinConfig{
+		Backend: BackendEsplora,
+	}, nil)
+	require.ErrorIs(t, err, ErrEsploraURLRequired)
+}
@@ -0,0 +1,123 @@
+package chain
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// txConfirmation describes the block that a transaction confirmed in. If
+// the transaction is unconfirmed, the block hash is empty.
+type txConfirmation struct {
+	blockHash     string
+	confirmations uint64
+	blockTime     int64
+}
+
+// decodeRawTx decodes a hex encoded transaction and checks that it has the
+// hash that we expect.
+func decodeRawTx(txHash *chainhash.Hash, rawTx string) (*wire.MsgTx, error) {
+	txBytes, err := hex.DecodeString(rawTx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &wire.MsgTx{}
+	if err := tx.Deserialize(bytes.NewReader(txBytes)); err != nil {
+		return nil, err
+	}
+
+	if hash := tx.TxHash(); hash != *txHash {
+		return nil, fmt.Errorf("backend returned tx: %v for %v", hash,
+			txHash)
+	}
+
+	return tx, nil
+}
+
+// txRawResult produces the verbose transaction that bitcoind would return
+// for a transaction, so that backends which only provide raw transactions
+// can be used in place of bitcoind.
+func txRawResult(tx *wire.MsgTx, conf *txConfirmation) (
+	*btcjson.TxRawResult, error) {
+
+	var buf bytes.Buffer
+	if err := tx.Serialize(&buf); err != nil {
+		return nil, err
+	}
+
+	weight := blockchain.GetTransactionWeight(btcutil.NewTx(tx))
+	vsize := (weight + blockchain.WitnessScaleFactor - 1) /
+		blockchain.WitnessScaleFactor
+
+	result := &btcjson.TxRawResult{
+		Hex:           hex.EncodeToString(buf.Bytes()),
+		Txid:          tx.TxHash().String(),
+		Hash:          tx.WitnessHash().String(),
+		Size:          int32(tx.SerializeSize()),
+		Vsize:         int32(vsize),
+		Weight:        int32(weight),
+		Version:       uint32(tx.Version),
+		LockTime:      tx.LockTime,
+		Vin:           make([]btcjson.Vin, len(tx.TxIn)),
+		Vout:          make([]btcjson.Vout, len(tx.TxOut)),
+		BlockHash:     conf.blockHash,
+		Confirmations: conf.confirmations,
+		Time:          conf.blockTime,
+		Blocktime:     conf.blockTime,
+	}
+
+	isCoinbase := blockchain.IsCoinBaseTx(tx)
+	for i, in := range tx.TxIn {
+		vin := btcjson.Vin{
+			Sequence: in.Sequence,
+		}
+
+		for _, witness := range in.Witness {
+			vin.Witness = append(
+				vin.Witness, hex.EncodeToString(witness),
+			)
+		}
+
+		if isCoinbase {
+			vin.Coinbase = hex.EncodeToString(in.SignatureScript)
+			result.Vin[i] = vin
+
+			continue
+		}
+
+		disasm, _ := txscript.DisasmString(in.SignatureScript)
+
+		vin.Txid = in.PreviousOutPoint.Hash.String()
+		vin.Vout = in.PreviousOutPoint.Index
+		vin.ScriptSig = &btcjson.ScriptSig{
+			Asm: disasm,
+			Hex: hex.EncodeToString(in.SignatureScript),
+		}
+		result.Vin[i] = vin
+	}
+
+	for i, out := range tx.TxOut {
+		disasm, _ := txscript.DisasmString(out.PkScript)
+		class := txscript.GetScriptClass(out.PkScript)
+
+		result.Vout[i] = btcjson.Vout{
+			Value: btcutil.Amount(out.Value).ToBTC(),
+			N:     uint32(i),
+			ScriptPubKey: btcjson.ScriptPubKeyResult{
+				Asm:  disasm,
+				Hex:  hex.EncodeToString(out.PkScript),
+				Type: class.String(),
+			},
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,122 @@
+package chain
+
+import (
+	"sync"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/rpcclient"
+)
+
+// rpcBackend looks up transactions from bitcoind or btcd's json-rpc api.
+type rpcBackend struct {
+	*rpcclient.Client
+
+	// batch is a client that queues requests until they are sent in a
+	// single json-rpc batch. It is nil if we are not in HTTP POST mode,
+	// because batching is only supported over HTTP POST.
+	batch *rpcclient.Client
+
+	// batchMtx ensures that only one batch is queued on our batch client
+	// at a time.
+	batchMtx sync.Mutex
+}
+
+// newRPCClient connects to bitcoind or btcd's json-rpc api.
+func newRPCClient(cfg *BitcoinConfig) (*rpcBackend, error) {
+	connCfg, err := getBitcoinConn(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Notice the notification parameter is nil since notifications are
+	// not supported in HTTP POST mode.
+	client, err := rpcclient.New(connCfg, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	backend := &rpcBackend{
+		Client: client,
+	}
+
+	if !cfg.HTTPPostMode {
+		return backend, nil
+	}
+
+	backend.batch, err = rpcclient.NewBatch(connCfg)
+	if err != nil {
+		client.Shutdown()
+		return nil, err
+	}
+
+	return backend, nil
+}
+
+// GetRawTransactionsVerbose looks up a set of transactions in a single
+// json-rpc batch if we have a batch client. Otherwise, we pipeline the
+// requests on our websocket connection.
+func (r *rpcBackend) GetRawTransactionsVerbose(txHashes []*chainhash.Hash) (
+	[]*btcjson.TxRawResult, error) {
+
+	if r.batch == nil {
+		return receiveAll(r.Client, txHashes)
+	}
+
+	r.batchMtx.Lock()
+	defer r.batchMtx.Unlock()
+
+	futures := make(
+		[]rpcclient.FutureGetRawTransactionVerboseResult, len(txHashes),
+	)
+	for i, txHash := range txHashes {
+		futures[i] = r.batch.GetRawTransactionVerboseAsync(txHash)
+	}
+
+	if err := r.batch.Send(); err != nil {
+		return nil, err
+	}
+
+	return receive(futures)
+}
+
+// Shutdown shuts down our clients.
+func (r *rpcBackend) Shutdown() {
+	r.Client.Shutdown()
+
+	if r.batch != nil {
+		r.batch.Shutdown()
+	}
+}
+
+// receiveAll sends a request for each transaction before waiting for any
+// of the responses.
+func receiveAll(client *rpcclient.Client, txHashes []*chainhash.Hash) (
+	[]*btcjson.TxRawResult, error) {
+
+	futures := make(
+		[]rpcclient.FutureGetRawTransactionVerboseResult, len(txHashes),
+	)
+	for i, txHash := range txHashes {
+		futures[i] = client.GetRawTransactionVerboseAsync(txHash)
+	}
+
+	return receive(futures)
+}
+
+// receive waits for the responses to a set of transaction lookups.
+func receive(futures []rpcclient.FutureGetRawTransactionVerboseResult) (
+	[]*btcjson.TxRawResult, error) {
+
+	txs := make([]*btcjson.TxRawResult, len(futures))
+	for i, future := range futures {
+		tx, err := future.Receive()
+		if err != nil {
+			return nil, err
+		}
+
+		txs[i] = tx
+	}
+
+	return txs, nil
+}
@@ -0,0 +1,101 @@
+package chain
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRPCBatch tests that transactions are looked up from bitcoind in a
+// single json-rpc batch, and that confirmed transactions are cached.
+func TestRPCBatch(t *testing.T) {
+	var (
+		hash0 = chainhash.Hash{0}
+		hash1 = chainhash.Hash{1}
+
+		txs = map[string]*btcjson.TxRawResult{
+			hash0.String(): {
+				Txid:      hash0.String(),
+				BlockHash: "abcd",
+			},
+			hash1.String(): {
+				Txid: hash1.String(),
+			},
+		}
+
+		requests int32
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+
+			var batch []btcjson.Request
+			err := json.NewDecoder(r.Body).Decode(&batch)
+			if err != nil {
+				http.Error(
+					w, err.Error(), http.StatusBadRequest,
+				)
+				return
+			}
+
+			resps := make([]map[string]interface{}, len(batch))
+			for i, req := range batch {
+				var txid string
+				_ = json.Unmarshal(req.Params[0], &txid)
+
+				resps[i] = map[string]interface{}{
+					"jsonrpc": "2.0",
+					"id":      req.ID,
+					"result":  txs[txid],
+				}
+			}
+
+			_ = json.NewEncoder(w).Encode(resps)
+		},
+	))
+	t.Cleanup(server.Close)
+
+	client, err := NewBitcoinClient(&BitcoinConfig{
+		Backend:      BackendRPC,
+		Host:         strings.TrimPrefix(server.URL, "http://"),
+		User:         "user",
+		Password:     "password",
+		HTTPPostMode: true,
+	}, nil)
+	require.NoError(t, err)
+	t.Cleanup(client.(*bitcoinClient).Stop)
+
+	// Request our confirmed transaction twice, we expect it to be looked
+	// up once and returned in both positions.
+	details, err := client.GetTxDetails(
+		[]*chainhash.Hash{&hash0, &hash1, &hash0},
+	)
+	require.NoError(t, err)
+	require.Len(t, details, 3)
+	require.Equal(t, hash0.String(), details[0].Txid)
+	require.Equal(t, hash1.String(), details[1].Txid)
+	require.Equal(t, hash0.String(), details[2].Txid)
+	require.EqualValues(t, 1, atomic.LoadInt32(&requests))
+
+	// Our confirmed transaction is cached, so we don't expect another
+	// request for it.
+	tx, err := client.GetTxDetail(&hash0)
+	require.NoError(t, err)
+	require.Equal(t, "abcd", tx.BlockHash)
+	require.EqualValues(t, 1, atomic.LoadInt32(&requests))
+
+	// Our unconfirmed transaction is not cached, so it will be looked up
+	// again.
+	details, err = client.GetTxDetails([]*chainhash.Hash{&hash1})
+	require.NoError(t, err)
+	require.Equal(t, hash1.String(), details[0].Txid)
+	require.EqualValues(t, 2, atomic.LoadInt32(&requests))
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/urfave/cli"
+)
+
+var subscribeAlertsCommand = cli.Command{
+	Name:     "subscribealerts",
+	Category: "insights",
+	Usage:    "Stream the alerts raised by faraday's alert rules.",
+	Description: `
+	Subscribe to the alerts raised by faraday's alert rules, printing
+	each alert as it is raised until the command is interrupted. Alert
+	rules are enabled with faraday's alerts options.`,
+	Action: subscribeAlerts,
+}
+
+func subscribeAlerts(ctx *cli.Context) error {
+	client, cleanup := getClient(ctx)
+	defer cleanup()
+
+	rpcCtx := context.Background()
+	stream, err := client.SubscribeAlerts(
+		rpcCtx, &frdrpc.SubscribeAlertsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		alert, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(alert)
+	}
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/urfave/cli"
+)
+
+var bakeMacaroonCommand = cli.Command{
+	Name:      "bakemacaroon",
+	Category:  "macaroons",
+	Usage:     "Bake a macaroon with restricted permissions.",
+	ArgsUsage: "entity [entity ...]",
+	Description: `
+	Bake a macaroon that only grants access to the entities provided,
+	which may be any of audit, report, insights, rates, recommendation
+	and macaroon. Audits made with the macaroon can be restricted to a
+	time range, and fiat values can be stripped from its responses.
+
+	For example, a read only macaroon that can only audit 2023 without
+	fiat values can be baked with:
+	frcli bakemacaroon --read_only --audit_start_time=1672531200 \
+		--audit_end_time=1704067200 --strip_fiat audit`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name: "read_only",
+			Usage: "Only grant read access to the entities " +
+				"provided.",
+		},
+		cli.Uint64Flag{
+			Name: "root_key_id",
+			Usage: "The root key ID used to create the " +
+				"macaroon.",
+		},
+		cli.Int64Flag{
+			Name: "audit_start_time",
+			Usage: "(optional) The unix timestamp in seconds " +
+				"from which the macaroon may request " +
+				"audits, inclusive.",
+		},
+		cli.Int64Flag{
+			Name: "audit_end_time",
+			Usage: "(optional) The unix timestamp in seconds " +
+				"until which the macaroon may request " +
+				"audits, exclusive. If audit_start_time is " +
+				"set and this flag is not, audits may be " +
+				"requested until the present.",
+		},
+		cli.BoolFlag{
+			Name: "strip_fiat",
+			Usage: "Strip fiat values from the responses of the " +
+				"macaroon's requests.",
+		},
+		cli.StringFlag{
+			Name: "save_to",
+			Usage: "(optional) The file to write the macaroon " +
+				"to. If not set, the hex encoded macaroon " +
+				"is printed.",
+		},
+	},
+	Action: bakeMacaroon,
+}
+
+func bakeMacaroon(ctx *cli.Context) error {
+	if ctx.NArg() == 0 {
+		return errors.New("at least one entity required")
+	}
+
+	client, cleanup := getClient(ctx)
+	defer cleanup()
+
+	rpcCtx := context.Background()
+	resp, err := client.BakeMacaroon(rpcCtx, &frdrpc.BakeMacaroonRequest{
+		Entities:       ctx.Args(),
+		ReadOnly:       ctx.Bool("read_only"),
+		RootKeyId:      ctx.Uint64("root_key_id"),
+		AuditStartTime: uint64(ctx.Int64("audit_start_time")),
+		AuditEndTime:   uint64(ctx.Int64("audit_end_time")),
+		StripFiat:      ctx.Bool("strip_fiat"),
+	})
+	if err != nil {
+		return err
+	}
+
+	saveTo := ctx.String("save_to")
+	if saveTo == "" {
+		fmt.Println(resp.Macaroon)
+		return nil
+	}
+
+	macBytes, err := hex.DecodeString(resp.Macaroon)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(saveTo, macBytes, 0600); err != nil {
+		return err
+	}
+
+	fmt.Printf("Macaroon saved to %v\n", saveTo)
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/urfave/cli"
+)
+
+var balanceSheetCommand = cli.Command{
+	Name:     "balancesheet",
+	Category: "reporting",
+	Usage:    "Get a balance sheet of node holdings at a point in time.",
+	Description: `
+	Get our node's confirmed on chain balance, local channel balances,
+	funds in pending closes and unsettled htlcs at a point in time.
+	Balances in the past are reconstructed from our current balances and
+	the node activity since then. Fiat values can optionally be included
+	using the --enable_fiat flag.`,
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "timestamp",
+			Usage: "(optional) The unix timestamp in seconds at " +
+				"which the balance sheet should be produced. " +
+				"If not set, the balance sheet will be " +
+				"produced for the present.",
+		},
+		cli.BoolFlag{
+			Name:  "enable_fiat",
+			Usage: "Create a balance sheet with fiat conversions.",
+		},
+		fiatBackendFlag,
+		cli.StringFlag{
+			Name: "prices_csv_path",
+			Usage: "Path to a CSV file containing custom fiat " +
+				"price data. This is only required if " +
+				"'fiat_backend' is set to 'custom'.",
+		},
+		cli.StringFlag{
+			Name: "custom_price_currency",
+			Usage: "The currency that the custom prices are " +
+				"quoted in. This is only required if " +
+				"'fiat_backend' is set to 'custom'.",
+		},
+	},
+	Action: queryBalanceSheet,
+}
+
+func queryBalanceSheet(ctx *cli.Context) error {
+	client, cleanup := getClient(ctx)
+	defer cleanup()
+
+	fiatBackend, err := parseFiatBackend(ctx.String("fiat_backend"))
+	if err != nil {
+		return err
+	}
+
+	req := &frdrpc.BalanceSheetRequest{
+		Timestamp:   uint64(ctx.Int64("timestamp")),
+		DisableFiat: !ctx.IsSet("enable_fiat"),
+		FiatBackend: fiatBackend,
+	}
+
+	if fiatBackend == frdrpc.FiatBackend_CUSTOM {
+		customPrices, err := parsePricesFromCSV(
+			ctx.String("prices_csv_path"),
+			ctx.String("custom_price_currency"),
+		)
+		if err != nil {
+			return err
+		}
+
+		timestamp := ctx.Int64("timestamp")
+		if timestamp == 0 {
+			timestamp = time.Now().Unix()
+		}
+
+		req.CustomPrices, err = filterPrices(
+			customPrices, timestamp, timestamp,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	rpcCtx := context.Background()
+	resp, err := client.BalanceSheet(rpcCtx, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
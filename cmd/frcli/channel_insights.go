@@ -12,6 +12,9 @@ var channelInsightsCommand = cli.Command{
 	Category: "insights",
 	Usage: "List currently open channel with routing and " +
 		"uptime information.",
+	Flags: append([]cli.Flag{
+		feeAttributionFlag,
+	}, sortFlags...),
 	Action: queryChannelInsights,
 }
 
@@ -27,12 +30,24 @@ type insightsResp struct {
 }
 
 func queryChannelInsights(ctx *cli.Context) error {
+	attribution, err := parseFeeAttribution(ctx)
+	if err != nil {
+		return err
+	}
+
+	format, err := parseFormat(ctx)
+	if err != nil {
+		return err
+	}
+
 	client, cleanup := getClient(ctx)
 	defer cleanup()
 
 	rpcCtx := context.Background()
 	resp, err := client.ChannelInsights(
-		rpcCtx, &frdrpc.ChannelInsightsRequest{},
+		rpcCtx, &frdrpc.ChannelInsightsRequest{
+			FeeAttribution: attribution,
+		},
 	)
 	if err != nil {
 		return err
@@ -70,7 +85,36 @@ func queryChannelInsights(ctx *cli.Context) error {
 		insights[i] = insight
 	}
 
-	printJSON(insights)
+	if format == "" || format == formatJSON {
+		printJSON(insights)
+		return nil
+	}
+
+	return printTable(ctx, insightsTable(insights), format)
+}
+
+// insightsTable returns a table with a row for each channel's insights.
+func insightsTable(insights []insightsResp) *table {
+	t := newTable(
+		"chan_point", "private", "confirmations", "uptime_ratio",
+		"monitored_seconds", "uptime_seconds", "volume_incoming_msat",
+		"volume_outgoing_msat", "fees_earned_msat",
+		"revenue_per_conf_msat", "volume_per_conf_msat",
+		"incoming_vol_per_conf_msat", "outgoing_vol_per_conf_msat",
+	)
+
+	for _, insight := range insights {
+		t.addRow(
+			insight.ChanPoint, insight.Private,
+			insight.Confirmations, insight.UptimeRatio,
+			insight.MonitoredSeconds, insight.UptimeSeconds,
+			insight.VolumeIncomingMsat, insight.VolumeOutgoingMsat,
+			insight.FeesEarnedMsat, insight.RevenuePerConfirmation,
+			insight.VolumePerConfirmation,
+			insight.IncomingVolumePerConfirmation,
+			insight.OutgoingVolumePerConfirmation,
+		)
+	}
 
-	return nil
+	return t
 }
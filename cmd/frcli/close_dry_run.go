@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/urfave/cli"
+)
+
+var closeDryRunCommand = cli.Command{
+	Name:     "closedryrun",
+	Category: "recommendations",
+	Usage: "Simulate closing a set of channels to estimate close fees " +
+		"and lost revenue.",
+	Description: `
+	Estimate the on chain fees we would pay to close a set of channels
+	cooperatively or by force closing them, and project the forwarding
+	revenue we would lose based on the pairwise flows that include the
+	closed channels. Flows are marked as reroutable if the closed
+	channels' peers have other channels with our node.`,
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name: "chan_points",
+			Usage: "The set of channels to simulate closing. A " +
+				"single channel can be set directly using " +
+				"--chan_points=txid:outpoint, multiple " +
+				"channels should be specified using a comma " +
+				"separated list in braces " +
+				"--chan_points={chan, chan}",
+		},
+		cli.Int64Flag{
+			Name: "conf_target",
+			Usage: "(optional) The confirmation target to " +
+				"estimate close fees for, defaults to 6 " +
+				"blocks.",
+		},
+		cli.Int64Flag{
+			Name: "start_time",
+			Usage: "(optional) The unix timestamp in seconds " +
+				"from which forwarding history is used to " +
+				"project lost revenue. If not set, the last " +
+				"30 days of forwards are used.",
+		},
+		cli.Int64Flag{
+			Name: "end_time",
+			Usage: "(optional) The unix timestamp in seconds " +
+				"until which forwarding history is used to " +
+				"project lost revenue. If not set, the " +
+				"present is used.",
+		},
+	},
+	Action: queryCloseDryRun,
+}
+
+func queryCloseDryRun(ctx *cli.Context) error {
+	// Show command help if no channels were provided.
+	if !ctx.IsSet("chan_points") {
+		return cli.ShowCommandHelp(ctx, "closedryrun")
+	}
+
+	client, cleanup := getClient(ctx)
+	defer cleanup()
+
+	req := &frdrpc.CloseDryRunRequest{
+		ChanPoints: ctx.StringSlice("chan_points"),
+		ConfTarget: int32(ctx.Int64("conf_target")),
+		StartTime:  uint64(ctx.Int64("start_time")),
+		EndTime:    uint64(ctx.Int64("end_time")),
+	}
+
+	rpcCtx := context.Background()
+	resp, err := client.CloseDryRun(rpcCtx, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -53,6 +53,7 @@ var (
 				"identified for close",
 		},
 		monitoredFlag,
+		feeAttributionFlag,
 	}
 
 	// Flags required for outlier close recommendations.
@@ -63,7 +64,35 @@ var (
 				"inter quartile ranges a channel should be " +
 				"from quartiles to be considered an outlier. " +
 				"Recommended values are 1.5 for aggressive " +
-				"recommendations and 3 for conservative ones.",
+				"recommendations and 3 for conservative ones. " +
+				"Also used for the log_iqr method.",
+		},
+		cli.StringFlag{
+			Name: "method",
+			Usage: "(optional) the method used to identify " +
+				"outliers: iqr, modified_zscore, percentile " +
+				"or log_iqr. Log_iqr is suited to heavy-tailed " +
+				"metrics such as revenue.",
+			Value: "iqr",
+		},
+		cli.Float64Flag{
+			Name: "modified_zscore",
+			Usage: "(optional with modified_zscore method) the " +
+				"absolute modified z-score above which a " +
+				"channel is considered an outlier, defaults " +
+				"to 3.5",
+		},
+		cli.Float64Flag{
+			Name: "lower_percentile",
+			Usage: "(optional with percentile method) the " +
+				"percentile beneath which channels are lower " +
+				"outliers, expressed in [0;100], defaults to 5",
+		},
+		cli.Float64Flag{
+			Name: "upper_percentile",
+			Usage: "(optional with percentile method) the " +
+				"percentile above which channels are upper " +
+				"outliers, expressed in [0;100], defaults to 95",
 		},
 		cli.BoolFlag{
 			Name: "uptime",
@@ -92,6 +121,7 @@ var (
 				"channel's total volume per confirmation",
 		},
 		monitoredFlag,
+		feeAttributionFlag,
 	}
 )
 
@@ -100,11 +130,21 @@ var thresholdRecommendationCommand = cli.Command{
 	Category: "recommendations",
 	Usage: "Get close recommendations for currently open channels " +
 		"based on whether they are below a set threshold.",
-	Flags:  thresholdFlags,
+	Flags:  append(thresholdFlags, sortFlags...),
 	Action: queryThresholdRecommendations,
 }
 
 func queryThresholdRecommendations(ctx *cli.Context) error {
+	attribution, err := parseFeeAttribution(ctx)
+	if err != nil {
+		return err
+	}
+
+	format, err := parseFormat(ctx)
+	if err != nil {
+		return err
+	}
+
 	client, cleanup := getClient(ctx)
 	defer cleanup()
 
@@ -113,6 +153,7 @@ func queryThresholdRecommendations(ctx *cli.Context) error {
 	req := &frdrpc.ThresholdRecommendationsRequest{
 		RecRequest: &frdrpc.CloseRecommendationRequest{
 			MinimumMonitored: ctx.Int64("min_monitored"),
+			FeeAttribution:   attribution,
 		},
 	}
 
@@ -148,9 +189,7 @@ func queryThresholdRecommendations(ctx *cli.Context) error {
 		return err
 	}
 
-	printRespJSON(recs)
-
-	return nil
+	return printRecommendations(ctx, recs, format)
 }
 
 var outlierRecommendationCommand = cli.Command{
@@ -158,11 +197,21 @@ var outlierRecommendationCommand = cli.Command{
 	Category: "recommendations",
 	Usage: "Get close recommendations for currently open channels " +
 		"based on whether it is an outlier.",
-	Flags:  outlierFlags,
+	Flags:  append(outlierFlags, sortFlags...),
 	Action: queryOutlierRecommendations,
 }
 
 func queryOutlierRecommendations(ctx *cli.Context) error {
+	attribution, err := parseFeeAttribution(ctx)
+	if err != nil {
+		return err
+	}
+
+	format, err := parseFormat(ctx)
+	if err != nil {
+		return err
+	}
+
 	client, cleanup := getClient(ctx)
 	defer cleanup()
 
@@ -173,6 +222,7 @@ func queryOutlierRecommendations(ctx *cli.Context) error {
 	req := &frdrpc.OutlierRecommendationsRequest{
 		RecRequest: &frdrpc.CloseRecommendationRequest{
 			MinimumMonitored: ctx.Int64("min_monitored"),
+			FeeAttribution:   attribution,
 		},
 		OutlierMultiplier: float32(defaultOutlierMultiplier),
 	}
@@ -182,6 +232,28 @@ func queryOutlierRecommendations(ctx *cli.Context) error {
 		req.OutlierMultiplier = float32(ctx.Float64("outlier_mult"))
 	}
 
+	// Set the outlier method and its parameters.
+	switch ctx.String("method") {
+	case "iqr":
+		req.Method = frdrpc.OutlierRecommendationsRequest_IQR
+
+	case "log_iqr":
+		req.Method = frdrpc.OutlierRecommendationsRequest_LOG_IQR
+
+	case "modified_zscore":
+		req.Method = frdrpc.OutlierRecommendationsRequest_MODIFIED_Z_SCORE
+		req.ModifiedZScore = float32(ctx.Float64("modified_zscore"))
+
+	case "percentile":
+		req.Method = frdrpc.OutlierRecommendationsRequest_PERCENTILE
+		req.LowerPercentile = float32(ctx.Float64("lower_percentile"))
+		req.UpperPercentile = float32(ctx.Float64("upper_percentile"))
+
+	default:
+		return fmt.Errorf("unknown outlier method: %v",
+			ctx.String("method"))
+	}
+
 	// Set metric based on uptime or revenue flags.
 	switch {
 	case ctx.IsSet("uptime"):
@@ -210,7 +282,22 @@ func queryOutlierRecommendations(ctx *cli.Context) error {
 		return err
 	}
 
-	printRespJSON(recs)
+	return printRecommendations(ctx, recs, format)
+}
+
+// printRecommendations prints close recommendations in the format provided.
+func printRecommendations(ctx *cli.Context,
+	recs *frdrpc.CloseRecommendationsResponse, format string) error {
+
+	if format == "" || format == formatJSON {
+		printRespJSON(recs)
+		return nil
+	}
+
+	t := newTable("chan_point", "value", "recommend_close")
+	for _, rec := range recs.Recommendations {
+		t.addRow(rec.ChanPoint, rec.Value, rec.RecommendClose)
+	}
 
-	return nil
+	return printTable(ctx, t, format)
 }
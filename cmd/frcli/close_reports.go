@@ -25,11 +25,21 @@ var closeReportCommand = cli.Command{
 			Usage: "the output index for the funding output of " +
 				"the funding transaction",
 		},
+		cli.BoolFlag{
+			Name: "sign",
+			Usage: "sign the report with the node's key so that " +
+				"it can be checked with verifyreport",
+		},
 	},
 	Action: queryCloseReport,
 }
 
 func queryCloseReport(ctx *cli.Context) error {
+	format, err := parseFormat(ctx)
+	if err != nil {
+		return err
+	}
+
 	client, cleanup := getClient(ctx)
 	defer cleanup()
 
@@ -45,6 +55,7 @@ func queryCloseReport(ctx *cli.Context) error {
 
 	req := &frdrpc.CloseReportRequest{
 		ChannelPoint: outpoint.String(),
+		Sign:         ctx.Bool("sign"),
 	}
 
 	rpcCtx := context.Background()
@@ -53,6 +64,21 @@ func queryCloseReport(ctx *cli.Context) error {
 		return err
 	}
 
-	printRespJSON(report)
-	return nil
+	if format == "" || format == formatJSON {
+		printRespJSON(report)
+		return nil
+	}
+
+	// The report's signature is only included in json output.
+	t := newTable(
+		"channel_point", "channel_initiator", "close_type",
+		"close_txid", "open_fee", "close_fee",
+	)
+	t.addRow(
+		report.ChannelPoint, report.ChannelInitiator,
+		report.CloseType, report.CloseTxid, report.OpenFee,
+		report.CloseFee,
+	)
+
+	return printTable(ctx, t, format)
 }
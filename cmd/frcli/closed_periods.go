@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/urfave/cli"
+)
+
+var closePeriodCommand = cli.Command{
+	Name:     "closeperiod",
+	Category: "reporting",
+	Usage:    "Close a fiscal period, freezing its audit entries.",
+	Description: `
+	Close a fiscal period, persisting a snapshot of its audit entries,
+	the prices used to value them and the version of faraday that
+	produced them, signed with our node's key. Audits that overlap with a
+	closed period will use its frozen entries and report any drift from
+	live data. Closed periods can't be modified, and may not overlap.
+
+	Periods should be closed with the same fiat settings and custom
+	categories as the audits that will use them, since these values are
+	frozen with the period's entries.`,
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "start_time",
+			Usage: "The unix timestamp in seconds from which the " +
+				"period should be closed, inclusive.",
+		},
+		cli.Int64Flag{
+			Name: "end_time",
+			Usage: "The unix timestamp in seconds until which " +
+				"the period should be closed, exclusive. This " +
+				"time may not be in the future.",
+		},
+		cli.BoolFlag{
+			Name:  "enable_fiat",
+			Usage: "Close the period with fiat conversions.",
+		},
+		cli.StringFlag{
+			Name: "categories",
+			Usage: "A set of custom categories to close the " +
+				"period with, expressed as a json array.",
+		},
+		fiatBackendFlag,
+		cli.StringFlag{
+			Name: "prices_csv_path",
+			Usage: "Path to a CSV file containing custom fiat " +
+				"price data. This is only required if " +
+				"'fiat_backend' is set to 'custom'.",
+		},
+		cli.StringFlag{
+			Name: "custom_price_currency",
+			Usage: "The currency that the custom prices are " +
+				"quoted in. This is only required if " +
+				"'fiat_backend' is set to 'custom'.",
+		},
+	},
+	Action: closePeriod,
+}
+
+func closePeriod(ctx *cli.Context) error {
+	client, cleanup := getClient(ctx)
+	defer cleanup()
+
+	fiatBackend, err := parseFiatBackend(ctx.String("fiat_backend"))
+	if err != nil {
+		return err
+	}
+
+	startTime := ctx.Int64("start_time")
+	endTime := ctx.Int64("end_time")
+
+	req := &frdrpc.ClosePeriodRequest{
+		StartTime:   uint64(startTime),
+		EndTime:     uint64(endTime),
+		DisableFiat: !ctx.IsSet("enable_fiat"),
+		FiatBackend: fiatBackend,
+	}
+
+	if fiatBackend == frdrpc.FiatBackend_CUSTOM {
+		customPrices, err := parsePricesFromCSV(
+			ctx.String("prices_csv_path"),
+			ctx.String("custom_price_currency"),
+		)
+		if err != nil {
+			return err
+		}
+
+		req.CustomPrices, err = filterPrices(
+			customPrices, startTime, endTime,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if categoryStr := ctx.String("categories"); categoryStr != "" {
+		err := json.Unmarshal(
+			[]byte(categoryStr), &req.CustomCategories,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	rpcCtx := context.Background()
+	resp, err := client.ClosePeriod(rpcCtx, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var listClosedPeriodsCommand = cli.Command{
+	Name:     "listperiods",
+	Category: "reporting",
+	Usage:    "List the fiscal periods that have been closed.",
+	Action:   listClosedPeriods,
+}
+
+func listClosedPeriods(ctx *cli.Context) error {
+	client, cleanup := getClient(ctx)
+	defer cleanup()
+
+	rpcCtx := context.Background()
+	resp, err := client.ListClosedPeriods(
+		rpcCtx, &frdrpc.ListClosedPeriodsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/lightninglabs/faraday/frdrpc"
@@ -31,6 +32,45 @@ func writeToCSV(e *frdrpc.ReportEntry) string {
 		e.BtcPrice.PriceTimestamp, e.Note)
 }
 
+// SummaryCSVHeaders returns the headers used for income statement csv
+// records.
+var SummaryCSVHeaders = "Section,Type,Category,OnChain,Count,Amount(Msat),Amount(%v)"
+
+// summaryToCSV returns a csv string containing each line of an income
+// statement, followed by a subtotal for each section and our net earnings.
+func summaryToCSV(s *frdrpc.AuditSummary) string {
+	csvStrs := []string{fmt.Sprintf(SummaryCSVHeaders, s.Currency)}
+
+	sections := []struct {
+		name    string
+		section *frdrpc.SummarySection
+	}{
+		{name: "income", section: s.Income},
+		{name: "expenses", section: s.Expenses},
+		{name: "transfers", section: s.Transfers},
+	}
+
+	for _, section := range sections {
+		for _, l := range section.section.Lines {
+			csvStrs = append(csvStrs, fmt.Sprintf(
+				"%v,%v,%v,%v,%v,%v,%v", section.name, l.Type,
+				l.CustomCategory, l.OnChain, l.Count,
+				l.AmountMsat, l.Fiat,
+			))
+		}
+
+		csvStrs = append(csvStrs, fmt.Sprintf("%v,subtotal,,,%v,%v,%v",
+			section.name, section.section.Count,
+			section.section.AmountMsat, section.section.Fiat,
+		))
+	}
+
+	csvStrs = append(csvStrs, fmt.Sprintf("net,total,,,,%v,%v",
+		s.NetAmountMsat, s.NetFiat))
+
+	return strings.Join(csvStrs, "\n")
+}
+
 // parsePricesFromCSV reads price point data from the csv at the specified path.
 // This function expects the first csv line to be headers and expects the rest
 // of the lines to be tuples of the following format:
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/lightninglabs/faraday/accounting"
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/lightninglabs/faraday/frdrpcserver"
+	"github.com/urfave/cli"
+)
+
+var exportAuditCommand = cli.Command{
+	Name:     "exportaudit",
+	Category: "reporting",
+	Usage:    "Export the data required to produce audits offline.",
+	Description: `
+	Export all of the lnd data required to produce node audits for the
+	period specified to a json file. This file can be used with
+	offlineaudit to reproduce audits for any period within the export
+	without a connection to lnd. Note that the export contains all of
+	your node's invoices, payments and on chain transactions, so it
+	should be stored securely.
+
+	Fee information for on chain transactions is only included if
+	faraday is connected to a bitcoin backend.`,
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "start_time",
+			Usage: "The unix timestamp in seconds from which " +
+				"data should be exported, inclusive.",
+		},
+		cli.Int64Flag{
+			Name: "end_time",
+			Usage: "(optional) The unix timestamp in seconds " +
+				"until which data should be exported, " +
+				"exclusive. If not set, data will be " +
+				"exported until the present.",
+		},
+		cli.StringFlag{
+			Name:  "output",
+			Usage: "The file to write the export to.",
+		},
+	},
+	Action: exportAudit,
+}
+
+func exportAudit(ctx *cli.Context) error {
+	output := ctx.String("output")
+	if output == "" {
+		return errors.New("output file required")
+	}
+
+	client, cleanup := getClient(ctx)
+	defer cleanup()
+
+	rpcCtx := context.Background()
+	resp, err := client.ExportAuditData(
+		rpcCtx, &frdrpc.ExportAuditDataRequest{
+			StartTime: uint64(ctx.Int64("start_time")),
+			EndTime:   uint64(ctx.Int64("end_time")),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(output, resp.Export, 0600); err != nil {
+		return err
+	}
+
+	fmt.Printf("Wrote export to %v\n", output)
+
+	return nil
+}
+
+var offlineAuditCommand = cli.Command{
+	Name:     "offlineaudit",
+	Category: "reporting",
+	Usage:    "Produce a node audit from an export.",
+	Description: `
+	Produce a node audit from a file created with exportaudit, rather
+	than from a live connection to lnd. Audits can be produced for any
+	period within the export, and default to the full period exported.
+	This command does not connect to faraday, although fiat backends
+	other than custom require network access to query prices.
+
+	Offline audits do not use closed periods and can't be signed. All
+	other options match the audit command.`,
+	Flags: append([]cli.Flag{
+		cli.StringFlag{
+			Name:  "export_file",
+			Usage: "The export file to produce the audit from.",
+		},
+		cli.Int64Flag{
+			Name: "start_time",
+			Usage: "(optional) The unix timestamp in seconds " +
+				"from which the report should be generated, " +
+				"defaults to the start of the export.",
+		},
+		cli.Int64Flag{
+			Name: "end_time",
+			Usage: "(optional) The unix timestamp in seconds " +
+				"until which the report should be generated, " +
+				"defaults to the end of the export.",
+		},
+	}, auditFlags...),
+	Action: offlineAudit,
+}
+
+func offlineAudit(ctx *cli.Context) error {
+	exportFile := ctx.String("export_file")
+	if exportFile == "" {
+		return errors.New("export file required")
+	}
+
+	exportBytes, err := os.ReadFile(exportFile)
+	if err != nil {
+		return err
+	}
+
+	export := &accounting.Export{}
+	if err := json.Unmarshal(exportBytes, export); err != nil {
+		return fmt.Errorf("could not decode export: %w", err)
+	}
+
+	// We default to the export's period so that custom prices are
+	// filtered to the period that our audit will cover.
+	startTime := export.StartTime.Unix()
+	if ctx.IsSet("start_time") {
+		startTime = ctx.Int64("start_time")
+	}
+
+	endTime := export.EndTime.Unix()
+	if ctx.IsSet("end_time") {
+		endTime = ctx.Int64("end_time")
+	}
+
+	req, err := parseAuditRequest(ctx, startTime, endTime)
+	if err != nil {
+		return err
+	}
+
+	// If we're using the export's end time, we leave our end time unset
+	// so that the export's exact end is used rather than a time
+	// truncated to the second.
+	if !ctx.IsSet("end_time") {
+		req.EndTime = 0
+	}
+
+	report, err := frdrpcserver.OfflineAudit(
+		context.Background(), export, req,
+	)
+	if err != nil {
+		return err
+	}
+
+	return outputAuditReport(ctx, report, req.Summary)
+}
@@ -54,7 +54,21 @@ var fiatEstimateCommand = cli.Command{
 	Action: queryFiatEstimate,
 }
 
+// fiatEstimateResp is used to display a fiat estimate in json output.
+type fiatEstimateResp struct {
+	AmountMsat     uint64 `json:"amount_msat"`
+	FiatValue      string `json:"fiat_value"`
+	Currency       string `json:"currency"`
+	BtcPrice       string `json:"btc_price"`
+	PriceTimestamp uint64 `json:"price_timestamp"`
+}
+
 func queryFiatEstimate(ctx *cli.Context) error {
+	format, err := parseFormat(ctx)
+	if err != nil {
+		return err
+	}
+
 	client, cleanup := getClient(ctx)
 	defer cleanup()
 
@@ -123,10 +137,36 @@ func queryFiatEstimate(ctx *cli.Context) error {
 	}
 
 	fiatVal := fiat.MsatToFiat(bitcoinPrice, lnwire.MilliSatoshi(amt))
-	priceTs := time.Unix(int64(estimate.BtcPrice.PriceTimestamp), 0)
 
-	fmt.Printf("%v msat = %v %s, priced at %v\n",
-		amt, fiatVal, estimate.BtcPrice.Currency, priceTs)
+	switch format {
+	case "":
+		priceTs := time.Unix(int64(estimate.BtcPrice.PriceTimestamp), 0)
+
+		fmt.Printf("%v msat = %v %s, priced at %v\n",
+			amt, fiatVal, estimate.BtcPrice.Currency, priceTs)
+
+		return nil
+
+	case formatJSON:
+		printJSON(fiatEstimateResp{
+			AmountMsat:     amt,
+			FiatValue:      fiatVal.String(),
+			Currency:       estimate.BtcPrice.Currency,
+			BtcPrice:       estimate.BtcPrice.Price,
+			PriceTimestamp: estimate.BtcPrice.PriceTimestamp,
+		})
+
+		return nil
+	}
+
+	t := newTable(
+		"amount_msat", "fiat_value", "currency", "btc_price",
+		"price_timestamp",
+	)
+	t.addRow(
+		amt, fiatVal, estimate.BtcPrice.Currency,
+		estimate.BtcPrice.Price, estimate.BtcPrice.PriceTimestamp,
+	)
 
-	return nil
+	return printTable(ctx, t, format)
 }
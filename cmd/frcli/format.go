@@ -0,0 +1,181 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/urfave/cli"
+)
+
+const (
+	// formatJSON prints a command's response as json.
+	formatJSON = "json"
+
+	// formatTable prints a command's response as a table with aligned
+	// columns.
+	formatTable = "table"
+
+	// formatCSV prints a command's response as comma separated values.
+	formatCSV = "csv"
+
+	// formatTSV prints a command's response as tab separated values.
+	formatTSV = "tsv"
+)
+
+var (
+	// formatFlag sets the output format of commands that support tabular
+	// output. It is a global flag so that it can be set once for all of
+	// these commands, for example in a shell alias.
+	formatFlag = cli.StringFlag{
+		Name: "format",
+		Usage: "(optional) the output format for the insights, " +
+			"revenue, outliers, threshold, closereport and fiat " +
+			"commands: json, table, csv or tsv. If not set, " +
+			"commands print their default output",
+	}
+
+	// sortFlags are common to commands that print multiple rows.
+	sortFlags = []cli.Flag{
+		cli.StringFlag{
+			Name: "sort_by",
+			Usage: "(optional) the column that table, csv and " +
+				"tsv output is sorted by",
+		},
+		cli.BoolFlag{
+			Name: "reverse",
+			Usage: "(optional) sort table, csv and tsv output in " +
+				"descending order",
+		},
+	}
+)
+
+// parseFormat parses our global format flag, returning an empty string if it
+// is not set.
+func parseFormat(ctx *cli.Context) (string, error) {
+	format := strings.ToLower(ctx.GlobalString(formatFlag.Name))
+
+	switch format {
+	case "", formatJSON, formatTable, formatCSV, formatTSV:
+		return format, nil
+
+	default:
+		return "", fmt.Errorf("unknown format: %v, expected json, "+
+			"table, csv or tsv", format)
+	}
+}
+
+// table holds rows of values under a set of named columns.
+type table struct {
+	columns []string
+	rows    [][]string
+}
+
+// newTable creates a table with the columns provided.
+func newTable(columns ...string) *table {
+	return &table{
+		columns: columns,
+	}
+}
+
+// addRow adds a row to our table. A value must be provided for each column.
+func (t *table) addRow(values ...interface{}) {
+	row := make([]string, len(values))
+	for i, value := range values {
+		row[i] = fmt.Sprint(value)
+	}
+
+	t.rows = append(t.rows, row)
+}
+
+// sortBy sorts our rows by the column provided. Values that are both numbers
+// are compared numerically, and all other values are compared as strings.
+// Rows with equal values keep their existing order.
+func (t *table) sortBy(column string, reverse bool) error {
+	index := -1
+	for i, name := range t.columns {
+		if name == column {
+			index = i
+			break
+		}
+	}
+
+	if index < 0 {
+		return fmt.Errorf("unknown sort column: %v, expected one of: "+
+			"%v", column, strings.Join(t.columns, ", "))
+	}
+
+	sort.SliceStable(t.rows, func(i, j int) bool {
+		a, b := t.rows[i][index], t.rows[j][index]
+		if reverse {
+			a, b = b, a
+		}
+
+		return lessValue(a, b)
+	})
+
+	return nil
+}
+
+// lessValue returns a boolean indicating whether a sorts before b.
+func lessValue(a, b string) bool {
+	aNum, aErr := strconv.ParseFloat(a, 64)
+	bNum, bErr := strconv.ParseFloat(b, 64)
+	if aErr == nil && bErr == nil {
+		return aNum < bNum
+	}
+
+	return a < b
+}
+
+// write writes our table to the writer provided in a tabular format.
+func (t *table) write(w io.Writer, format string) error {
+	switch format {
+	case formatTable:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		for _, row := range append([][]string{t.columns}, t.rows...) {
+			_, err := fmt.Fprintln(tw, strings.Join(row, "\t"))
+			if err != nil {
+				return err
+			}
+		}
+
+		return tw.Flush()
+
+	case formatCSV, formatTSV:
+		cw := csv.NewWriter(w)
+		if format == formatTSV {
+			cw.Comma = '\t'
+		}
+
+		if err := cw.Write(t.columns); err != nil {
+			return err
+		}
+
+		if err := cw.WriteAll(t.rows); err != nil {
+			return err
+		}
+
+		return cw.Error()
+
+	default:
+		return fmt.Errorf("format: %v is not tabular", format)
+	}
+}
+
+// printTable sorts our table by our sort flags, if they are set, and prints
+// it in the format provided.
+func printTable(ctx *cli.Context, t *table, format string) error {
+	if column := ctx.String("sort_by"); column != "" {
+		if err := t.sortBy(column, ctx.Bool("reverse")); err != nil {
+			return err
+		}
+	}
+
+	return t.write(os.Stdout, format)
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/stretchr/testify/require"
+)
+
+// testTable returns a table with numeric and string columns.
+func testTable() *table {
+	t := newTable("chan_point", "fees_msat")
+	t.addRow("b:1", 100)
+	t.addRow("a:1", 20)
+	t.addRow("c:1", 3)
+
+	return t
+}
+
+// TestTableSort tests sorting tables by their columns.
+func TestTableSort(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		column   string
+		reverse  bool
+		expected []string
+		err      bool
+	}{
+		{
+			name:     "strings",
+			column:   "chan_point",
+			expected: []string{"a:1", "b:1", "c:1"},
+		},
+		{
+			name:     "numbers",
+			column:   "fees_msat",
+			expected: []string{"c:1", "a:1", "b:1"},
+		},
+		{
+			name:     "reverse numbers",
+			column:   "fees_msat",
+			reverse:  true,
+			expected: []string{"b:1", "a:1", "c:1"},
+		},
+		{
+			name:   "unknown column",
+			column: "uptime",
+			err:    true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			table := testTable()
+			err := table.sortBy(test.column, test.reverse)
+			if test.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			var channels []string
+			for _, row := range table.rows {
+				channels = append(channels, row[0])
+			}
+			require.Equal(t, test.expected, channels)
+		})
+	}
+}
+
+// TestTableWrite tests writing tables in each of our tabular formats.
+func TestTableWrite(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		format   string
+		expected string
+		err      bool
+	}{
+		{
+			format: formatTable,
+			expected: "chan_point  fees_msat\n" +
+				"b:1         100\n" +
+				"a:1         20\n" +
+				"c:1         3\n",
+		},
+		{
+			format: formatCSV,
+			expected: "chan_point,fees_msat\nb:1,100\na:1,20\n" +
+				"c:1,3\n",
+		},
+		{
+			format: formatTSV,
+			expected: "chan_point\tfees_msat\nb:1\t100\na:1\t20\n" +
+				"c:1\t3\n",
+		},
+		{
+			format: formatJSON,
+			err:    true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.format, func(t *testing.T) {
+			t.Parallel()
+
+			var out bytes.Buffer
+			err := testTable().write(&out, test.format)
+			if test.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, out.String())
+		})
+	}
+}
+
+// TestRevenueTable tests that revenue reports have a row for each channel
+// pair, in a consistent order.
+func TestRevenueTable(t *testing.T) {
+	t.Parallel()
+
+	table := revenueTable(&frdrpc.RevenueReportResponse{
+		Reports: []*frdrpc.RevenueReport{
+			{
+				TargetChannel: "a:1",
+				PairReports: map[string]*frdrpc.PairReport{
+					"c:1": {
+						FeesIncomingMsat:     2,
+						UndecomposedForwards: 3,
+					},
+					"b:1": {FeesOutgoingMsat: 1},
+				},
+			},
+		},
+	})
+
+	require.Equal(t, [][]string{
+		{"a:1", "b:1", "0", "0", "0", "1", "0", "0", "0"},
+		{"a:1", "c:1", "0", "2", "0", "0", "0", "0", "3"},
+	}, table.rows)
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/urfave/cli"
+)
+
+var forwardingFailuresCommand = cli.Command{
+	Name:     "failures",
+	Category: "insights",
+	Usage: "Get a report of the volume and fees missed due to failed " +
+		"forwards.",
+	Description: `
+	Get a report of the forwards that failed at our node, grouped by
+	outgoing channel and failure reason. Failures are only recorded while
+	faraday is monitoring lnd's htlc event stream.`,
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "start_time",
+			Usage: "(optional) The unix timestamp in seconds " +
+				"from which the report should be generated. " +
+				"If not set, the report will include all " +
+				"recorded failures.",
+		},
+		cli.Int64Flag{
+			Name: "end_time",
+			Usage: "(optional) The unix timestamp in seconds " +
+				"until which the report should be generated. " +
+				"If not set, the report will be produced " +
+				"until the present.",
+		},
+	},
+	Action: queryForwardingFailures,
+}
+
+func queryForwardingFailures(ctx *cli.Context) error {
+	client, cleanup := getClient(ctx)
+	defer cleanup()
+
+	req := &frdrpc.ForwardingFailuresRequest{
+		StartTime: uint64(ctx.Int64("start_time")),
+		EndTime:   uint64(ctx.Int64("end_time")),
+	}
+
+	rpcCtx := context.Background()
+	resp, err := client.ForwardingFailures(rpcCtx, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/urfave/cli"
+)
+
+// errJobIDRequired is returned if a job command is run without a job id.
+var errJobIDRequired = errors.New("job_id required")
+
+var startAuditCommand = cli.Command{
+	Name:     "startaudit",
+	Category: "reporting",
+	Usage:    "Start producing a node audit in the background.",
+	Description: `
+	Start producing a node audit in the background, which keeps running
+	if frcli disconnects from faraday. This command takes the same
+	options as audit and outputs the id of the audit job. Use jobstatus
+	to track the job's progress, canceljob to cancel it and jobresult
+	to fetch the audit once it has completed. The results of audit jobs
+	are kept for faraday's --jobretention period.`,
+	Flags: append(
+		append([]cli.Flag{}, nodeAuditFlags...),
+		withoutFlag(auditFlags, "csv_path")...,
+	),
+	Action: startAudit,
+}
+
+func startAudit(ctx *cli.Context) error {
+	client, cleanup := getClient(ctx)
+	defer cleanup()
+
+	req, err := parseNodeAuditRequest(ctx)
+	if err != nil {
+		return err
+	}
+
+	rpcCtx := context.Background()
+	resp, err := client.StartAudit(rpcCtx, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+// jobIDFlag is the flag used to identify a background job.
+var jobIDFlag = cli.StringFlag{
+	Name:  "job_id",
+	Usage: "The id of the job, as returned by startaudit.",
+}
+
+var jobStatusCommand = cli.Command{
+	Name:     "jobstatus",
+	Category: "reporting",
+	Usage:    "Get the status and progress of a background job.",
+	Flags: []cli.Flag{
+		jobIDFlag,
+	},
+	Action: jobStatus,
+}
+
+func jobStatus(ctx *cli.Context) error {
+	jobID := ctx.String("job_id")
+	if jobID == "" {
+		return errJobIDRequired
+	}
+
+	client, cleanup := getClient(ctx)
+	defer cleanup()
+
+	rpcCtx := context.Background()
+	resp, err := client.JobStatus(rpcCtx, &frdrpc.JobStatusRequest{
+		JobId: jobID,
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var cancelJobCommand = cli.Command{
+	Name:     "canceljob",
+	Category: "reporting",
+	Usage:    "Cancel a running background job.",
+	Flags: []cli.Flag{
+		jobIDFlag,
+	},
+	Action: cancelJob,
+}
+
+func cancelJob(ctx *cli.Context) error {
+	jobID := ctx.String("job_id")
+	if jobID == "" {
+		return errJobIDRequired
+	}
+
+	client, cleanup := getClient(ctx)
+	defer cleanup()
+
+	rpcCtx := context.Background()
+	_, err := client.CancelJob(rpcCtx, &frdrpc.CancelJobRequest{
+		JobId: jobID,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Cancelled job %v\n", jobID)
+
+	return nil
+}
+
+var jobResultCommand = cli.Command{
+	Name:     "jobresult",
+	Category: "reporting",
+	Usage:    "Get the audit produced by a background job.",
+	Description: `
+	Get the audit produced by an audit job that has completed
+	successfully. To write the audit directly to a csv, set a target
+	directory using the --csv_path flag.`,
+	Flags: []cli.Flag{
+		jobIDFlag,
+		cli.StringFlag{
+			Name: "csv_path",
+			Usage: "A path to write node_report.csv, or " +
+				"node_summary.csv for summaries, to. If not " +
+				"set, the command will output the report. " +
+				"Note that write permissions are required.",
+		},
+	},
+	Action: jobResult,
+}
+
+func jobResult(ctx *cli.Context) error {
+	jobID := ctx.String("job_id")
+	if jobID == "" {
+		return errJobIDRequired
+	}
+
+	client, cleanup := getClient(ctx)
+	defer cleanup()
+
+	rpcCtx := context.Background()
+	report, err := client.JobResult(rpcCtx, &frdrpc.JobResultRequest{
+		JobId: jobID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return outputAuditReport(ctx, report, report.Summary != nil)
+}
+
+// withoutFlag returns a copy of a set of flags without the flag named.
+func withoutFlag(flags []cli.Flag, name string) []cli.Flag {
+	var filtered []cli.Flag
+	for _, flag := range flags {
+		if flag.GetName() == name {
+			continue
+		}
+
+		filtered = append(filtered, flag)
+	}
+
+	return filtered
+}
@@ -48,15 +48,34 @@ func main() {
 		faradayDirFlag,
 		tlsCertFlag,
 		macaroonPathFlag,
+		formatFlag,
 	}
 	app.Commands = []cli.Command{
 		thresholdRecommendationCommand,
 		outlierRecommendationCommand,
 		revenueReportCommand,
+		forwardingFailuresCommand,
+		pairFlowsCommand,
 		channelInsightsCommand,
 		fiatEstimateCommand,
 		onChainReportCommand,
 		closeReportCommand,
+		closeDryRunCommand,
+		openRecommendationsCommand,
+		policyHistoryCommand,
+		balanceSheetCommand,
+		closePeriodCommand,
+		listClosedPeriodsCommand,
+		verifyReportCommand,
+		exportAuditCommand,
+		offlineAuditCommand,
+		bakeMacaroonCommand,
+		startAuditCommand,
+		jobStatusCommand,
+		cancelJobCommand,
+		jobResultCommand,
+		subscribeAlertsCommand,
+		subscribeEntriesCommand,
 	}
 
 	if err := app.Run(os.Args); err != nil {
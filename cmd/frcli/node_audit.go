@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path"
@@ -13,6 +14,92 @@ import (
 	"github.com/urfave/cli"
 )
 
+// auditFlags are the flags shared by commands which produce node audits.
+var auditFlags = []cli.Flag{
+	cli.StringFlag{
+		Name: "csv_path",
+		Usage: "A path to write node_report.csv to. If not " +
+			"set, the command will output the report. " +
+			"Note that write permissions are required.",
+	},
+	cli.BoolFlag{
+		Name: "summary",
+		Usage: "Aggregate entries into an income statement " +
+			"rather than listing them individually. If " +
+			"csv_path is set, node_summary.csv is " +
+			"written instead of node_report.csv.",
+	},
+	cli.BoolFlag{
+		Name:  "enable_fiat",
+		Usage: "Create a report with fiat conversions.",
+	},
+	cli.StringFlag{
+		Name: "categories",
+		Usage: "A set of custom categories to create the " +
+			"report with, expressed as a json array.",
+	},
+	cli.BoolFlag{
+		Name: "loop-category",
+		Usage: "Add a custom category called 'loop' " +
+			"containing all transactions associated with " +
+			"Lightning Labs Loop swaps. Note that this " +
+			"category currently does not include off " +
+			"chain payments.",
+	},
+	cli.BoolFlag{
+		Name: "pool-category",
+		Usage: "Add a custom category called 'pool' " +
+			"containing all transactions associated with " +
+			"Lightning Labs Pool trades. Note that this " +
+			"category currently does not include off " +
+			"chain payments.",
+	},
+	fiatBackendFlag,
+	cli.StringFlag{
+		Name: "prices_csv_path",
+		Usage: "Path to a CSV file containing custom fiat " +
+			"price data. This is only required if " +
+			"'fiat_backend' is set to 'custom'.",
+	},
+	cli.StringFlag{
+		Name: "custom_price_currency",
+		Usage: "The currency that the custom prices are " +
+			"quoted in. This is only required if " +
+			"'fiat_backend' is set to 'custom'.",
+	},
+}
+
+// nodeAuditFlags are the flags used by commands that request node audits from
+// faraday, in addition to our shared audit flags.
+var nodeAuditFlags = []cli.Flag{
+	cli.Int64Flag{
+		Name: "start_time",
+		Usage: "(optional) The unix timestamp in seconds " +
+			"from which the report should be generated, " +
+			"defaults to one week ago",
+	},
+	cli.Int64Flag{
+		Name: "end_time",
+		Usage: "(optional) The unix timestamp in seconds " +
+			"until which the report should be generated. " +
+			"If not set, the report will be produced " +
+			"until the present.",
+	},
+	cli.BoolFlag{
+		Name: "sign",
+		Usage: "Sign the report with the node's key so that " +
+			"it can be checked with verifyreport. Signed " +
+			"reports are output as json, so csv_path may " +
+			"not be set.",
+	},
+	cli.BoolFlag{
+		Name: "ignore_closed_periods",
+		Usage: "Produce the report from live data only, " +
+			"rather than using the frozen entries of " +
+			"closed periods.",
+	},
+}
+
 var onChainReportCommand = cli.Command{
 	Name:     "audit",
 	Category: "reporting",
@@ -25,6 +112,17 @@ var onChainReportCommand = cli.Command{
 	directly to a csv, set a target directory using the --csv_path 
 	flag.
 
+	If the report overlaps with fiscal periods that have been closed 
+	using closeperiod, the frozen entries for those periods are used 
+	and any drift between them and live data is reported. Set 
+	--ignore_closed_periods to produce the report from live data only.
+
+	To produce a one page income statement rather than a list of 
+	entries, set the --summary flag. Entries are aggregated by type, 
+	custom category and chain, and split into income, expenses and 
+	transfers between our own balances, with subtotals for each and 
+	our net earnings for the period. 
+
 	These reports can optionally be created with custom categories. 
 	This requires providing a name for the category, and a set of 
 	regular expressions which identify the labels of transactions 
@@ -47,65 +145,7 @@ var onChainReportCommand = cli.Command{
 		},
 	]'
 `,
-	Flags: []cli.Flag{
-		cli.Int64Flag{
-			Name: "start_time",
-			Usage: "(optional) The unix timestamp in seconds " +
-				"from which the report should be generated, " +
-				"defaults to one week ago",
-		},
-		cli.Int64Flag{
-			Name: "end_time",
-			Usage: "(optional) The unix timestamp in seconds " +
-				"until which the report should be generated. " +
-				"If not set, the report will be produced " +
-				"until the present.",
-		},
-		cli.StringFlag{
-			Name: "csv_path",
-			Usage: "A path to write node_report.csv to. If not " +
-				"set, the command will output the report. " +
-				"Note that write permissions are required.",
-		},
-		cli.BoolFlag{
-			Name:  "enable_fiat",
-			Usage: "Create a report with fiat conversions.",
-		},
-		cli.StringFlag{
-			Name: "categories",
-			Usage: "A set of custom categories to create the " +
-				"report with, expressed as a json array.",
-		},
-		cli.BoolFlag{
-			Name: "loop-category",
-			Usage: "Add a custom category called 'loop' " +
-				"containing all transactions associated with " +
-				"Lightning Labs Loop swaps. Note that this " +
-				"category currently does not include off " +
-				"chain payments.",
-		},
-		cli.BoolFlag{
-			Name: "pool-category",
-			Usage: "Add a custom category called 'pool' " +
-				"containing all transactions associated with " +
-				"Lightning Labs Pool trades. Note that this " +
-				"category currently does not include off " +
-				"chain payments.",
-		},
-		fiatBackendFlag,
-		cli.StringFlag{
-			Name: "prices_csv_path",
-			Usage: "Path to a CSV file containing custom fiat " +
-				"price data. This is only required if " +
-				"'fiat_backend' is set to 'custom'.",
-		},
-		cli.StringFlag{
-			Name: "custom_price_currency",
-			Usage: "The currency that the custom prices are " +
-				"quoted in. This is only required if " +
-				"'fiat_backend' is set to 'custom'.",
-		},
-	},
+	Flags:  append(append([]cli.Flag{}, nodeAuditFlags...), auditFlags...),
 	Action: queryOnChainReport,
 }
 
@@ -113,13 +153,59 @@ func queryOnChainReport(ctx *cli.Context) error {
 	client, cleanup := getClient(ctx)
 	defer cleanup()
 
-	fiatBackend, err := parseFiatBackend(ctx.String("fiat_backend"))
+	req, err := parseNodeAuditRequest(ctx)
 	if err != nil {
 		return err
 	}
 
-	startTime := ctx.Int64("start_time")
-	endTime := ctx.Int64("end_time")
+	rpcCtx := context.Background()
+	report, err := client.NodeAudit(rpcCtx, req)
+	if err != nil {
+		return err
+	}
+
+	return outputAuditReport(ctx, report, req.Summary)
+}
+
+// parseNodeAuditRequest creates a node audit request from the values of our
+// node audit and shared audit flags.
+func parseNodeAuditRequest(ctx *cli.Context) (*frdrpc.NodeAuditRequest,
+	error) {
+
+	req, err := parseAuditRequest(
+		ctx, ctx.Int64("start_time"), ctx.Int64("end_time"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	req.IgnoreClosedPeriods = ctx.Bool("ignore_closed_periods")
+	req.Sign = ctx.Bool("sign")
+
+	// A signature covers the report's json output, so we can't sign
+	// reports that are written to csv.
+	if req.Sign && ctx.IsSet("csv_path") {
+		return nil, errors.New("signed reports can't be written to csv")
+	}
+
+	// If start time is zero, default to a week ago.
+	if req.StartTime == 0 {
+		weekAgo := time.Now().Add(time.Hour * 24 * 7 * -1)
+		req.StartTime = uint64(weekAgo.Unix())
+	}
+
+	return req, nil
+}
+
+// parseAuditRequest creates a node audit request for the period provided
+// from the values of our shared audit flags.
+func parseAuditRequest(ctx *cli.Context, startTime,
+	endTime int64) (*frdrpc.NodeAuditRequest, error) {
+
+	fiatBackend, err := parseFiatBackend(ctx.String("fiat_backend"))
+	if err != nil {
+		return nil, err
+	}
 
 	// nolint: prealloc
 	var filteredPrices []*frdrpc.BitcoinPrice
@@ -130,14 +216,14 @@ func queryOnChainReport(ctx *cli.Context) error {
 			ctx.String("custom_price_currency"),
 		)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		filteredPrices, err = filterPrices(
 			customPrices, startTime, endTime,
 		)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
@@ -149,12 +235,7 @@ func queryOnChainReport(ctx *cli.Context) error {
 		DisableFiat:  !ctx.IsSet("enable_fiat"),
 		FiatBackend:  fiatBackend,
 		CustomPrices: filteredPrices,
-	}
-
-	// If start time is zero, default to a week ago.
-	if req.StartTime == 0 {
-		weekAgo := time.Now().Add(time.Hour * 24 * 7 * -1)
-		req.StartTime = uint64(weekAgo.Unix())
+		Summary:      ctx.Bool("summary"),
 	}
 
 	var (
@@ -167,7 +248,7 @@ func queryOnChainReport(ctx *cli.Context) error {
 	if categoryStr != "" {
 		err := json.Unmarshal([]byte(categoryStr), &categories)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		req.CustomCategories = categories
 	}
@@ -199,11 +280,13 @@ func queryOnChainReport(ctx *cli.Context) error {
 		)
 	}
 
-	rpcCtx := context.Background()
-	report, err := client.NodeAudit(rpcCtx, req)
-	if err != nil {
-		return err
-	}
+	return req, nil
+}
+
+// outputAuditReport prints a node audit, or writes it to a csv file if the
+// csv_path flag is set.
+func outputAuditReport(ctx *cli.Context, report *frdrpc.NodeAuditResponse,
+	summary bool) error {
 
 	// If we did not request a csv, just print the response and return.
 	if !ctx.IsSet("csv_path") {
@@ -211,10 +294,15 @@ func queryOnChainReport(ctx *cli.Context) error {
 		return nil
 	}
 
+	fileName := "node_report.csv"
+	if summary {
+		fileName = "node_summary.csv"
+	}
+
 	csvPath := ctx.String("csv_path")
-	fmt.Printf("Outputting node_report.csv to %v\n", csvPath)
+	fmt.Printf("Outputting %v to %v\n", fileName, csvPath)
 
-	file, err := os.Create(path.Join(csvPath, "node_report.csv"))
+	file, err := os.Create(path.Join(csvPath, fileName))
 	if err != nil {
 		return err
 	}
@@ -225,6 +313,11 @@ func queryOnChainReport(ctx *cli.Context) error {
 		}
 	}()
 
+	if summary {
+		_, err = file.WriteString(summaryToCSV(report.Summary))
+		return err
+	}
+
 	var headers string
 	if len(report.Reports) > 0 {
 		headers = fmt.Sprintf(
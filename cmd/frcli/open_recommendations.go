@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/urfave/cli"
+)
+
+var openRecommendationsCommand = cli.Command{
+	Name:     "openrecommendations",
+	Category: "recommendations",
+	Usage: "Get recommendations for peers to open additional channels " +
+		"with.",
+	Description: `
+	Suggest opening additional capacity with peers that have earned fees,
+	based on the volume forwarded out to them and the forwards that failed
+	because we had insufficient balance. Each recommendation includes a
+	suggested channel size that covers the peer's outgoing demand for the
+	configured number of days, and the estimated number of days that the
+	new channel would take to earn back its opening fee if it earns the
+	same yield as our existing channels with the peer.`,
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "start_time",
+			Usage: "(optional) The unix timestamp in seconds " +
+				"from which fees and demand are measured. " +
+				"If not set, the 30 days before the end " +
+				"time are used.",
+		},
+		cli.Int64Flag{
+			Name: "end_time",
+			Usage: "(optional) The unix timestamp in seconds " +
+				"until which fees and demand are measured. " +
+				"If not set, the present is used.",
+		},
+		cli.Int64Flag{
+			Name: "conf_target",
+			Usage: "(optional) The confirmation target to " +
+				"estimate opening fees for, defaults to 6 " +
+				"blocks.",
+		},
+		cli.Float64Flag{
+			Name: "liquidity_days",
+			Usage: "(optional) The number of days of outgoing " +
+				"demand that suggested channels should " +
+				"cover, defaults to 7 days.",
+		},
+		cli.Int64Flag{
+			Name: "min_size",
+			Usage: "(optional) The smallest channel size in " +
+				"satoshis to suggest.",
+		},
+		cli.Int64Flag{
+			Name: "max_size",
+			Usage: "(optional) The largest channel size in " +
+				"satoshis to suggest.",
+		},
+		feeAttributionFlag,
+	},
+	Action: queryOpenRecommendations,
+}
+
+func queryOpenRecommendations(ctx *cli.Context) error {
+	attribution, err := parseFeeAttribution(ctx)
+	if err != nil {
+		return err
+	}
+
+	client, cleanup := getClient(ctx)
+	defer cleanup()
+
+	req := &frdrpc.OpenRecommendationsRequest{
+		StartTime:         uint64(ctx.Int64("start_time")),
+		EndTime:           uint64(ctx.Int64("end_time")),
+		ConfTarget:        int32(ctx.Int64("conf_target")),
+		LiquidityDays:     float32(ctx.Float64("liquidity_days")),
+		MinChannelSizeSat: ctx.Int64("min_size"),
+		MaxChannelSizeSat: ctx.Int64("max_size"),
+		FeeAttribution:    attribution,
+	}
+
+	rpcCtx := context.Background()
+	resp, err := client.OpenRecommendations(rpcCtx, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/urfave/cli"
+)
+
+var pairFlowsCommand = cli.Command{
+	Name:     "flows",
+	Category: "insights",
+	Usage: "Get a matrix of liquidity flows between channel pairs and " +
+		"classify channels as sources, sinks, routers or dormant.",
+	Description: `
+	Get the amounts that have been forwarded between each pair of channels,
+	normalized by the smaller channel's capacity, optionally split into
+	time buckets. Each open channel is classified as a source if forwards
+	mostly arrive on it, a sink if forwards mostly leave on it, a router
+	if its flows are balanced or dormant if it has little volume.`,
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "start_time",
+			Usage: "(optional) The unix timestamp in seconds " +
+				"from which the report should be generated. " +
+				"If not set, the report will cover the 30 " +
+				"days before the end time.",
+		},
+		cli.Int64Flag{
+			Name: "end_time",
+			Usage: "(optional) The unix timestamp in seconds " +
+				"until which the report should be generated. " +
+				"If not set, the report will be produced " +
+				"until the present.",
+		},
+		cli.DurationFlag{
+			Name: "bucket",
+			Usage: "(optional) The duration of each time bucket " +
+				"in the report, for example 24h. If not set, " +
+				"a single bucket covering the full period is " +
+				"returned.",
+		},
+		cli.Float64Flag{
+			Name: "dormant_turnover",
+			Usage: "(optional) The volume to capacity ratio " +
+				"below which a channel is considered dormant.",
+		},
+		cli.Float64Flag{
+			Name: "direction_threshold",
+			Usage: "(optional) The imbalance between incoming " +
+				"and outgoing volume, in (0, 1], above which " +
+				"a channel is considered a source or sink.",
+		},
+	},
+	Action: queryPairFlows,
+}
+
+func queryPairFlows(ctx *cli.Context) error {
+	client, cleanup := getClient(ctx)
+	defer cleanup()
+
+	req := &frdrpc.PairFlowsRequest{
+		StartTime:          uint64(ctx.Int64("start_time")),
+		EndTime:            uint64(ctx.Int64("end_time")),
+		BucketSeconds:      uint64(ctx.Duration("bucket").Seconds()),
+		DormantTurnover:    float32(ctx.Float64("dormant_turnover")),
+		DirectionThreshold: float32(ctx.Float64("direction_threshold")),
+	}
+
+	rpcCtx := context.Background()
+	resp, err := client.PairFlows(rpcCtx, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/urfave/cli"
+)
+
+var policyHistoryCommand = cli.Command{
+	Name:     "policyhistory",
+	Category: "insights",
+	Usage: "Get the history of our channel policies and the volume and " +
+		"fees earned under each policy.",
+	Description: `
+	Get the forwarding policies that we have set for our channels over a
+	period, with the volume and fees that each channel earned under each
+	policy and an estimate of its fee elasticity. Policies are only
+	recorded while faraday is running.`,
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name: "chan_points",
+			Usage: "(optional) A set of channels to report on. " +
+				"If not specified, all channels with " +
+				"recorded policies are included.",
+		},
+		cli.Int64Flag{
+			Name: "start_time",
+			Usage: "(optional) The unix timestamp in seconds " +
+				"from which the report should be generated. " +
+				"If not set, the report will include all " +
+				"recorded policies.",
+		},
+		cli.Int64Flag{
+			Name: "end_time",
+			Usage: "(optional) The unix timestamp in seconds " +
+				"until which the report should be generated. " +
+				"If not set, the report will be produced " +
+				"until the present.",
+		},
+	},
+	Action: queryPolicyHistory,
+}
+
+func queryPolicyHistory(ctx *cli.Context) error {
+	client, cleanup := getClient(ctx)
+	defer cleanup()
+
+	req := &frdrpc.PolicyHistoryRequest{
+		StartTime: uint64(ctx.Int64("start_time")),
+		EndTime:   uint64(ctx.Int64("end_time")),
+	}
+
+	if ctx.IsSet("chan_points") {
+		req.ChanPoints = ctx.StringSlice("chan_points")
+	}
+
+	rpcCtx := context.Background()
+	resp, err := client.PolicyHistory(rpcCtx, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -2,16 +2,54 @@ package main
 
 import (
 	"context"
+	"fmt"
+	"sort"
 
 	"github.com/lightninglabs/faraday/frdrpc"
 	"github.com/urfave/cli"
 )
 
+// feeAttributionFlag is common to requests that attribute forwarding fees to
+// channels.
+var feeAttributionFlag = cli.StringFlag{
+	Name: "fee_attribution",
+	Usage: "(optional) how each forward's fee is attributed to its " +
+		"incoming and outgoing channels: full, split, outgoing, " +
+		"incoming or capacity. Full attributes the whole fee to " +
+		"both channels, capacity splits fees in proportion to the " +
+		"channels' capacities.",
+	Value: "full",
+}
+
+// parseFeeAttribution parses the fee attribution flag.
+func parseFeeAttribution(ctx *cli.Context) (frdrpc.FeeAttribution, error) {
+	switch ctx.String(feeAttributionFlag.Name) {
+	case "full":
+		return frdrpc.FeeAttribution_FULL_FEE, nil
+
+	case "split":
+		return frdrpc.FeeAttribution_SPLIT, nil
+
+	case "outgoing":
+		return frdrpc.FeeAttribution_ALL_OUTGOING, nil
+
+	case "incoming":
+		return frdrpc.FeeAttribution_ALL_INCOMING, nil
+
+	case "capacity":
+		return frdrpc.FeeAttribution_CAPACITY_WEIGHTED, nil
+
+	default:
+		return 0, fmt.Errorf("unknown fee attribution: %v",
+			ctx.String(feeAttributionFlag.Name))
+	}
+}
+
 var revenueReportCommand = cli.Command{
 	Name:     "revenue",
 	Category: "insights",
 	Usage:    "Get a pairwise revenue report for a channel.",
-	Flags: []cli.Flag{
+	Flags: append([]cli.Flag{
 		cli.StringSliceFlag{
 			Name: "chan_points",
 			Usage: "(optional) A set of channels to generate a " +
@@ -38,19 +76,31 @@ var revenueReportCommand = cli.Command{
 				"If not set, the report will be produced " +
 				"until the present.",
 		},
-	},
+		feeAttributionFlag,
+	}, sortFlags...),
 	Action: queryRevenueReport,
 }
 
 func queryRevenueReport(ctx *cli.Context) error {
+	attribution, err := parseFeeAttribution(ctx)
+	if err != nil {
+		return err
+	}
+
+	format, err := parseFormat(ctx)
+	if err != nil {
+		return err
+	}
+
 	client, cleanup := getClient(ctx)
 	defer cleanup()
 
 	// Set start and end times from user specified values, defaulting
 	// to zero if they are not set.
 	req := &frdrpc.RevenueReportRequest{
-		StartTime: uint64(ctx.Int64("start_time")),
-		EndTime:   uint64(ctx.Int64("end_time")),
+		StartTime:      uint64(ctx.Int64("start_time")),
+		EndTime:        uint64(ctx.Int64("end_time")),
+		FeeAttribution: attribution,
 	}
 
 	if ctx.IsSet("chan_points") {
@@ -63,7 +113,48 @@ func queryRevenueReport(ctx *cli.Context) error {
 		return err
 	}
 
-	printRespJSON(recs)
+	if format == "" || format == formatJSON {
+		printRespJSON(recs)
+		return nil
+	}
+
+	return printTable(ctx, revenueTable(recs), format)
+}
+
+// revenueTable returns a table with a row for each channel pair in a revenue
+// report. Unattributed forwards are only included in json output.
+func revenueTable(resp *frdrpc.RevenueReportResponse) *table {
+	t := newTable(
+		"target_channel", "pair_channel", "amount_incoming_msat",
+		"fees_incoming_msat", "amount_outgoing_msat",
+		"fees_outgoing_msat", "inbound_surcharges_msat",
+		"inbound_discounts_msat", "undecomposed_forwards",
+	)
+
+	for _, report := range resp.Reports {
+		// Our pair reports are a map, so we sort them by channel for
+		// consistent output.
+		pairs := make([]string, 0, len(report.PairReports))
+		for pair := range report.PairReports {
+			pairs = append(pairs, pair)
+		}
+		sort.Strings(pairs)
+
+		for _, pair := range pairs {
+			pairReport := report.PairReports[pair]
+
+			t.addRow(
+				report.TargetChannel, pair,
+				pairReport.AmountIncomingMsat,
+				pairReport.FeesIncomingMsat,
+				pairReport.AmountOutgoingMsat,
+				pairReport.FeesOutgoingMsat,
+				pairReport.InboundSurchargesMsat,
+				pairReport.InboundDiscountsMsat,
+				pairReport.UndecomposedForwards,
+			)
+		}
+	}
 
-	return nil
+	return t
 }
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/urfave/cli"
+)
+
+var subscribeEntriesCommand = cli.Command{
+	Name:     "subscribeentries",
+	Category: "reporting",
+	Usage:    "Stream the entries recorded by faraday's journal.",
+	Description: `
+	Subscribe to the accounting entries recorded by faraday's journal,
+	printing each entry with its sequence number as it is recorded until
+	the command is interrupted. All recorded entries after the sequence
+	number provided are printed first, so the stream can be resumed from
+	the last entry received without missing entries. The journal is
+	enabled with faraday's journal options.`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "after_sequence",
+			Usage: "the sequence number of the last entry " +
+				"received, if not set all recorded entries " +
+				"are printed",
+		},
+	},
+	Action: subscribeEntries,
+}
+
+func subscribeEntries(ctx *cli.Context) error {
+	client, cleanup := getClient(ctx)
+	defer cleanup()
+
+	rpcCtx := context.Background()
+	stream, err := client.SubscribeEntries(
+		rpcCtx, &frdrpc.SubscribeEntriesRequest{
+			AfterSequence: ctx.Uint64("after_sequence"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		entry, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(entry)
+	}
+}
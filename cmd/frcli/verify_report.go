@@ -0,0 +1,130 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/lightninglabs/faraday/attestation"
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+	"google.golang.org/protobuf/proto"
+)
+
+var verifyReportCommand = cli.Command{
+	Name:     "verifyreport",
+	Category: "reporting",
+	Usage:    "Verify that a signed report was produced by a node.",
+	Description: `
+	Check that a report that was signed using the --sign flag of the
+	audit or closereport commands, and saved as json, was signed by the
+	node with the public key provided and has not been edited since.
+	Verification is performed locally, so it does not require a
+	connection to faraday or lnd.`,
+	ArgsUsage: "report_file",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "report_file",
+			Usage: "The path to the json report to verify.",
+		},
+		cli.StringFlag{
+			Name: "pubkey",
+			Usage: "The hex encoded public key of the node that " +
+				"the report should be signed by.",
+		},
+		cli.StringFlag{
+			Name:  "type",
+			Value: "audit",
+			Usage: "The type of report: audit or closereport.",
+		},
+	},
+	Action: verifyReport,
+}
+
+func verifyReport(ctx *cli.Context) error {
+	path := ctx.String("report_file")
+	if path == "" && ctx.NArg() > 0 {
+		path = ctx.Args().First()
+	}
+
+	if path == "" {
+		return cli.ShowCommandHelp(ctx, "verifyreport")
+	}
+
+	pubkey, err := hex.DecodeString(ctx.String("pubkey"))
+	if err != nil {
+		return fmt.Errorf("invalid pubkey: %w", err)
+	}
+
+	reportJSON, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var (
+		report    proto.Message
+		signature func() *frdrpc.ReportSignature
+	)
+
+	// Parse the report and then clear its signature, since its digest
+	// is calculated without the signature.
+	switch ctx.String("type") {
+	case "audit":
+		audit := &frdrpc.NodeAuditResponse{}
+		signature = func() *frdrpc.ReportSignature {
+			sig := audit.Signature
+			audit.Signature = nil
+			return sig
+		}
+		report = audit
+
+	case "closereport":
+		closeReport := &frdrpc.CloseReportResponse{}
+		signature = func() *frdrpc.ReportSignature {
+			sig := closeReport.Signature
+			closeReport.Signature = nil
+			return sig
+		}
+		report = closeReport
+
+	default:
+		return fmt.Errorf("unknown report type: %v", ctx.String("type"))
+	}
+
+	err = lnrpc.ProtoJSONUnmarshalOpts.Unmarshal(reportJSON, report)
+	if err != nil {
+		return fmt.Errorf("could not parse report: %w", err)
+	}
+
+	sig := signature()
+	if sig == nil {
+		return errors.New("report is not signed")
+	}
+
+	if sig.EncodingVersion != attestation.EncodingVersion {
+		return fmt.Errorf("unsupported report encoding version: %v, "+
+			"expected: %v", sig.EncodingVersion,
+			attestation.EncodingVersion)
+	}
+
+	digest, err := attestation.Digest(report)
+	if err != nil {
+		return err
+	}
+
+	if hex.EncodeToString(digest) != sig.Digest {
+		return errors.New("report does not match its signed digest, " +
+			"it has been edited")
+	}
+
+	err = attestation.Verify(digest, sig.Signature, pubkey)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Report signed by %x is valid\n", pubkey)
+
+	return nil
+}
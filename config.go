@@ -10,7 +10,12 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/faraday/accounting"
+	"github.com/lightninglabs/faraday/alerts"
 	"github.com/lightninglabs/faraday/chain"
+	"github.com/lightninglabs/faraday/jobs"
+	"github.com/lightninglabs/faraday/journal"
+	"github.com/lightninglabs/faraday/metrics"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/cert"
@@ -153,9 +158,42 @@ type Config struct { //nolint:maligned
 	// CORSOrigin specifies the CORS header that should be set on REST responses. No header is added if the value is empty.
 	CORSOrigin string `long:"corsorigin" description:"The value to send in the Access-Control-Allow-Origin header. Header will be omitted if empty."`
 
+	// DisableHtlcMonitor disables recording of forwarding failures from
+	// lnd's htlc event stream.
+	DisableHtlcMonitor bool `long:"disablehtlcmonitor" description:"Disable recording of forwarding failures from lnd's htlc event stream."`
+
+	// DisablePolicyMonitor disables recording of changes to our channel
+	// policies.
+	DisablePolicyMonitor bool `long:"disablepolicymonitor" description:"Disable recording of changes to our channel fee policies."`
+
+	// DisableArchive disables archiving of lnd's invoices, payments and
+	// forwards.
+	DisableArchive bool `long:"disablearchive" description:"Disable archiving of lnd's invoices, payments and forwards, which keeps audits complete after lnd deletes payments or forwarding history."`
+
+	// JobRetention is the amount of time that we keep the results of
+	// background jobs for.
+	JobRetention time.Duration `long:"jobretention" description:"The amount of time that the results of background audit jobs are kept for. Valid time units are {s, m, h}."`
+
+	// AuditParallelism is the number of queries to lnd and our price
+	// source that we run concurrently when producing audits.
+	AuditParallelism int `long:"auditparallelism" description:"The number of queries to lnd and the fiat price source that are run concurrently when producing audits."`
+
+	// InvoiceLookback is the amount of time before the start of an audit
+	// that we query invoices from.
+	InvoiceLookback time.Duration `long:"invoicelookback" description:"If set, audits only query the invoices created from this amount of time before the start of the audit, rather than all invoices. Invoices that were created earlier than this, but settled within the audit, are not included, so this should be longer than the expiry of the node's invoices. Valid time units are {s, m, h}."`
+
 	// Bitcoin is the configuration required to connect to a bitcoin node.
 	Bitcoin *chain.BitcoinConfig `group:"bitcoin" namespace:"bitcoin"`
 
+	// Metrics is the configuration for exporting prometheus metrics.
+	Metrics *metrics.Config `group:"metrics" namespace:"metrics"`
+
+	// Alerts is the configuration for our alert rules.
+	Alerts *alerts.Config `group:"alerts" namespace:"alerts"`
+
+	// Journal is the configuration for recording new accounting entries.
+	Journal *journal.Config `group:"journal" namespace:"journal"`
+
 	// Logging controls various aspects of pool logging.
 	Logging *build.LogConfig `group:"logging" namespace:"logging"`
 }
@@ -177,7 +215,12 @@ func DefaultConfig() Config {
 		MacaroonPath:     DefaultMacaroonPath,
 		RPCListen:        defaultRPCListen,
 		ChainConn:        defaultChainConn,
+		JobRetention:     jobs.DefaultRetention,
+		AuditParallelism: accounting.DefaultFetchParallelism,
 		Bitcoin:          chain.DefaultConfig,
+		Metrics:          metrics.DefaultConfig,
+		Alerts:           alerts.DefaultConfig,
+		Journal:          journal.DefaultConfig,
 		Logging:          build.DefaultLogConfig(),
 	}
 }
@@ -191,6 +234,29 @@ func ValidateConfig(config *Config) error {
 		return fmt.Errorf("error validating network: %v", err)
 	}
 
+	if config.AuditParallelism < 1 {
+		return fmt.Errorf("auditparallelism must be at least 1, got: %v",
+			config.AuditParallelism)
+	}
+
+	if config.InvoiceLookback < 0 {
+		return fmt.Errorf("invoicelookback must not be negative, got: "+
+			"%v", config.InvoiceLookback)
+	}
+
+	if config.Metrics.Listen != "" && config.Metrics.Interval <= 0 {
+		return fmt.Errorf("metrics.interval must be positive, got: %v",
+			config.Metrics.Interval)
+	}
+
+	if err := config.Alerts.Validate(); err != nil {
+		return err
+	}
+
+	if err := config.Journal.Validate(); err != nil {
+		return err
+	}
+
 	// Clean up and validate paths, then make sure the directories exist.
 	config.FaradayDir = lncfg.CleanAndExpandPath(config.FaradayDir)
 	config.TLSCertPath = lncfg.CleanAndExpandPath(config.TLSCertPath)
@@ -254,10 +320,11 @@ func ValidateConfig(config *Config) error {
 		)
 	}
 
-	// If the user has opted into connecting to a bitcoin backend, check
-	// that we have a rpc user and password, and that tls path is set if
-	// required.
-	if config.ChainConn {
+	// If the user has opted into connecting to a bitcoin rpc backend,
+	// check that we have a rpc user and password, and that tls path is
+	// set if required. Esplora and electrum backends do not require
+	// credentials.
+	if config.ChainConn && config.Bitcoin.Backend == chain.BackendRPC {
 		if config.Bitcoin.User == "" || config.Bitcoin.Password == "" {
 			return fmt.Errorf("rpc user and password " +
 				"required when chainconn is set")
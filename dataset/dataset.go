@@ -1,5 +1,6 @@
 // Package dataset provides a basic dataset type which provides functionality
-// for detecting inter-quartile range outliers.
+// for detecting outliers using inter-quartile ranges, modified z-scores,
+// percentile cutoffs and log-transformed inter-quartile ranges.
 package dataset
 
 import (
@@ -112,13 +113,9 @@ type OutlierResult struct {
 	LowerOutlier bool
 }
 
-// isIQROutlier returns an outlier result which indicates whether a value is an
-// upper or lower outlier (or not an outlier) for the dataset.
-// If a value is an upper or lower outlier, the result is recorded in the
-// corresponding bool in an outlier result.
-func (d Dataset) isIQROutlier(value, lowerQuartile, upperQuartile,
-	multiplier float64) *OutlierResult {
-
+// iqrBounds returns the bounds beyond which a value is considered to be an
+// inter-quartile range outlier.
+func iqrBounds(lowerQuartile, upperQuartile, multiplier float64) *Bounds {
 	interquartileRange := upperQuartile - lowerQuartile
 
 	// quartileDistance is the distance from the upper/lower quartile a value
@@ -127,15 +124,15 @@ func (d Dataset) isIQROutlier(value, lowerQuartile, upperQuartile,
 	// from the upper/lower quartile.
 	quartileDistance := interquartileRange * multiplier
 
-	return &OutlierResult{
-		// A value is considered to be a upper outlier if it lies above the
-		// upper quartile by the chosen quartile distance.
-		UpperOutlier: value > upperQuartile+quartileDistance,
+	return &Bounds{
+		// A value is considered to be a lower outlier if it lies beneath
+		// the lower quartile by the chosen quartile distance for
+		// calculating outliers.
+		Lower: lowerQuartile - quartileDistance,
 
-		// A value is considered to be a lower outlier if it lies beneath the
-		// lower quartile by the chosen quartile distance for calculating
-		// outliers.
-		LowerOutlier: value < lowerQuartile-quartileDistance,
+		// A value is considered to be a upper outlier if it lies above
+		// the upper quartile by the chosen quartile distance.
+		Upper: upperQuartile + quartileDistance,
 	}
 }
 
@@ -179,41 +176,12 @@ func (d Dataset) isIQROutlier(value, lowerQuartile, upperQuartile,
 func (d Dataset) GetOutliers(outlierMultiplier float64) (
 	map[string]*OutlierResult, error) {
 
-	outliers := make(map[string]*OutlierResult, len(d))
-
-	lower, upper, err := d.quartiles()
-	// If we could not calculate quartiles because there are too few values,
-	// we cannot calculate outliers so we return a map with all false
-	// outlier results.
-	if err == errTooFewValues {
-		log.Debug("could not calculate quartiles: %v, returning an "+
-			"empty set of outliers", err)
-
-		// Return a map with no outliers.
-		for label := range d {
-			outliers[label] = &OutlierResult{
-				UpperOutlier: false,
-				LowerOutlier: false,
-			}
-		}
-		return outliers, nil
-	}
-	if err != nil {
-		return nil, err
-	}
-
-	log.Tracef("quartiles calculated for: %v items: upper quartile: %v, "+
-		"lower quartile: %v", len(d), upper, lower)
-
-	// If we could could calculate quartiles for the dataset, we get
-	// outliers and populate a result map.
-	for label, value := range d {
-		outliers[label] = d.isIQROutlier(
-			value, lower, upper, outlierMultiplier,
-		)
-	}
+	outliers, _, err := d.GetOutliersWithConfig(&OutlierConfig{
+		Method:     IQRMethod,
+		Multiplier: outlierMultiplier,
+	})
 
-	return outliers, nil
+	return outliers, err
 }
 
 // GetThreshold returns the set of values in a dataset <= or > a given
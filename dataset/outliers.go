@@ -0,0 +1,288 @@
+package dataset
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+var (
+	// errNegativeValue is returned when we attempt to log transform a
+	// dataset that contains negative values.
+	errNegativeValue = errors.New("log transformed outliers require " +
+		"non-negative values")
+
+	// errInvalidPercentiles is returned when the percentiles provided for
+	// percentile outliers are out of range or do not describe a range.
+	errInvalidPercentiles = errors.New("percentiles must be in [0;100] " +
+		"with lower percentile < upper percentile")
+
+	// errUnknownMethod is returned when an unknown outlier method is
+	// requested.
+	errUnknownMethod = errors.New("unknown outlier method")
+)
+
+const (
+	// madScale is the constant that the median absolute deviation is
+	// scaled by to make it a consistent estimator of the standard
+	// deviation for normally distributed data (1/0.6745).
+	madScale = 1.4826
+
+	// meanADScale is the constant that the mean absolute deviation is
+	// scaled by when the median absolute deviation is zero, as suggested
+	// by Iglewicz and Hoaglin.
+	meanADScale = 1.253314
+)
+
+// OutlierMethod indicates the statistical method used to identify outliers in
+// a dataset.
+type OutlierMethod int
+
+const (
+	// IQRMethod identifies outliers using Tukey's inter-quartile range
+	// fences, with the configured multiplier as the number of
+	// inter-quartile ranges a value must lie beyond the quartiles.
+	IQRMethod OutlierMethod = iota
+
+	// ModifiedZScoreMethod identifies outliers using the modified z-score,
+	// which is based on the median absolute deviation of the dataset. The
+	// configured multiplier is the absolute modified z-score above which
+	// a value is considered an outlier.
+	ModifiedZScoreMethod
+
+	// PercentileMethod identifies values that lie beneath the configured
+	// lower percentile or above the configured upper percentile as
+	// outliers.
+	PercentileMethod
+
+	// LogIQRMethod identifies outliers using inter-quartile range fences
+	// on the log transformed dataset, which is more suitable for heavy
+	// tailed data. Values are transformed with ln(1+x) so that zero values
+	// are permitted.
+	LogIQRMethod
+)
+
+// String returns the string representation of an outlier method.
+func (o OutlierMethod) String() string {
+	switch o {
+	case IQRMethod:
+		return "iqr"
+
+	case ModifiedZScoreMethod:
+		return "modified z-score"
+
+	case PercentileMethod:
+		return "percentile"
+
+	case LogIQRMethod:
+		return "log iqr"
+
+	default:
+		return fmt.Sprintf("unknown: %d", o)
+	}
+}
+
+// OutlierConfig describes the method we use to detect outliers and the
+// parameters for that method.
+type OutlierConfig struct {
+	// Method is the statistical method used to identify outliers.
+	Method OutlierMethod
+
+	// Multiplier is the number of inter-quartile ranges a value must lie
+	// beyond the quartiles to be an outlier for the IQRMethod and
+	// LogIQRMethod, and the modified z-score threshold for the
+	// ModifiedZScoreMethod.
+	Multiplier float64
+
+	// LowerPercentile is the percentile, expressed in [0;100], beneath
+	// which values are lower outliers for the PercentileMethod.
+	LowerPercentile float64
+
+	// UpperPercentile is the percentile, expressed in [0;100], above which
+	// values are upper outliers for the PercentileMethod.
+	UpperPercentile float64
+}
+
+// Bounds contains the lower and upper values outside of which a value is
+// considered to be an outlier.
+type Bounds struct {
+	// Lower is the value beneath which values are lower outliers.
+	Lower float64
+
+	// Upper is the value above which values are upper outliers.
+	Upper float64
+}
+
+// classify returns an outlier result for a value.
+func (b *Bounds) classify(value float64) *OutlierResult {
+	return &OutlierResult{
+		UpperOutlier: value > b.Upper,
+		LowerOutlier: value < b.Lower,
+	}
+}
+
+// GetOutliersWithConfig returns a map of the labels in the dataset to outlier
+// results using the outlier method provided, along with the bounds that were
+// used to classify outliers. If there are too few values to calculate
+// outliers, it will return false values for all data points and nil bounds.
+func (d Dataset) GetOutliersWithConfig(cfg *OutlierConfig) (
+	map[string]*OutlierResult, *Bounds, error) {
+
+	outliers := make(map[string]*OutlierResult, len(d))
+
+	bounds, err := d.outlierBounds(cfg)
+	// If we could not calculate bounds because there are too few values,
+	// we cannot calculate outliers so we return a map with all false
+	// outlier results.
+	if err == errTooFewValues {
+		log.Debugf("could not calculate %v bounds: %v, returning an "+
+			"empty set of outliers", cfg.Method, err)
+
+		// Return a map with no outliers.
+		for label := range d {
+			outliers[label] = &OutlierResult{
+				UpperOutlier: false,
+				LowerOutlier: false,
+			}
+		}
+		return outliers, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	log.Tracef("%v bounds calculated for: %v items: lower: %v, upper: %v",
+		cfg.Method, len(d), bounds.Lower, bounds.Upper)
+
+	for label, value := range d {
+		outliers[label] = bounds.classify(value)
+	}
+
+	return outliers, bounds, nil
+}
+
+// outlierBounds returns the bounds for the outlier method provided.
+func (d Dataset) outlierBounds(cfg *OutlierConfig) (*Bounds, error) {
+	switch cfg.Method {
+	case IQRMethod:
+		lower, upper, err := d.quartiles()
+		if err != nil {
+			return nil, err
+		}
+
+		return iqrBounds(lower, upper, cfg.Multiplier), nil
+
+	case ModifiedZScoreMethod:
+		return d.modifiedZScoreBounds(cfg.Multiplier)
+
+	case PercentileMethod:
+		return d.percentileBounds(
+			cfg.LowerPercentile, cfg.UpperPercentile,
+		)
+
+	case LogIQRMethod:
+		return d.logIQRBounds(cfg.Multiplier)
+
+	default:
+		return nil, errUnknownMethod
+	}
+}
+
+// modifiedZScoreBounds returns the bounds beyond which a value's modified
+// z-score, (x - median) / (1.4826 * MAD), exceeds the threshold provided. If
+// the median absolute deviation is zero, which happens when more than half of
+// our values are equal, the scaled mean absolute deviation is used instead.
+func (d Dataset) modifiedZScoreBounds(threshold float64) (*Bounds, error) {
+	if len(d) < 3 {
+		return nil, errTooFewValues
+	}
+
+	values := d.rawValues()
+	median, err := getMedian(values)
+	if err != nil {
+		return nil, err
+	}
+
+	var totalDeviation float64
+	deviations := make([]float64, len(values))
+	for i, value := range values {
+		deviations[i] = math.Abs(value - median)
+		totalDeviation += deviations[i]
+	}
+
+	sort.Float64s(deviations)
+	mad, err := getMedian(deviations)
+	if err != nil {
+		return nil, err
+	}
+
+	scale := madScale * mad
+	if mad == 0 {
+		meanAD := totalDeviation / float64(len(values))
+		scale = meanADScale * meanAD
+	}
+
+	return &Bounds{
+		Lower: median - threshold*scale,
+		Upper: median + threshold*scale,
+	}, nil
+}
+
+// percentileBounds returns the values at the lower and upper percentiles
+// provided as outlier bounds.
+func (d Dataset) percentileBounds(lower, upper float64) (*Bounds, error) {
+	if lower < 0 || upper > 100 || lower >= upper {
+		return nil, errInvalidPercentiles
+	}
+
+	if len(d) < 3 {
+		return nil, errTooFewValues
+	}
+
+	values := d.rawValues()
+
+	return &Bounds{
+		Lower: percentile(values, lower),
+		Upper: percentile(values, upper),
+	}, nil
+}
+
+// logIQRBounds returns inter-quartile range bounds calculated on the log
+// transformed dataset, converted back to the original scale of our values.
+func (d Dataset) logIQRBounds(multiplier float64) (*Bounds, error) {
+	transformed := make(Dataset, len(d))
+	for label, value := range d {
+		if value < 0 {
+			return nil, errNegativeValue
+		}
+
+		transformed[label] = math.Log1p(value)
+	}
+
+	lower, upper, err := transformed.quartiles()
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := iqrBounds(lower, upper, multiplier)
+
+	return &Bounds{
+		Lower: math.Expm1(bounds.Lower),
+		Upper: math.Expm1(bounds.Upper),
+	}, nil
+}
+
+// percentile returns the value at the percentile provided for a set of
+// *already sorted* values, linearly interpolating between the closest ranks.
+func percentile(values []float64, p float64) float64 {
+	rank := p / 100 * float64(len(values)-1)
+
+	lowerIdx := int(math.Floor(rank))
+	upperIdx := int(math.Ceil(rank))
+
+	fraction := rank - float64(lowerIdx)
+
+	return values[lowerIdx] +
+		fraction*(values[upperIdx]-values[lowerIdx])
+}
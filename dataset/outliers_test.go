@@ -0,0 +1,212 @@
+package dataset
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetOutliersWithConfig tests detection of outliers and calculation of
+// outlier bounds for each of our outlier methods.
+func TestGetOutliersWithConfig(t *testing.T) {
+	// heavyTailed is a dataset with values spread over several orders of
+	// magnitude.
+	heavyTailed := map[string]float64{
+		"a": 0,
+		"b": 9,
+		"c": 99,
+		"d": 999,
+		"e": 9999,
+		"f": 99999,
+		"g": 999999,
+	}
+
+	tests := []struct {
+		name           string
+		values         map[string]float64
+		cfg            *OutlierConfig
+		expectedErr    error
+		expectedBounds *Bounds
+		expectedLower  []string
+		expectedUpper  []string
+	}{
+		{
+			name: "too few values",
+			values: map[string]float64{
+				"a": 1,
+				"b": 100,
+			},
+			cfg: &OutlierConfig{
+				Method:     ModifiedZScoreMethod,
+				Multiplier: 3.5,
+			},
+		},
+		{
+			name:   "iqr, heavy tailed",
+			values: heavyTailed,
+			cfg: &OutlierConfig{
+				Method:     IQRMethod,
+				Multiplier: 1.5,
+			},
+			expectedBounds: &Bounds{
+				Lower: 9 - 1.5*99990,
+				Upper: 99999 + 1.5*99990,
+			},
+			expectedUpper: []string{"g"},
+		},
+		{
+			name:   "log iqr, heavy tailed",
+			values: heavyTailed,
+			cfg: &OutlierConfig{
+				Method:     LogIQRMethod,
+				Multiplier: 1.5,
+			},
+			expectedBounds: &Bounds{
+				Lower: 10*1e-6 - 1,
+				Upper: 1e5*1e6 - 1,
+			},
+		},
+		{
+			name: "log iqr, negative value",
+			values: map[string]float64{
+				"a": -1,
+				"b": 1,
+				"c": 2,
+			},
+			cfg: &OutlierConfig{
+				Method: LogIQRMethod,
+			},
+			expectedErr: errNegativeValue,
+		},
+		{
+			name: "modified z-score",
+			values: map[string]float64{
+				"a": 1,
+				"b": 2,
+				"c": 3,
+				"d": 4,
+				"e": 5,
+				"f": 100,
+			},
+			cfg: &OutlierConfig{
+				Method:     ModifiedZScoreMethod,
+				Multiplier: 3.5,
+			},
+			expectedBounds: &Bounds{
+				Lower: 3.5 - 3.5*madScale*1.5,
+				Upper: 3.5 + 3.5*madScale*1.5,
+			},
+			expectedUpper: []string{"f"},
+		},
+		{
+			name: "modified z-score, zero mad",
+			values: map[string]float64{
+				"a": 5,
+				"b": 5,
+				"c": 5,
+				"d": 5,
+				"e": 9,
+			},
+			cfg: &OutlierConfig{
+				Method:     ModifiedZScoreMethod,
+				Multiplier: 3.5,
+			},
+			expectedBounds: &Bounds{
+				Lower: 5 - 3.5*meanADScale*0.8,
+				Upper: 5 + 3.5*meanADScale*0.8,
+			},
+			expectedUpper: []string{"e"},
+		},
+		{
+			name: "percentile",
+			values: map[string]float64{
+				"a": 1,
+				"b": 2,
+				"c": 3,
+				"d": 4,
+				"e": 5,
+				"f": 6,
+			},
+			cfg: &OutlierConfig{
+				Method:          PercentileMethod,
+				LowerPercentile: 10,
+				UpperPercentile: 90,
+			},
+			expectedBounds: &Bounds{
+				Lower: 1.5,
+				Upper: 5.5,
+			},
+			expectedLower: []string{"a"},
+			expectedUpper: []string{"f"},
+		},
+		{
+			name: "percentile, invalid range",
+			values: map[string]float64{
+				"a": 1,
+				"b": 2,
+				"c": 3,
+			},
+			cfg: &OutlierConfig{
+				Method:          PercentileMethod,
+				LowerPercentile: 90,
+				UpperPercentile: 10,
+			},
+			expectedErr: errInvalidPercentiles,
+		},
+		{
+			name: "unknown method",
+			values: map[string]float64{
+				"a": 1,
+				"b": 2,
+				"c": 3,
+			},
+			cfg: &OutlierConfig{
+				Method: OutlierMethod(99),
+			},
+			expectedErr: errUnknownMethod,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			outliers, bounds, err := New(test.values).
+				GetOutliersWithConfig(test.cfg)
+			require.Equal(t, test.expectedErr, err)
+			if err != nil {
+				return
+			}
+
+			if test.expectedBounds == nil {
+				require.Nil(t, bounds)
+			} else {
+				require.InEpsilon(
+					t, test.expectedBounds.Upper,
+					bounds.Upper, 1e-9,
+				)
+				require.InDelta(
+					t, test.expectedBounds.Lower,
+					bounds.Lower, 1e-9,
+				)
+			}
+
+			var lower, upper []string
+			for label, outlier := range outliers {
+				if outlier.LowerOutlier {
+					lower = append(lower, label)
+				}
+
+				if outlier.UpperOutlier {
+					upper = append(upper, label)
+				}
+			}
+
+			require.Len(t, outliers, len(test.values))
+			require.ElementsMatch(t, test.expectedLower, lower)
+			require.ElementsMatch(t, test.expectedUpper, upper)
+		})
+	}
+}
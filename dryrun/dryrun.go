@@ -0,0 +1,391 @@
+// Package dryrun simulates the impact of closing a set of our channels. It
+// estimates the on chain fees we would pay to close each channel cooperatively
+// or by force closing it at current fee estimates, and projects the forwarding
+// revenue that we would lose based on the pairwise flows in a revenue report.
+package dryrun
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/faraday/revenue"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+var (
+	// ErrNoChannels is returned when a dry run is requested without any
+	// channels.
+	ErrNoChannels = errors.New("at least one channel required for close " +
+		"dry run")
+
+	// ErrChannelNotOpen is returned when we are asked to simulate the
+	// close of a channel that is not currently open.
+	ErrChannelNotOpen = errors.New("channel not open")
+)
+
+// Config provides all the external functions and parameters required to
+// simulate channel closes.
+type Config struct {
+	// OpenChannels returns all of our currently open channels.
+	OpenChannels func() ([]lndclient.ChannelInfo, error)
+
+	// EstimateFeeRate returns a fee rate estimate for the confirmation
+	// target provided.
+	EstimateFeeRate func(confTarget int32) (chainfee.SatPerKWeight, error)
+
+	// ConfTarget is the confirmation target that we estimate fees for.
+	ConfTarget int32
+
+	// RevenueReport is a report of our channels' revenue over the revenue
+	// window.
+	RevenueReport *revenue.Report
+
+	// RevenueWindow is the period of time that our revenue report covers.
+	// It is used to calculate the rate at which we would lose revenue.
+	RevenueWindow time.Duration
+}
+
+// CloseEstimate contains the estimated on chain fees we would pay to close a
+// channel. Note that these estimates are for the fees that our node pays, so
+// cooperative close fees and commitment fees will be zero if the remote peer
+// opened the channel.
+type CloseEstimate struct {
+	// ChannelPoint is the outpoint of the channel's funding transaction.
+	ChannelPoint string
+
+	// Initiator is true if we opened the channel.
+	Initiator bool
+
+	// LocalBalance is our current balance in the channel.
+	LocalBalance btcutil.Amount
+
+	// CooperativeFee is the fee we would pay to close the channel
+	// cooperatively at the current fee estimate.
+	CooperativeFee btcutil.Amount
+
+	// ForceCommitFee is the fee that has already been committed to in our
+	// current commitment transaction, which we pay if we opened the
+	// channel.
+	ForceCommitFee btcutil.Amount
+
+	// ForceSweepFee is the fee we would pay to sweep our time locked
+	// balance back into our wallet after a force close.
+	ForceSweepFee btcutil.Amount
+
+	// ForceHtlcFee is the fee we would pay to resolve the channel's
+	// pending htlcs on chain after a force close.
+	ForceHtlcFee btcutil.Amount
+
+	// CSVDelay is the number of blocks our funds will be time locked for
+	// if we force close the channel.
+	CSVDelay uint64
+}
+
+// ForceFee returns the total fee we would pay to force close a channel.
+func (c *CloseEstimate) ForceFee() btcutil.Amount {
+	return c.ForceCommitFee + c.ForceSweepFee + c.ForceHtlcFee
+}
+
+// LostFlow describes a pairwise flow through our node that would be affected
+// by closing a set of channels.
+type LostFlow struct {
+	// IncomingChannel is the channel that forwards arrived on.
+	IncomingChannel string
+
+	// OutgoingChannel is the channel that forwards left our node on.
+	OutgoingChannel string
+
+	// Amount is the amount that was forwarded out over the outgoing
+	// channel.
+	Amount lnwire.MilliSatoshi
+
+	// Fees is the total amount of fees earned by the flow.
+	Fees lnwire.MilliSatoshi
+
+	// AlternativeRoute is true if the peers of all the closed channels in
+	// the flow have other open channels with our node that will not be
+	// closed, so the flow could still be routed through our node.
+	AlternativeRoute bool
+}
+
+// Report contains the results of a close dry run.
+type Report struct {
+	// FeeRate is the fee rate that our close estimates were calculated
+	// with.
+	FeeRate chainfee.SatPerKWeight
+
+	// Estimates contains a close fee estimate for each channel.
+	Estimates []*CloseEstimate
+
+	// LostFlows is the set of pairwise flows that include at least one of
+	// the channels we are closing.
+	LostFlows []*LostFlow
+
+	// LostFees is the total amount of fees earned over the revenue window
+	// by flows that would no longer have a route through our node.
+	LostFees lnwire.MilliSatoshi
+
+	// ReroutableFees is the total amount of fees earned over the revenue
+	// window by flows that could still be routed through another channel
+	// with the same peer.
+	ReroutableFees lnwire.MilliSatoshi
+
+	// LostFeesPerDay is the average daily fee revenue we would lose, based
+	// on the fees lost over the revenue window.
+	LostFeesPerDay lnwire.MilliSatoshi
+}
+
+// CloseDryRun simulates closing the set of channels provided.
+func CloseDryRun(cfg *Config, chanPoints []string) (*Report, error) {
+	if len(chanPoints) == 0 {
+		return nil, ErrNoChannels
+	}
+
+	channels, err := cfg.OpenChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	openChannels := make(map[string]lndclient.ChannelInfo, len(channels))
+	for _, channel := range channels {
+		openChannels[channel.ChannelPoint] = channel
+	}
+
+	closing := make(map[string]bool, len(chanPoints))
+	for _, chanPoint := range chanPoints {
+		if _, ok := openChannels[chanPoint]; !ok {
+			return nil, fmt.Errorf("%w: %v", ErrChannelNotOpen,
+				chanPoint)
+		}
+
+		closing[chanPoint] = true
+	}
+
+	feeRate, err := cfg.EstimateFeeRate(cfg.ConfTarget)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		FeeRate: feeRate,
+	}
+
+	for chanPoint := range closing {
+		channel := openChannels[chanPoint]
+		report.Estimates = append(
+			report.Estimates, estimateClose(&channel, feeRate),
+		)
+	}
+
+	sort.SliceStable(report.Estimates, func(i, j int) bool {
+		return report.Estimates[i].ChannelPoint <
+			report.Estimates[j].ChannelPoint
+	})
+
+	report.LostFlows = getLostFlows(
+		cfg.RevenueReport, openChannels, closing,
+	)
+
+	for _, flow := range report.LostFlows {
+		if flow.AlternativeRoute {
+			report.ReroutableFees += flow.Fees
+			continue
+		}
+
+		report.LostFees += flow.Fees
+	}
+
+	if days := cfg.RevenueWindow.Hours() / 24; days > 0 {
+		report.LostFeesPerDay = lnwire.MilliSatoshi(
+			float64(report.LostFees) / days,
+		)
+	}
+
+	return report, nil
+}
+
+// estimateClose estimates the fees we would pay to close a channel
+// cooperatively or by force closing it at the fee rate provided. We assume
+// that closing transactions pay out to taproot addresses, and that each
+// output we need to sweep is swept in its own transaction, so these estimates
+// are conservative.
+func estimateClose(channel *lndclient.ChannelInfo,
+	feeRate chainfee.SatPerKWeight) *CloseEstimate {
+
+	estimate := &CloseEstimate{
+		ChannelPoint: channel.ChannelPoint,
+		Initiator:    channel.Initiator,
+		LocalBalance: channel.LocalBalance,
+		CSVDelay:     channel.CSVDelay,
+	}
+
+	// The channel initiator pays the fees for the commitment transaction
+	// and the cooperative close transaction.
+	if channel.Initiator {
+		var coopClose input.TxWeightEstimator
+		coopClose.AddWitnessInput(input.MultiSigWitnessSize)
+		coopClose.AddP2TROutput()
+		coopClose.AddP2TROutput()
+
+		estimate.CooperativeFee = feeRate.FeeForWeight(
+			coopClose.Weight(),
+		)
+		estimate.ForceCommitFee = channel.CommitFee
+	}
+
+	// If we have a balance in the channel, we need to sweep it from our
+	// time locked output after a force close.
+	if channel.LocalBalance > 0 {
+		estimate.ForceSweepFee = feeRate.FeeForWeight(
+			sweepWeight(),
+		)
+	}
+
+	// Each pending htlc needs to be resolved with a second level
+	// transaction, the output of which is time locked and then swept.
+	for _, htlc := range channel.PendingHtlcs {
+		secondLevelWeight := lntypes.WeightUnit(input.HtlcTimeoutWeight)
+		if htlc.Incoming {
+			secondLevelWeight = input.HtlcSuccessWeight
+		}
+
+		estimate.ForceHtlcFee += feeRate.FeeForWeight(
+			secondLevelWeight + sweepWeight(),
+		)
+	}
+
+	return estimate
+}
+
+// sweepWeight returns the weight of a transaction which sweeps a single time
+// locked output to our wallet.
+func sweepWeight() lntypes.WeightUnit {
+	var sweep input.TxWeightEstimator
+	sweep.AddWitnessInput(input.ToLocalTimeoutWitnessSize)
+	sweep.AddP2TROutput()
+
+	return sweep.Weight()
+}
+
+// flowKey uniquely identifies a directional flow between two channels.
+type flowKey struct {
+	incoming string
+	outgoing string
+}
+
+// getLostFlows returns the set of flows in a revenue report that include one
+// of the channels that we are closing. Flows with channels that are no longer
+// open are excluded, because their revenue is already lost. Each flow is
+// marked with whether it could still be routed through our node using other
+// channels with the closed channels' peers.
+func getLostFlows(report *revenue.Report,
+	openChannels map[string]lndclient.ChannelInfo,
+	closing map[string]bool) []*LostFlow {
+
+	// Count the number of channels we will have with each peer once we
+	// have closed our channels.
+	remaining := make(map[route.Vertex]int)
+	for chanPoint, channel := range openChannels {
+		if closing[chanPoint] {
+			continue
+		}
+
+		remaining[channel.PubKeyBytes]++
+	}
+
+	// hasAlternative returns true if a channel is not being closed, or its
+	// peer has other channels with us that are not being closed.
+	hasAlternative := func(chanPoint string) bool {
+		if !closing[chanPoint] {
+			return true
+		}
+
+		return remaining[openChannels[chanPoint].PubKeyBytes] > 0
+	}
+
+	flows := make(map[flowKey]*LostFlow)
+	addFlow := func(incoming, outgoing string) {
+		key := flowKey{
+			incoming: incoming,
+			outgoing: outgoing,
+		}
+
+		// Our revenue report records each forward for both its
+		// incoming and outgoing channel, so we only add each flow
+		// once.
+		if _, ok := flows[key]; ok {
+			return
+		}
+
+		// We calculate the fees for the flow from the amounts that
+		// arrived and left our node, so that our total does not
+		// depend on how fees were attributed to each channel.
+		amtIn := report.ChannelPairs[incoming][outgoing].AmountIncoming
+		amtOut := report.ChannelPairs[outgoing][incoming].AmountOutgoing
+
+		flows[key] = &LostFlow{
+			IncomingChannel: incoming,
+			OutgoingChannel: outgoing,
+			Amount:          amtOut,
+			Fees:            amtIn - amtOut,
+			AlternativeRoute: hasAlternative(incoming) &&
+				hasAlternative(outgoing),
+		}
+	}
+
+	for chanPoint := range closing {
+		pairs, ok := report.ChannelPairs[chanPoint]
+		if !ok {
+			continue
+		}
+
+		for pair, rev := range pairs {
+			if _, ok := openChannels[pair]; !ok {
+				continue
+			}
+
+			// Outgoing amounts for our channel are flows that
+			// arrived on the pair channel and left on ours.
+			if rev.AmountOutgoing != 0 {
+				addFlow(pair, chanPoint)
+			}
+
+			// Incoming amounts for our channel are flows that
+			// arrived on our channel and left on the pair.
+			if rev.AmountIncoming != 0 {
+				addFlow(chanPoint, pair)
+			}
+		}
+	}
+
+	lostFlows := make([]*LostFlow, 0, len(flows))
+	for _, flow := range flows {
+		lostFlows = append(lostFlows, flow)
+	}
+
+	// Sort our flows so that the flows that earned the most fees are
+	// listed first.
+	sort.SliceStable(lostFlows, func(i, j int) bool {
+		if lostFlows[i].Fees != lostFlows[j].Fees {
+			return lostFlows[i].Fees > lostFlows[j].Fees
+		}
+
+		if lostFlows[i].IncomingChannel != lostFlows[j].IncomingChannel {
+			return lostFlows[i].IncomingChannel <
+				lostFlows[j].IncomingChannel
+		}
+
+		return lostFlows[i].OutgoingChannel <
+			lostFlows[j].OutgoingChannel
+	})
+
+	return lostFlows
+}
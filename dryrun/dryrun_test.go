@@ -0,0 +1,257 @@
+package dryrun
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/faraday/revenue"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	peer1 = route.Vertex{1}
+	peer2 = route.Vertex{2}
+	peer3 = route.Vertex{3}
+
+	// chanA is a channel with peer1 that we opened.
+	chanA = lndclient.ChannelInfo{
+		ChannelPoint: "a:1",
+		PubKeyBytes:  peer1,
+		Initiator:    true,
+		LocalBalance: 10000,
+		CommitFee:    300,
+		CSVDelay:     144,
+		PendingHtlcs: []lndclient.PendingHtlc{
+			{Incoming: true},
+			{Incoming: false},
+		},
+	}
+
+	// chanB is a channel with peer2 that the remote peer opened.
+	chanB = lndclient.ChannelInfo{
+		ChannelPoint: "b:1",
+		PubKeyBytes:  peer2,
+		CommitFee:    300,
+	}
+
+	// chanC is a second channel with peer2.
+	chanC = lndclient.ChannelInfo{
+		ChannelPoint: "c:1",
+		PubKeyBytes:  peer2,
+	}
+
+	// chanD is a channel with peer3.
+	chanD = lndclient.ChannelInfo{
+		ChannelPoint: "d:1",
+		PubKeyBytes:  peer3,
+	}
+
+	// revenueReport contains flows a->b (fee 100), b->a (fee 50), a->d
+	// (fee 200) and a flow from b to a channel that has already been
+	// closed.
+	revenueReport = &revenue.Report{
+		ChannelPairs: map[string]map[string]revenue.Revenue{
+			"a:1": {
+				"b:1": {
+					AmountIncoming: 1000,
+					FeesIncoming:   100,
+					AmountOutgoing: 450,
+					FeesOutgoing:   50,
+				},
+				"d:1": {
+					AmountIncoming: 2000,
+					FeesIncoming:   200,
+				},
+			},
+			"b:1": {
+				"a:1": {
+					AmountOutgoing: 900,
+					FeesOutgoing:   100,
+					AmountIncoming: 500,
+					FeesIncoming:   50,
+				},
+				"closed:1": {
+					AmountIncoming: 5000,
+					FeesIncoming:   500,
+				},
+			},
+			"d:1": {
+				"a:1": {
+					AmountOutgoing: 1800,
+					FeesOutgoing:   200,
+				},
+			},
+			"closed:1": {
+				"b:1": {
+					AmountOutgoing: 4500,
+					FeesOutgoing:   500,
+				},
+			},
+		},
+	}
+)
+
+// TestCloseDryRun tests simulation of closing sets of channels.
+func TestCloseDryRun(t *testing.T) {
+	openChannels := func() ([]lndclient.ChannelInfo, error) {
+		return []lndclient.ChannelInfo{
+			chanA, chanB, chanC, chanD,
+		}, nil
+	}
+
+	tests := []struct {
+		name            string
+		chanPoints      []string
+		expectedErr     error
+		expectedFlows   []*LostFlow
+		expectedLost    int64
+		expectedReroute int64
+	}{
+		{
+			name:        "no channels",
+			expectedErr: ErrNoChannels,
+		},
+		{
+			name:        "channel not open",
+			chanPoints:  []string{"closed:1"},
+			expectedErr: ErrChannelNotOpen,
+		},
+		{
+			name:       "peer has other channel",
+			chanPoints: []string{"b:1"},
+			expectedFlows: []*LostFlow{
+				{
+					IncomingChannel:  "a:1",
+					OutgoingChannel:  "b:1",
+					Amount:           900,
+					Fees:             100,
+					AlternativeRoute: true,
+				},
+				{
+					IncomingChannel:  "b:1",
+					OutgoingChannel:  "a:1",
+					Amount:           450,
+					Fees:             50,
+					AlternativeRoute: true,
+				},
+			},
+			expectedReroute: 150,
+		},
+		{
+			name:       "peer has no other channel",
+			chanPoints: []string{"a:1"},
+			expectedFlows: []*LostFlow{
+				{
+					IncomingChannel: "a:1",
+					OutgoingChannel: "d:1",
+					Amount:          1800,
+					Fees:            200,
+				},
+				{
+					IncomingChannel: "a:1",
+					OutgoingChannel: "b:1",
+					Amount:          900,
+					Fees:            100,
+				},
+				{
+					IncomingChannel: "b:1",
+					OutgoingChannel: "a:1",
+					Amount:          450,
+					Fees:            50,
+				},
+			},
+			expectedLost: 350,
+		},
+		{
+			name:       "both channels in flow closed",
+			chanPoints: []string{"a:1", "b:1", "c:1"},
+			expectedFlows: []*LostFlow{
+				{
+					IncomingChannel: "a:1",
+					OutgoingChannel: "d:1",
+					Amount:          1800,
+					Fees:            200,
+				},
+				{
+					IncomingChannel: "a:1",
+					OutgoingChannel: "b:1",
+					Amount:          900,
+					Fees:            100,
+				},
+				{
+					IncomingChannel: "b:1",
+					OutgoingChannel: "a:1",
+					Amount:          450,
+					Fees:            50,
+				},
+			},
+			expectedLost: 350,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := &Config{
+				OpenChannels: openChannels,
+				EstimateFeeRate: func(int32) (
+					chainfee.SatPerKWeight, error) {
+
+					return 1000, nil
+				},
+				RevenueReport: revenueReport,
+				RevenueWindow: time.Hour * 24 * 10,
+			}
+
+			report, err := CloseDryRun(cfg, test.chanPoints)
+			require.True(t, errors.Is(err, test.expectedErr))
+			if test.expectedErr != nil {
+				return
+			}
+
+			require.Len(t, report.Estimates, len(test.chanPoints))
+			require.Equal(t, test.expectedFlows, report.LostFlows)
+			require.EqualValues(t, test.expectedLost,
+				report.LostFees)
+			require.EqualValues(t, test.expectedReroute,
+				report.ReroutableFees)
+			require.EqualValues(t, test.expectedLost/10,
+				report.LostFeesPerDay)
+		})
+	}
+}
+
+// TestEstimateClose tests estimation of the fees we pay to close channels.
+func TestEstimateClose(t *testing.T) {
+	var feeRate chainfee.SatPerKWeight = 1000
+
+	// We opened channel a, so we pay for the cooperative close and the
+	// commitment fee. At 1000 sat/kw, our fees are equal to the weights
+	// of each transaction.
+	estimate := estimateClose(&chanA, feeRate)
+	require.Equal(t, &CloseEstimate{
+		ChannelPoint:   "a:1",
+		Initiator:      true,
+		LocalBalance:   10000,
+		CooperativeFee: 772,
+		ForceCommitFee: 300,
+		ForceSweepFee:  534,
+		ForceHtlcFee:   703 + 534 + 663 + 534,
+		CSVDelay:       144,
+	}, estimate)
+	require.Equal(t, btcutil.Amount(300+534+2434), estimate.ForceFee())
+
+	// The remote peer opened channel b and we have no balance, so we
+	// have no fees to pay.
+	estimate = estimateClose(&chanB, feeRate)
+	require.Zero(t, estimate.CooperativeFee)
+	require.Zero(t, estimate.ForceFee())
+}
@@ -0,0 +1,73 @@
+// Package failures records forwards that failed at our node and produces
+// reports of the volume and fees that we missed as a result. Failed forwards
+// are obtained from lnd's htlc event stream, which only provides events as
+// they happen, so they are persisted so that reports can cover any period
+// that faraday has been monitoring for.
+package failures
+
+import (
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// ReasonDownstream is the failure reason we record for forwards that were
+// successfully sent out over our outgoing channel, but failed further along
+// the route.
+const ReasonDownstream = "DOWNSTREAM_FAILURE"
+
+// Failure represents a forward that failed at our node.
+type Failure struct {
+	// Timestamp is the time that the forward failed.
+	Timestamp time.Time
+
+	// IncomingChannel is the short channel id of the channel that the
+	// htlc arrived on.
+	IncomingChannel uint64
+
+	// IncomingHtlcID is the index of the htlc in the incoming channel.
+	IncomingHtlcID uint64
+
+	// OutgoingChannel is the short channel id that the htlc was requested
+	// to be forwarded over. Note that this may not be one of our channels
+	// if the forward failed because we do not know the next peer.
+	OutgoingChannel uint64
+
+	// IncomingAmount is the amount of the incoming htlc.
+	IncomingAmount lnwire.MilliSatoshi
+
+	// OutgoingAmount is the amount that was requested to be forwarded.
+	OutgoingAmount lnwire.MilliSatoshi
+
+	// Reason is the reason that the forward failed. This is the name of
+	// lnd's failure detail if provided, falling back to the wire failure
+	// code otherwise.
+	Reason string
+}
+
+// Fee returns the fee that we would have earned if the forward succeeded.
+func (f *Failure) Fee() lnwire.MilliSatoshi {
+	// We should not be offered htlcs that pay us a negative fee, but we
+	// check this case so that we do not underflow.
+	if f.OutgoingAmount > f.IncomingAmount {
+		return 0
+	}
+
+	return f.IncomingAmount - f.OutgoingAmount
+}
+
+// linkFailReason returns the failure reason for a link failure. We prefer
+// lnd's failure detail because it is more specific, and fall back to the wire
+// failure if no detail is provided.
+func linkFailReason(event *routerrpc.LinkFailEvent) string {
+	switch event.FailureDetail {
+	case routerrpc.FailureDetail_UNKNOWN,
+		routerrpc.FailureDetail_NO_DETAIL:
+
+		return event.WireFailure.String()
+
+	default:
+		return event.FailureDetail.String()
+	}
+}
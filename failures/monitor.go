@@ -0,0 +1,252 @@
+package failures
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// errMonitorAlreadyStarted is returned if the monitor is started more
+	// than once.
+	errMonitorAlreadyStarted = errors.New("failure monitor already " +
+		"started")
+
+	// errStreamClosed is returned when lnd closes our htlc event stream.
+	errStreamClosed = errors.New("htlc event stream closed")
+)
+
+// defaultResubscribeDelay is the amount of time we wait before resubscribing
+// to lnd's htlc event stream after it fails.
+const defaultResubscribeDelay = time.Second * 30
+
+// MonitorConfig provides the functions required to monitor failed forwards.
+type MonitorConfig struct {
+	// SubscribeHtlcEvents subscribes to lnd's htlc event stream.
+	SubscribeHtlcEvents func(ctx context.Context) (
+		<-chan *routerrpc.HtlcEvent, <-chan error, error)
+
+	// AddFailure persists a failed forward.
+	AddFailure func(failure *Failure) error
+
+	// ResubscribeDelay is the amount of time we wait before resubscribing
+	// to lnd's htlc event stream after it fails. If this value is not set,
+	// a default of 30 seconds is used.
+	ResubscribeDelay time.Duration
+}
+
+// htlcKey uniquely identifies a htlc that was forwarded through our node by
+// its incoming channel and index.
+type htlcKey struct {
+	channel uint64
+	htlcID  uint64
+}
+
+// Monitor consumes lnd's htlc event stream and records failed forwards.
+type Monitor struct {
+	started int32 // To be used atomically.
+
+	cfg *MonitorConfig
+
+	// forwards tracks the htlcs that we have forwarded which have not yet
+	// been resolved. We need to keep track of these htlcs because lnd's
+	// forward fail events do not include the htlc's amounts. This map is
+	// only accessed by our event loop, so it does not need a mutex.
+	forwards map[htlcKey]*routerrpc.HtlcInfo
+
+	cancel func()
+	wg     sync.WaitGroup
+}
+
+// NewMonitor returns a monitor for failed forwards. Note that the monitor is
+// not running, and should be started using Start().
+func NewMonitor(cfg *MonitorConfig) *Monitor {
+	if cfg.ResubscribeDelay == 0 {
+		cfg.ResubscribeDelay = defaultResubscribeDelay
+	}
+
+	return &Monitor{
+		cfg:      cfg,
+		forwards: make(map[htlcKey]*routerrpc.HtlcInfo),
+	}
+}
+
+// Start starts consuming lnd's htlc event stream.
+func (m *Monitor) Start() error {
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return errMonitorAlreadyStarted
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		m.run(ctx)
+	}()
+
+	return nil
+}
+
+// Stop stops the monitor and waits for it to exit.
+func (m *Monitor) Stop() {
+	if m.cancel != nil {
+		m.cancel()
+	}
+
+	m.wg.Wait()
+}
+
+// run subscribes to lnd's htlc event stream and consumes events until our
+// context is cancelled, resubscribing if the stream fails.
+func (m *Monitor) run(ctx context.Context) {
+	for {
+		err := m.consumeEvents(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		log.Errorf("Htlc event stream failed, resubscribing in %v: %v",
+			m.cfg.ResubscribeDelay, err)
+
+		// We will not receive resolutions for any htlcs that were
+		// resolved while we were not subscribed, so we clear our
+		// set of pending forwards.
+		m.forwards = make(map[htlcKey]*routerrpc.HtlcInfo)
+
+		select {
+		case <-time.After(m.cfg.ResubscribeDelay):
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// consumeEvents subscribes to lnd's htlc event stream and handles events until
+// the stream fails or our context is cancelled.
+func (m *Monitor) consumeEvents(ctx context.Context) error {
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	events, errChan, err := m.cfg.SubscribeHtlcEvents(streamCtx)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Subscribed to htlc events")
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return errStreamClosed
+			}
+
+			if err := m.handleEvent(event); err != nil {
+				log.Errorf("Could not record htlc event: %v",
+					err)
+			}
+
+		case err, ok := <-errChan:
+			if !ok {
+				return errStreamClosed
+			}
+
+			return err
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// handleEvent handles a single htlc event, persisting it if it is a failed
+// forward.
+func (m *Monitor) handleEvent(event *routerrpc.HtlcEvent) error {
+	key := htlcKey{
+		channel: event.IncomingChannelId,
+		htlcID:  event.IncomingHtlcId,
+	}
+
+	// Final htlc events may not be labelled as forwards, so we stop
+	// tracking the htlc before we filter by event type.
+	if _, ok := event.Event.(*routerrpc.HtlcEvent_FinalHtlcEvent); ok {
+		delete(m.forwards, key)
+		return nil
+	}
+
+	// We are only interested in forwards, sends and receives do not
+	// represent revenue that we missed.
+	if event.EventType != routerrpc.HtlcEvent_FORWARD {
+		return nil
+	}
+
+	switch e := event.Event.(type) {
+	// If the htlc was successfully forwarded, we track it until it is
+	// resolved so that we have its amounts if it fails downstream.
+	case *routerrpc.HtlcEvent_ForwardEvent:
+		if e.ForwardEvent.Info != nil {
+			m.forwards[key] = e.ForwardEvent.Info
+		}
+
+		return nil
+
+	// If the htlc failed downstream, we record the failure if we have
+	// its amounts.
+	case *routerrpc.HtlcEvent_ForwardFailEvent:
+		info, ok := m.forwards[key]
+		if !ok {
+			log.Debugf("No forward for failed htlc: %v(%v)",
+				key.channel, key.htlcID)
+
+			return nil
+		}
+		delete(m.forwards, key)
+
+		return m.cfg.AddFailure(
+			newFailure(event, info, ReasonDownstream),
+		)
+
+	// If the htlc failed at our node, the event contains the htlc's
+	// amounts and the reason for the failure.
+	case *routerrpc.HtlcEvent_LinkFailEvent:
+		if e.LinkFailEvent.Info == nil {
+			return nil
+		}
+
+		return m.cfg.AddFailure(newFailure(
+			event, e.LinkFailEvent.Info,
+			linkFailReason(e.LinkFailEvent),
+		))
+
+	// Once the htlc is settled, we no longer need to track it.
+	case *routerrpc.HtlcEvent_SettleEvent:
+		delete(m.forwards, key)
+		return nil
+
+	default:
+		return nil
+	}
+}
+
+// newFailure creates a failure from a htlc event and its amounts.
+func newFailure(event *routerrpc.HtlcEvent, info *routerrpc.HtlcInfo,
+	reason string) *Failure {
+
+	return &Failure{
+		Timestamp:       time.Unix(0, int64(event.TimestampNs)),
+		IncomingChannel: event.IncomingChannelId,
+		IncomingHtlcID:  event.IncomingHtlcId,
+		OutgoingChannel: event.OutgoingChannelId,
+		IncomingAmount:  lnwire.MilliSatoshi(info.IncomingAmtMsat),
+		OutgoingAmount:  lnwire.MilliSatoshi(info.OutgoingAmtMsat),
+		Reason:          reason,
+	}
+}
@@ -0,0 +1,123 @@
+package failures
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHandleEvent tests recording of failed forwards from htlc events.
+func TestHandleEvent(t *testing.T) {
+	var failures []*Failure
+	monitor := NewMonitor(&MonitorConfig{
+		AddFailure: func(failure *Failure) error {
+			failures = append(failures, failure)
+			return nil
+		},
+	})
+
+	info := &routerrpc.HtlcInfo{
+		IncomingAmtMsat: 2000,
+		OutgoingAmtMsat: 1900,
+	}
+
+	forward := func(htlcID uint64,
+		event interface{}) *routerrpc.HtlcEvent {
+
+		htlcEvent := &routerrpc.HtlcEvent{
+			IncomingChannelId: 1,
+			OutgoingChannelId: 2,
+			IncomingHtlcId:    htlcID,
+			TimestampNs:       uint64(htlcID),
+			EventType:         routerrpc.HtlcEvent_FORWARD,
+		}
+
+		switch e := event.(type) {
+		case *routerrpc.ForwardEvent:
+			htlcEvent.Event = &routerrpc.HtlcEvent_ForwardEvent{
+				ForwardEvent: e,
+			}
+
+		case *routerrpc.ForwardFailEvent:
+			htlcEvent.Event = &routerrpc.HtlcEvent_ForwardFailEvent{
+				ForwardFailEvent: e,
+			}
+
+		case *routerrpc.LinkFailEvent:
+			htlcEvent.Event = &routerrpc.HtlcEvent_LinkFailEvent{
+				LinkFailEvent: e,
+			}
+
+		case *routerrpc.SettleEvent:
+			htlcEvent.Event = &routerrpc.HtlcEvent_SettleEvent{
+				SettleEvent: e,
+			}
+		}
+
+		return htlcEvent
+	}
+
+	events := []*routerrpc.HtlcEvent{
+		// Htlc 1 is forwarded and then settled, so it should not be
+		// recorded.
+		forward(1, &routerrpc.ForwardEvent{Info: info}),
+		forward(1, &routerrpc.SettleEvent{}),
+
+		// Htlc 2 is forwarded and then fails downstream.
+		forward(2, &routerrpc.ForwardEvent{Info: info}),
+		forward(2, &routerrpc.ForwardFailEvent{}),
+
+		// Htlc 3 fails downstream, but we did not see it forwarded so
+		// we do not know its amounts.
+		forward(3, &routerrpc.ForwardFailEvent{}),
+
+		// Htlc 4 fails at our node with a failure detail.
+		forward(4, &routerrpc.LinkFailEvent{
+			Info:        info,
+			WireFailure: lnrpc.Failure_TEMPORARY_CHANNEL_FAILURE,
+			FailureDetail: routerrpc.
+				FailureDetail_INSUFFICIENT_BALANCE,
+		}),
+
+		// Htlc 5 fails at our node without a failure detail.
+		forward(5, &routerrpc.LinkFailEvent{
+			Info:          info,
+			WireFailure:   lnrpc.Failure_FEE_INSUFFICIENT,
+			FailureDetail: routerrpc.FailureDetail_NO_DETAIL,
+		}),
+	}
+
+	// Add a failed send, which should not be recorded.
+	send := forward(6, &routerrpc.LinkFailEvent{Info: info})
+	send.EventType = routerrpc.HtlcEvent_SEND
+	events = append(events, send)
+
+	for _, event := range events {
+		require.NoError(t, monitor.handleEvent(event))
+	}
+
+	expected := func(htlcID uint64, reason string) *Failure {
+		return &Failure{
+			Timestamp:       time.Unix(0, int64(htlcID)),
+			IncomingChannel: 1,
+			IncomingHtlcID:  htlcID,
+			OutgoingChannel: 2,
+			IncomingAmount:  2000,
+			OutgoingAmount:  1900,
+			Reason:          reason,
+		}
+	}
+
+	require.Equal(t, []*Failure{
+		expected(2, ReasonDownstream),
+		expected(4, "INSUFFICIENT_BALANCE"),
+		expected(5, "FEE_INSUFFICIENT"),
+	}, failures)
+
+	// All of our forwards have been resolved, so we should not be
+	// tracking any htlcs.
+	require.Empty(t, monitor.forwards)
+}
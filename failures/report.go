@@ -0,0 +1,153 @@
+package failures
+
+import (
+	"sort"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// Config contains the functions required to produce a failure report.
+type Config struct {
+	// ListChannels returns all open, public channels.
+	ListChannels func() ([]lndclient.ChannelInfo, error)
+
+	// ClosedChannels returns all closed channels.
+	ClosedChannels func() ([]lndclient.ClosedChannel, error)
+
+	// ListFailures returns the failed forwards that occurred in
+	// [start, end).
+	ListFailures func(start, end time.Time) ([]*Failure, error)
+
+	// StartTime is the beginning of the period the report covers.
+	StartTime time.Time
+
+	// EndTime is the end of the period the report covers.
+	EndTime time.Time
+}
+
+// Missed contains the volume and fees that we missed due to failed forwards.
+type Missed struct {
+	// Count is the number of failed forwards.
+	Count int
+
+	// Volume is the total amount that we failed to forward.
+	Volume lnwire.MilliSatoshi
+
+	// Fees is the total amount of fees we would have earned if the
+	// forwards had succeeded.
+	Fees lnwire.MilliSatoshi
+}
+
+// add adds a failure to our missed totals.
+func (m *Missed) add(failure *Failure) {
+	m.Count++
+	m.Volume += failure.OutgoingAmount
+	m.Fees += failure.Fee()
+}
+
+// ChannelReport contains the failed forwards for an outgoing channel.
+type ChannelReport struct {
+	// ChannelID is the short channel id that forwards were requested
+	// over.
+	ChannelID uint64
+
+	// ChannelPoint is the outpoint of the channel. This value is empty if
+	// the channel is not one of our channels.
+	ChannelPoint string
+
+	// Missed is the total missed volume and fees for the channel.
+	Missed
+
+	// Reasons contains the missed volume and fees for the channel for
+	// each failure reason.
+	Reasons map[string]*Missed
+}
+
+// Report contains the failed forwards for each outgoing channel.
+type Report struct {
+	// Missed is the total missed volume and fees across all channels.
+	Missed
+
+	// Channels contains a report for each outgoing channel, sorted by the
+	// fees that we missed.
+	Channels []*ChannelReport
+}
+
+// GetReport produces a report of the forwards that failed over the period
+// specified.
+func GetReport(cfg *Config) (*Report, error) {
+	channels, err := cfg.ListChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	closedChannels, err := cfg.ClosedChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a map of short channel id to outpoint so that we can
+	// identify the channels in our report.
+	channelIDs := make(map[uint64]string)
+	for _, channel := range channels {
+		channelIDs[channel.ChannelID] = channel.ChannelPoint
+	}
+
+	for _, closedChannel := range closedChannels {
+		channelIDs[closedChannel.ChannelID] = closedChannel.ChannelPoint
+	}
+
+	failures, err := cfg.ListFailures(cfg.StartTime, cfg.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return getReport(channelIDs, failures), nil
+}
+
+// getReport aggregates a set of failures by outgoing channel and reason.
+func getReport(channelIDs map[uint64]string, failures []*Failure) *Report {
+	report := &Report{}
+	channels := make(map[uint64]*ChannelReport)
+
+	for _, failure := range failures {
+		channelID := failure.OutgoingChannel
+
+		channel, ok := channels[channelID]
+		if !ok {
+			channel = &ChannelReport{
+				ChannelID:    channelID,
+				ChannelPoint: channelIDs[channelID],
+				Reasons:      make(map[string]*Missed),
+			}
+			channels[channelID] = channel
+		}
+
+		reason, ok := channel.Reasons[failure.Reason]
+		if !ok {
+			reason = &Missed{}
+			channel.Reasons[failure.Reason] = reason
+		}
+
+		reason.add(failure)
+		channel.add(failure)
+		report.add(failure)
+	}
+
+	for _, channel := range channels {
+		report.Channels = append(report.Channels, channel)
+	}
+
+	sort.SliceStable(report.Channels, func(i, j int) bool {
+		if report.Channels[i].Fees != report.Channels[j].Fees {
+			return report.Channels[i].Fees > report.Channels[j].Fees
+		}
+
+		return report.Channels[i].ChannelID <
+			report.Channels[j].ChannelID
+	})
+
+	return report
+}
@@ -0,0 +1,112 @@
+package failures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetReport tests aggregation of failures by channel and reason.
+func TestGetReport(t *testing.T) {
+	channelIDs := map[uint64]string{
+		1: "a:1",
+		2: "b:2",
+	}
+
+	failures := []*Failure{
+		{
+			OutgoingChannel: 1,
+			IncomingAmount:  1100,
+			OutgoingAmount:  1000,
+			Reason:          "INSUFFICIENT_BALANCE",
+		},
+		{
+			OutgoingChannel: 1,
+			IncomingAmount:  2200,
+			OutgoingAmount:  2000,
+			Reason:          "INSUFFICIENT_BALANCE",
+		},
+		{
+			OutgoingChannel: 1,
+			IncomingAmount:  500,
+			OutgoingAmount:  499,
+			Reason:          "FEE_INSUFFICIENT",
+		},
+		{
+			OutgoingChannel: 2,
+			IncomingAmount:  5000,
+			OutgoingAmount:  4000,
+			Reason:          ReasonDownstream,
+		},
+		{
+			// A failure for a channel we do not know, with a
+			// negative fee.
+			OutgoingChannel: 3,
+			IncomingAmount:  100,
+			OutgoingAmount:  200,
+			Reason:          "UNKNOWN_NEXT_PEER",
+		},
+	}
+
+	report := getReport(channelIDs, failures)
+
+	require.Equal(t, &Report{
+		Missed: Missed{
+			Count:  5,
+			Volume: 7699,
+			Fees:   1301,
+		},
+		Channels: []*ChannelReport{
+			{
+				ChannelID:    2,
+				ChannelPoint: "b:2",
+				Missed: Missed{
+					Count:  1,
+					Volume: 4000,
+					Fees:   1000,
+				},
+				Reasons: map[string]*Missed{
+					ReasonDownstream: {
+						Count:  1,
+						Volume: 4000,
+						Fees:   1000,
+					},
+				},
+			},
+			{
+				ChannelID:    1,
+				ChannelPoint: "a:1",
+				Missed: Missed{
+					Count:  3,
+					Volume: 3499,
+					Fees:   301,
+				},
+				Reasons: map[string]*Missed{
+					"INSUFFICIENT_BALANCE": {
+						Count:  2,
+						Volume: 3000,
+						Fees:   300,
+					},
+					"FEE_INSUFFICIENT": {
+						Count:  1,
+						Volume: 499,
+						Fees:   1,
+					},
+				},
+			},
+			{
+				ChannelID: 3,
+				Missed: Missed{
+					Count:  1,
+					Volume: 200,
+				},
+				Reasons: map[string]*Missed{
+					"UNKNOWN_NEXT_PEER": {
+						Count:  1,
+						Volume: 200,
+					},
+				},
+			},
+		},
+	}, report)
+}
@@ -0,0 +1,167 @@
+package failures
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"time"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// failuresBucket is the top level bucket that failed forwards are
+	// stored in. Failures are keyed by timestamp, incoming channel and
+	// incoming htlc id so that they are sorted by time and we can query
+	// for failures within a time range.
+	failuresBucket = []byte("htlc-failures")
+
+	// errBucketNotFound is returned if our failures bucket has not been
+	// created.
+	errBucketNotFound = errors.New("failures bucket not found")
+
+	// errInvalidValue is returned when a stored failure cannot be decoded.
+	errInvalidValue = errors.New("invalid stored failure")
+)
+
+const (
+	// keyLength is the length of our failure keys: an 8 byte timestamp,
+	// followed by an 8 byte incoming channel id and an 8 byte htlc id.
+	keyLength = 24
+
+	// valueLength is the length of the fixed sized fields in our failure
+	// values: an 8 byte outgoing channel id, followed by 8 byte incoming
+	// and outgoing amounts. The failure reason follows these fields.
+	valueLength = 24
+)
+
+// Store persists failed forwards.
+type Store struct {
+	db kvdb.Backend
+}
+
+// NewStore creates a failure store backed by the database provided, creating
+// our failures bucket if it does not yet exist.
+func NewStore(db kvdb.Backend) (*Store, error) {
+	err := kvdb.Update(db, func(tx kvdb.RwTx) error {
+		_, err := tx.CreateTopLevelBucket(failuresBucket)
+		return err
+	}, func() {})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Store{
+		db: db,
+	}, nil
+}
+
+// AddFailure persists a failed forward. If the failure has already been
+// stored, it is overwritten.
+func (s *Store) AddFailure(failure *Failure) error {
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(failuresBucket)
+		if bucket == nil {
+			return errBucketNotFound
+		}
+
+		return bucket.Put(failureKey(failure), failureValue(failure))
+	}, func() {})
+}
+
+// ListFailures returns all the failures that occurred in [start, end).
+func (s *Store) ListFailures(start, end time.Time) ([]*Failure, error) {
+	var failures []*Failure
+
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(failuresBucket)
+		if bucket == nil {
+			return errBucketNotFound
+		}
+
+		var (
+			cursor   = bucket.ReadCursor()
+			startKey = timestampKey(start)
+			endKey   = timestampKey(end)
+		)
+
+		for k, v := cursor.Seek(startKey); k != nil &&
+			bytes.Compare(k, endKey) < 0; k, v = cursor.Next() {
+
+			failure, err := readFailure(k, v)
+			if err != nil {
+				return err
+			}
+
+			failures = append(failures, failure)
+		}
+
+		return nil
+	}, func() {
+		failures = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return failures, nil
+}
+
+// timestampKey returns the 8 byte key prefix for a timestamp. Timestamps
+// before the unix epoch are clamped to zero so that they sort first.
+func timestampKey(timestamp time.Time) []byte {
+	var ts uint64
+	if nanos := timestamp.UnixNano(); nanos > 0 {
+		ts = uint64(nanos)
+	}
+
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, ts)
+
+	return key
+}
+
+// failureKey returns the key that we store a failure under.
+func failureKey(failure *Failure) []byte {
+	key := make([]byte, keyLength)
+	copy(key, timestampKey(failure.Timestamp))
+	binary.BigEndian.PutUint64(key[8:], failure.IncomingChannel)
+	binary.BigEndian.PutUint64(key[16:], failure.IncomingHtlcID)
+
+	return key
+}
+
+// failureValue serializes the values of a failure that are not included in
+// its key.
+func failureValue(failure *Failure) []byte {
+	value := make([]byte, valueLength, valueLength+len(failure.Reason))
+	binary.BigEndian.PutUint64(value, failure.OutgoingChannel)
+	binary.BigEndian.PutUint64(value[8:], uint64(failure.IncomingAmount))
+	binary.BigEndian.PutUint64(value[16:], uint64(failure.OutgoingAmount))
+
+	return append(value, failure.Reason...)
+}
+
+// readFailure deserializes a failure from its key and value.
+func readFailure(key, value []byte) (*Failure, error) {
+	if len(key) != keyLength || len(value) < valueLength {
+		return nil, errInvalidValue
+	}
+
+	timestamp := binary.BigEndian.Uint64(key)
+
+	return &Failure{
+		Timestamp:       time.Unix(0, int64(timestamp)),
+		IncomingChannel: binary.BigEndian.Uint64(key[8:]),
+		IncomingHtlcID:  binary.BigEndian.Uint64(key[16:]),
+		OutgoingChannel: binary.BigEndian.Uint64(value),
+		IncomingAmount: lnwire.MilliSatoshi(
+			binary.BigEndian.Uint64(value[8:]),
+		),
+		OutgoingAmount: lnwire.MilliSatoshi(
+			binary.BigEndian.Uint64(value[16:]),
+		),
+		Reason: string(value[valueLength:]),
+	}, nil
+}
@@ -0,0 +1,78 @@
+package failures
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStore tests storage and time range queries of failures.
+func TestStore(t *testing.T) {
+	db, err := kvdb.GetBoltBackend(&kvdb.BoltBackendConfig{
+		DBPath:     t.TempDir(),
+		DBFileName: "test.db",
+		DBTimeout:  time.Second,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	store, err := NewStore(db)
+	require.NoError(t, err)
+
+	// Add failures out of order to check that our failures are sorted
+	// by timestamp.
+	now := time.Unix(0, 1_600_000_000_000_000_000)
+	failure1 := &Failure{
+		Timestamp:       now,
+		IncomingChannel: 1,
+		IncomingHtlcID:  2,
+		OutgoingChannel: 3,
+		IncomingAmount:  1010,
+		OutgoingAmount:  1000,
+		Reason:          "INSUFFICIENT_BALANCE",
+	}
+	failure2 := &Failure{
+		Timestamp:       now.Add(time.Hour),
+		IncomingChannel: 1,
+		IncomingHtlcID:  3,
+		OutgoingChannel: 4,
+		IncomingAmount:  2000,
+		OutgoingAmount:  1990,
+		Reason:          ReasonDownstream,
+	}
+	failure3 := &Failure{
+		Timestamp:       now.Add(time.Hour * 2),
+		IncomingChannel: 5,
+		OutgoingChannel: 3,
+		IncomingAmount:  500,
+		OutgoingAmount:  499,
+	}
+
+	for _, failure := range []*Failure{failure3, failure1, failure2} {
+		require.NoError(t, store.AddFailure(failure))
+	}
+
+	// Adding the same failure again should not duplicate it.
+	require.NoError(t, store.AddFailure(failure1))
+
+	failures, err := store.ListFailures(
+		time.Unix(0, 0), now.Add(time.Hour*3),
+	)
+	require.NoError(t, err)
+	require.Equal(t, []*Failure{failure1, failure2, failure3}, failures)
+
+	// Our end time is exclusive, so we should only get failure 2.
+	failures, err = store.ListFailures(
+		now.Add(time.Minute), now.Add(time.Hour*2),
+	)
+	require.NoError(t, err)
+	require.Equal(t, []*Failure{failure2}, failures)
+
+	failures, err = store.ListFailures(now.Add(time.Hour*3), time.Now())
+	require.NoError(t, err)
+	require.Empty(t, failures)
+}
@@ -97,11 +97,36 @@ func Main() error {
 		RestClientConfig: restClientCreds,
 		FaradayDir:       config.FaradayDir,
 		MacaroonPath:     config.MacaroonPath,
+
+		DisableHtlcMonitor:   config.DisableHtlcMonitor,
+		DisablePolicyMonitor: config.DisablePolicyMonitor,
+		DisableArchive:       config.DisableArchive,
+		JobRetention:         config.JobRetention,
+		AuditParallelism:     config.AuditParallelism,
+		InvoiceLookback:      config.InvoiceLookback,
+		MetricsListen:        config.Metrics.Listen,
+		MetricsInterval:      config.Metrics.Interval,
+		Alerts:               config.Alerts,
+		MinimumMonitored:     config.MinimumMonitored,
+		Journal:              config.Journal,
+		Version:              Version(),
 	}
 
 	// If the client chose to connect to a bitcoin client, get one now.
 	if config.ChainConn {
-		cfg.BitcoinClient, err = chain.NewBitcoinClient(config.Bitcoin)
+		txCacheDB, err := chain.OpenTxCacheDB(config.FaradayDir)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := txCacheDB.Close(); err != nil {
+				log.Errorf("Could not close tx cache: %v", err)
+			}
+		}()
+
+		cfg.BitcoinClient, err = chain.NewBitcoinClient(
+			config.Bitcoin, txCacheDB,
+		)
 		if err != nil {
 			return err
 		}
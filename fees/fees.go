@@ -6,8 +6,10 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 )
 
-// GetDetailsFunc is a function which looks up transactions by hash.
-type GetDetailsFunc func(hash *chainhash.Hash) (*btcjson.TxRawResult, error)
+// GetDetailsFunc is a function which looks up a set of transactions by hash,
+// returning them in the order that they were requested.
+type GetDetailsFunc func(hashes []*chainhash.Hash) ([]*btcjson.TxRawResult,
+	error)
 
 // CalculateFee returns the total fees for the transaction provided.
 // TODO(carla): identify change address and split fees between outputs.
@@ -16,24 +18,30 @@ func CalculateFee(details GetDetailsFunc, txid *chainhash.Hash) (btcutil.Amount,
 
 	var fees btcutil.Amount
 
-	tx, err := details(txid)
+	txs, err := details([]*chainhash.Hash{txid})
 	if err != nil {
 		return 0, err
 	}
+	tx := txs[0]
 
-	// Lookup each of our inputs and add their value to our fees.
-	for _, in := range tx.Vin {
-		prevOutHash, err := chainhash.NewHashFromStr(in.Txid)
+	// Lookup all of our inputs at once so that backends which support
+	// batching only need a single round trip.
+	prevOutHashes := make([]*chainhash.Hash, len(tx.Vin))
+	for i, in := range tx.Vin {
+		prevOutHashes[i], err = chainhash.NewHashFromStr(in.Txid)
 		if err != nil {
 			return 0, err
 		}
+	}
 
-		tx, err := details(prevOutHash)
-		if err != nil {
-			return 0, err
-		}
+	prevTxs, err := details(prevOutHashes)
+	if err != nil {
+		return 0, err
+	}
 
-		prevOut := tx.Vout[in.Vout]
+	// Add the value of each of our inputs to our fees.
+	for i, in := range tx.Vin {
+		prevOut := prevTxs[i].Vout[in.Vout]
 		amt, err := btcutil.NewAmount(prevOut.Value)
 		if err != nil {
 			return 0, err
@@ -136,18 +136,23 @@ func TestTotalFees(t *testing.T) {
 }
 
 // getDetails mocks lookup for a node that has knowledge of tx1 and tx2.
-func getDetails(txHash *chainhash.Hash) (*btcjson.TxRawResult, error) {
-	switch *txHash {
-	case *txid0:
-		return tx0, nil
-
-	case *txid1:
-		return tx1, nil
-
-	case *txid2:
-		return tx2, nil
-
-	default:
-		return nil, fmt.Errorf("transaction not found")
+func getDetails(txHashes []*chainhash.Hash) ([]*btcjson.TxRawResult, error) {
+	txs := make([]*btcjson.TxRawResult, len(txHashes))
+	for i, txHash := range txHashes {
+		switch *txHash {
+		case *txid0:
+			txs[i] = tx0
+
+		case *txid1:
+			txs[i] = tx1
+
+		case *txid2:
+			txs[i] = tx2
+
+		default:
+			return nil, fmt.Errorf("transaction not found")
+		}
 	}
+
+	return txs, nil
 }
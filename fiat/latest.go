@@ -0,0 +1,49 @@
+package fiat
+
+import (
+	"sync"
+	"time"
+)
+
+// latest holds the most recent price that we have fetched from any of our
+// price sources, and the time at which we fetched it.
+var latest struct {
+	price   *Price
+	fetched time.Time
+	mtx     sync.Mutex
+}
+
+// recordLatest records the most recent of the prices provided if it is newer
+// than the latest price we have fetched so far. Prices are expected to be
+// sorted by ascending timestamp.
+func recordLatest(prices []*Price, fetched time.Time) {
+	if len(prices) == 0 {
+		return
+	}
+
+	price := prices[len(prices)-1]
+
+	latest.mtx.Lock()
+	defer latest.mtx.Unlock()
+
+	if latest.price != nil && price.Timestamp.Before(
+		latest.price.Timestamp,
+	) {
+
+		return
+	}
+
+	latest.price = price
+	latest.fetched = fetched
+}
+
+// LatestPrice returns the most recent price that has been fetched from any of
+// our price sources, and the time at which it was fetched, so that callers can
+// monitor the freshness of our price data. The price returned is nil if no
+// prices have been fetched yet.
+func LatestPrice() (*Price, time.Time) {
+	latest.mtx.Lock()
+	defer latest.mtx.Unlock()
+
+	return latest.price, latest.fetched
+}
@@ -7,6 +7,7 @@ import (
 	"sort"
 	"time"
 
+	"github.com/lightninglabs/faraday/progress"
 	"github.com/lightninglabs/faraday/utils"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/shopspring/decimal"
@@ -148,6 +149,7 @@ func (p PriceSource) GetPrices(ctx context.Context, startTime,
 	if err != nil {
 		return nil, err
 	}
+	progress.FromContext(ctx).AddPrices(uint64(len(historicalRecords)))
 
 	// Sort by ascending timestamp once we have all of our records. We
 	// expect these records to already be sorted, but we do not trust our
@@ -157,6 +159,7 @@ func (p PriceSource) GetPrices(ctx context.Context, startTime,
 			historicalRecords[j].Timestamp,
 		)
 	})
+	recordLatest(historicalRecords, time.Now())
 
 	return historicalRecords, nil
 }
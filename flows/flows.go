@@ -0,0 +1,486 @@
+// Package flows produces a matrix of the liquidity that has flowed between
+// pairs of our channels, optionally split into time buckets, and classifies
+// each of our open channels by the dominant direction of its flows. Flows are
+// normalized by channel capacity so that channels of different sizes can be
+// compared, which is useful when planning rebalances and new channels.
+package flows
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/faraday/revenue"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// DefaultDormantTurnover is the default turnover below which we
+	// consider a channel to be dormant. A channel that has forwarded less
+	// than 1% of its capacity over the period is considered dormant.
+	DefaultDormantTurnover = 0.01
+
+	// DefaultDirectionThreshold is the default imbalance between incoming
+	// and outgoing volume above which we classify a channel as a source or
+	// sink rather than a router.
+	DefaultDirectionThreshold = 0.5
+
+	// maxBuckets is the maximum number of time buckets that we will split
+	// a report into.
+	maxBuckets = 1000
+)
+
+var (
+	// ErrInvalidRange is returned when a report is requested with a start
+	// time that is not before its end time.
+	ErrInvalidRange = errors.New("start time must be before end time")
+
+	// ErrTooManyBuckets is returned when the bucket size requested would
+	// split the report into too many buckets.
+	ErrTooManyBuckets = fmt.Errorf("bucket size too small, at most %v "+
+		"buckets are allowed", maxBuckets)
+
+	// ErrInvalidThreshold is returned when a direction threshold that is
+	// not in (0, 1] is provided.
+	ErrInvalidThreshold = errors.New("direction threshold must be in " +
+		"(0, 1]")
+)
+
+// Role describes the dominant direction of liquidity flows in a channel.
+type Role int
+
+const (
+	// RoleDormant is a channel that has not forwarded a significant amount
+	// relative to its capacity.
+	RoleDormant Role = iota
+
+	// RoleSource is a channel that forwards mostly arrive on. Liquidity
+	// flows into our side of these channels, so they accumulate local
+	// balance.
+	RoleSource
+
+	// RoleSink is a channel that forwards mostly leave our node on.
+	// Liquidity flows out of our side of these channels, so they deplete
+	// our local balance.
+	RoleSink
+
+	// RoleRouter is a channel with balanced incoming and outgoing flows.
+	RoleRouter
+)
+
+// String returns the string representation of a channel role.
+func (r Role) String() string {
+	switch r {
+	case RoleDormant:
+		return "dormant"
+
+	case RoleSource:
+		return "source"
+
+	case RoleSink:
+		return "sink"
+
+	case RoleRouter:
+		return "router"
+
+	default:
+		return "unknown"
+	}
+}
+
+// Config contains the functions and parameters required to produce a flow
+// report.
+type Config struct {
+	// ListChannels returns all of our open channels.
+	ListChannels func() ([]lndclient.ChannelInfo, error)
+
+	// ClosedChannels returns all of our closed channels.
+	ClosedChannels func() ([]lndclient.ClosedChannel, error)
+
+	// ForwardingHistory returns all forwards in [StartTime, EndTime).
+	ForwardingHistory func() ([]lndclient.ForwardingEvent, error)
+
+	// ListAliases returns the alias short channel ids that lnd has recorded
+	// for our channels. This function is optional.
+	ListAliases func() ([]*lnrpc.AliasMap, error)
+
+	// StartTime is the beginning of the period the report covers.
+	StartTime time.Time
+
+	// EndTime is the end of the period the report covers.
+	EndTime time.Time
+
+	// BucketSize is the duration of each time bucket in the report. If
+	// this value is zero, the report contains a single bucket covering the
+	// full period.
+	BucketSize time.Duration
+
+	// DormantTurnover is the turnover below which we classify a channel as
+	// dormant.
+	DormantTurnover float64
+
+	// DirectionThreshold is the direction above which we classify a
+	// channel as a source, and below the negative of which we classify a
+	// channel as a sink.
+	DirectionThreshold float64
+}
+
+// PairFlow describes the liquidity that flowed from an incoming channel to an
+// outgoing channel.
+type PairFlow struct {
+	// IncomingChannel is the channel that forwards arrived on.
+	IncomingChannel string
+
+	// OutgoingChannel is the channel that forwards left our node on.
+	OutgoingChannel string
+
+	// Amount is the amount that was forwarded out over the outgoing
+	// channel.
+	Amount lnwire.MilliSatoshi
+
+	// Fees is the total fees earned by the flow.
+	Fees lnwire.MilliSatoshi
+
+	// Normalized is the flow's amount as a fraction of the smaller of the
+	// two channels' capacities, because the smaller channel limits the
+	// amount that can flow between the pair.
+	Normalized float64
+}
+
+// Bucket contains the pairwise flows for a period of time.
+type Bucket struct {
+	// Start is the beginning of the bucket's period, inclusive.
+	Start time.Time
+
+	// End is the end of the bucket's period, exclusive.
+	End time.Time
+
+	// Flows contains the pairwise flows in the bucket, sorted by amount.
+	Flows []*PairFlow
+}
+
+// ChannelFlow summarizes the flows in a single open channel over the full
+// period of the report.
+type ChannelFlow struct {
+	// ChannelPoint is the outpoint of the channel.
+	ChannelPoint string
+
+	// Capacity is the capacity of the channel.
+	Capacity btcutil.Amount
+
+	// AmountIncoming is the amount that arrived on the channel.
+	AmountIncoming lnwire.MilliSatoshi
+
+	// AmountOutgoing is the amount that left our node on the channel.
+	AmountOutgoing lnwire.MilliSatoshi
+
+	// Turnover is the total volume of the channel as a multiple of its
+	// capacity.
+	Turnover float64
+
+	// Direction is the imbalance between the channel's incoming and
+	// outgoing volume, in [-1, 1]. Channels with only incoming volume have
+	// a direction of 1, and channels with only outgoing volume have a
+	// direction of -1.
+	Direction float64
+
+	// Role is the classification of the channel.
+	Role Role
+}
+
+// Report contains a pairwise flow matrix for each time bucket and a summary
+// of the flows in each of our open channels.
+type Report struct {
+	// Buckets contains pairwise flows for each time bucket.
+	Buckets []*Bucket
+
+	// Channels contains a summary for each of our open channels, sorted
+	// by turnover.
+	Channels []*ChannelFlow
+}
+
+// GetReport produces a flow report for the period specified.
+func GetReport(cfg *Config) (*Report, error) {
+	if !cfg.StartTime.Before(cfg.EndTime) {
+		return nil, ErrInvalidRange
+	}
+
+	if cfg.DirectionThreshold <= 0 || cfg.DirectionThreshold > 1 {
+		return nil, ErrInvalidThreshold
+	}
+
+	buckets, err := getBuckets(cfg.StartTime, cfg.EndTime, cfg.BucketSize)
+	if err != nil {
+		return nil, err
+	}
+
+	channels, err := cfg.ListChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	closedChannels, err := cfg.ClosedChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	capacities := make(map[string]btcutil.Amount)
+	for _, channel := range channels {
+		capacities[channel.ChannelPoint] = channel.Capacity
+	}
+
+	for _, channel := range closedChannels {
+		capacities[channel.ChannelPoint] = channel.Capacity
+	}
+
+	var aliases []*lnrpc.AliasMap
+	if cfg.ListAliases != nil {
+		aliases, err = cfg.ListAliases()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	forwards, err := cfg.ForwardingHistory()
+	if err != nil {
+		return nil, err
+	}
+
+	// Split our forwards into their time buckets. Our buckets cover
+	// consecutive periods, so we can find each forward's bucket from its
+	// offset from our start time.
+	bucketForwards := make([][]lndclient.ForwardingEvent, len(buckets))
+	for _, fwd := range forwards {
+		i := bucketIndex(cfg.StartTime, cfg.BucketSize, fwd.Timestamp)
+		if i < 0 || i >= len(buckets) {
+			continue
+		}
+
+		bucketForwards[i] = append(bucketForwards[i], fwd)
+	}
+
+	// We use the same channels for each of our revenue reports, so we
+	// only need to look them up once.
+	listChannels := func() ([]lndclient.ChannelInfo, error) {
+		return channels, nil
+	}
+	listClosed := func() ([]lndclient.ClosedChannel, error) {
+		return closedChannels, nil
+	}
+	listAliases := func() ([]*lnrpc.AliasMap, error) {
+		return aliases, nil
+	}
+
+	totals := make(map[string]*ChannelFlow)
+	for _, channel := range channels {
+		totals[channel.ChannelPoint] = &ChannelFlow{
+			ChannelPoint: channel.ChannelPoint,
+			Capacity:     channel.Capacity,
+		}
+	}
+
+	for i, bucket := range buckets {
+		fwds := bucketForwards[i]
+
+		revenueReport, err := revenue.GetRevenueReport(&revenue.Config{
+			ListChannels:   listChannels,
+			ClosedChannels: listClosed,
+			ListAliases:    listAliases,
+			ForwardingHistory: func() ([]lndclient.ForwardingEvent,
+				error) {
+
+				return fwds, nil
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		bucket.Flows = getPairFlows(revenueReport, capacities)
+
+		for _, flow := range bucket.Flows {
+			if total, ok := totals[flow.IncomingChannel]; ok {
+				total.AmountIncoming += flow.Amount + flow.Fees
+			}
+
+			if total, ok := totals[flow.OutgoingChannel]; ok {
+				total.AmountOutgoing += flow.Amount
+			}
+		}
+	}
+
+	report := &Report{
+		Buckets: buckets,
+	}
+
+	for _, total := range totals {
+		classify(total, cfg.DormantTurnover, cfg.DirectionThreshold)
+		report.Channels = append(report.Channels, total)
+	}
+
+	sort.SliceStable(report.Channels, func(i, j int) bool {
+		if report.Channels[i].Turnover != report.Channels[j].Turnover {
+			return report.Channels[i].Turnover >
+				report.Channels[j].Turnover
+		}
+
+		return report.Channels[i].ChannelPoint <
+			report.Channels[j].ChannelPoint
+	})
+
+	return report, nil
+}
+
+// getBuckets splits the period [start, end) into buckets of the size
+// provided. The last bucket is truncated at our end time. If the bucket size
+// is zero, a single bucket is returned.
+func getBuckets(start, end time.Time, size time.Duration) ([]*Bucket,
+	error) {
+
+	if size == 0 {
+		return []*Bucket{{
+			Start: start,
+			End:   end,
+		}}, nil
+	}
+
+	period := end.Sub(start)
+	count := period / size
+	if period%size != 0 {
+		count++
+	}
+
+	if count > maxBuckets {
+		return nil, ErrTooManyBuckets
+	}
+
+	buckets := make([]*Bucket, 0, count)
+	for bucketStart := start; bucketStart.Before(end); {
+		bucketEnd := bucketStart.Add(size)
+		if bucketEnd.After(end) {
+			bucketEnd = end
+		}
+
+		buckets = append(buckets, &Bucket{
+			Start: bucketStart,
+			End:   bucketEnd,
+		})
+
+		bucketStart = bucketEnd
+	}
+
+	return buckets, nil
+}
+
+// bucketIndex returns the index of the bucket that a timestamp falls in.
+func bucketIndex(start time.Time, size time.Duration,
+	timestamp time.Time) int {
+
+	offset := timestamp.Sub(start)
+	if offset < 0 {
+		return -1
+	}
+
+	if size == 0 {
+		return 0
+	}
+
+	return int(offset / size)
+}
+
+// getPairFlows produces a set of pairwise flows from a revenue report.
+func getPairFlows(report *revenue.Report,
+	capacities map[string]btcutil.Amount) []*PairFlow {
+
+	var flows []*PairFlow
+
+	// Our revenue report records each forward for both its incoming and
+	// outgoing channel. We only look at the incoming channel's records so
+	// that we add each flow once.
+	for incoming, pairs := range report.ChannelPairs {
+		for outgoing, rev := range pairs {
+			if rev.AmountIncoming == 0 {
+				continue
+			}
+
+			// We calculate fees from the amounts that arrived and
+			// left our node so that they do not depend on how
+			// fees were attributed to each channel.
+			amount := report.ChannelPairs[outgoing][incoming].
+				AmountOutgoing
+
+			flow := &PairFlow{
+				IncomingChannel: incoming,
+				OutgoingChannel: outgoing,
+				Amount:          amount,
+				Fees:            rev.AmountIncoming - amount,
+			}
+
+			capacity := capacities[incoming]
+			if outCap := capacities[outgoing]; outCap < capacity {
+				capacity = outCap
+			}
+
+			if capacity > 0 {
+				flow.Normalized = float64(amount) /
+					float64(lnwire.NewMSatFromSatoshis(
+						capacity,
+					))
+			}
+
+			flows = append(flows, flow)
+		}
+	}
+
+	sort.SliceStable(flows, func(i, j int) bool {
+		if flows[i].Amount != flows[j].Amount {
+			return flows[i].Amount > flows[j].Amount
+		}
+
+		if flows[i].IncomingChannel != flows[j].IncomingChannel {
+			return flows[i].IncomingChannel <
+				flows[j].IncomingChannel
+		}
+
+		return flows[i].OutgoingChannel < flows[j].OutgoingChannel
+	})
+
+	return flows
+}
+
+// classify calculates a channel's turnover and direction, and classifies its
+// role based on the thresholds provided.
+func classify(channel *ChannelFlow, dormantTurnover,
+	directionThreshold float64) {
+
+	volume := channel.AmountIncoming + channel.AmountOutgoing
+
+	if channel.Capacity > 0 {
+		channel.Turnover = float64(volume) / float64(
+			lnwire.NewMSatFromSatoshis(channel.Capacity),
+		)
+	}
+
+	if volume > 0 {
+		channel.Direction = (float64(channel.AmountIncoming) -
+			float64(channel.AmountOutgoing)) / float64(volume)
+	}
+
+	switch {
+	case volume == 0 || channel.Turnover < dormantTurnover:
+		channel.Role = RoleDormant
+
+	case channel.Direction >= directionThreshold:
+		channel.Role = RoleSource
+
+	case channel.Direction <= -directionThreshold:
+		channel.Role = RoleSink
+
+	default:
+		channel.Role = RoleRouter
+	}
+}
@@ -0,0 +1,273 @@
+package flows
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetBuckets tests splitting of a period into time buckets.
+func TestGetBuckets(t *testing.T) {
+	start := time.Unix(1000, 0)
+
+	tests := []struct {
+		name        string
+		end         time.Time
+		size        time.Duration
+		expected    []*Bucket
+		expectedErr error
+	}{
+		{
+			name: "no bucket size",
+			end:  start.Add(time.Hour),
+			expected: []*Bucket{
+				{Start: start, End: start.Add(time.Hour)},
+			},
+		},
+		{
+			name: "even buckets",
+			end:  start.Add(time.Hour * 2),
+			size: time.Hour,
+			expected: []*Bucket{
+				{Start: start, End: start.Add(time.Hour)},
+				{
+					Start: start.Add(time.Hour),
+					End:   start.Add(time.Hour * 2),
+				},
+			},
+		},
+		{
+			name: "last bucket truncated",
+			end:  start.Add(time.Minute * 90),
+			size: time.Hour,
+			expected: []*Bucket{
+				{Start: start, End: start.Add(time.Hour)},
+				{
+					Start: start.Add(time.Hour),
+					End:   start.Add(time.Minute * 90),
+				},
+			},
+		},
+		{
+			name:        "too many buckets",
+			end:         start.Add(time.Hour),
+			size:        time.Millisecond,
+			expectedErr: ErrTooManyBuckets,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			buckets, err := getBuckets(start, test.end, test.size)
+			require.Equal(t, test.expectedErr, err)
+			require.Equal(t, test.expected, buckets)
+		})
+	}
+}
+
+// TestClassify tests classification of channel roles.
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		name          string
+		incoming      lnwire.MilliSatoshi
+		outgoing      lnwire.MilliSatoshi
+		expectedRole  Role
+		expectedDir   float64
+		expectedTurns float64
+	}{
+		{
+			name:         "no volume",
+			expectedRole: RoleDormant,
+		},
+		{
+			name:          "low turnover",
+			incoming:      5000,
+			expectedRole:  RoleDormant,
+			expectedDir:   1,
+			expectedTurns: 0.005,
+		},
+		{
+			name:          "source",
+			incoming:      400_000,
+			outgoing:      100_000,
+			expectedRole:  RoleSource,
+			expectedDir:   0.6,
+			expectedTurns: 0.5,
+		},
+		{
+			name:          "sink",
+			outgoing:      200_000,
+			expectedRole:  RoleSink,
+			expectedDir:   -1,
+			expectedTurns: 0.2,
+		},
+		{
+			name:          "router",
+			incoming:      300_000,
+			outgoing:      200_000,
+			expectedRole:  RoleRouter,
+			expectedDir:   0.2,
+			expectedTurns: 0.5,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Use a 1000 sat channel so that it has a capacity of
+			// 1 million msat.
+			channel := &ChannelFlow{
+				Capacity:       1000,
+				AmountIncoming: test.incoming,
+				AmountOutgoing: test.outgoing,
+			}
+
+			classify(
+				channel, DefaultDormantTurnover,
+				DefaultDirectionThreshold,
+			)
+
+			require.Equal(t, test.expectedRole, channel.Role)
+			require.InDelta(t, test.expectedDir, channel.Direction,
+				1e-9)
+			require.InDelta(t, test.expectedTurns,
+				channel.Turnover, 1e-9)
+		})
+	}
+}
+
+// TestGetReport tests producing a bucketed flow report from a set of
+// forwards.
+func TestGetReport(t *testing.T) {
+	start := time.Unix(1000, 0)
+
+	cfg := &Config{
+		ListChannels: func() ([]lndclient.ChannelInfo, error) {
+			return []lndclient.ChannelInfo{
+				{
+					ChannelPoint: "a:1",
+					ChannelID:    1,
+					Capacity:     1000,
+				},
+				{
+					ChannelPoint: "b:1",
+					ChannelID:    2,
+					Capacity:     2000,
+				},
+				{
+					ChannelPoint: "c:1",
+					ChannelID:    3,
+					Capacity:     1000,
+				},
+			}, nil
+		},
+		ClosedChannels: func() ([]lndclient.ClosedChannel, error) {
+			return []lndclient.ClosedChannel{
+				{
+					ChannelPoint: "d:1",
+					ChannelID:    4,
+					Capacity:     500,
+				},
+			}, nil
+		},
+		ForwardingHistory: func() ([]lndclient.ForwardingEvent,
+			error) {
+
+			return []lndclient.ForwardingEvent{
+				{
+					Timestamp:     start,
+					ChannelIn:     1,
+					ChannelOut:    2,
+					AmountMsatIn:  100_100,
+					AmountMsatOut: 100_000,
+				},
+				{
+					Timestamp:     start.Add(time.Minute),
+					ChannelIn:     1,
+					ChannelOut:    2,
+					AmountMsatIn:  200_200,
+					AmountMsatOut: 200_000,
+				},
+				{
+					Timestamp:     start.Add(time.Hour),
+					ChannelIn:     4,
+					ChannelOut:    1,
+					AmountMsatIn:  50_050,
+					AmountMsatOut: 50_000,
+				},
+			}, nil
+		},
+		StartTime:          start,
+		EndTime:            start.Add(time.Hour * 2),
+		BucketSize:         time.Hour,
+		DormantTurnover:    DefaultDormantTurnover,
+		DirectionThreshold: DefaultDirectionThreshold,
+	}
+
+	report, err := GetReport(cfg)
+	require.NoError(t, err)
+
+	require.Equal(t, []*Bucket{
+		{
+			Start: start,
+			End:   start.Add(time.Hour),
+			Flows: []*PairFlow{
+				{
+					IncomingChannel: "a:1",
+					OutgoingChannel: "b:1",
+					Amount:          300_000,
+					Fees:            300,
+					Normalized:      0.3,
+				},
+			},
+		},
+		{
+			Start: start.Add(time.Hour),
+			End:   start.Add(time.Hour * 2),
+			Flows: []*PairFlow{
+				{
+					IncomingChannel: "d:1",
+					OutgoingChannel: "a:1",
+					Amount:          50_000,
+					Fees:            50,
+					Normalized:      0.1,
+				},
+			},
+		},
+	}, report.Buckets)
+
+	// Channel a has forwarded 300_300 msat in and 50_000 out, channel b
+	// has 300_000 out and channel c has no volume. Our closed channel
+	// should not be included.
+	require.Len(t, report.Channels, 3)
+
+	require.Equal(t, "a:1", report.Channels[0].ChannelPoint)
+	require.Equal(t, RoleSource, report.Channels[0].Role)
+
+	require.Equal(t, "b:1", report.Channels[1].ChannelPoint)
+	require.Equal(t, RoleSink, report.Channels[1].Role)
+	require.InDelta(t, 0.15, report.Channels[1].Turnover, 1e-9)
+
+	require.Equal(t, "c:1", report.Channels[2].ChannelPoint)
+	require.Equal(t, RoleDormant, report.Channels[2].Role)
+
+	// Check that we fail for invalid ranges and thresholds.
+	cfg.EndTime = start
+	_, err = GetReport(cfg)
+	require.Equal(t, ErrInvalidRange, err)
+
+	cfg.EndTime = start.Add(time.Hour)
+	cfg.DirectionThreshold = 0
+	_, err = GetReport(cfg)
+	require.Equal(t, ErrInvalidThreshold, err)
+}
@@ -20,6 +20,75 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// FeeAttribution describes how the fee earned by a forward is attributed to its
+// incoming and outgoing channels.
+type FeeAttribution int32
+
+const (
+	// Attribute the full fee to both the incoming and outgoing channels. This
+	// is the default, and matches the fees reported before fee attribution was
+	// configurable. Fees are double counted if they are summed across channels.
+	FeeAttribution_FULL_FEE FeeAttribution = 0
+	// Split fees evenly between the incoming and outgoing channels.
+	FeeAttribution_SPLIT FeeAttribution = 1
+	// Attribute all fees to the outgoing channel, since it is the outgoing
+	// channel's liquidity that the fee pays for.
+	FeeAttribution_ALL_OUTGOING FeeAttribution = 2
+	// Attribute all fees to the incoming channel.
+	FeeAttribution_ALL_INCOMING FeeAttribution = 3
+	// Split fees between the incoming and outgoing channels in proportion to
+	// their capacities. This is an approximation of the liquidity that each
+	// channel provided: it uses the total capacity of each channel, not the
+	// local and remote balances that the channels had at the time of each
+	// forward. Fees are split evenly if either channel's capacity is unknown.
+	FeeAttribution_CAPACITY_WEIGHTED FeeAttribution = 4
+)
+
+// Enum value maps for FeeAttribution.
+var (
+	FeeAttribution_name = map[int32]string{
+		0: "FULL_FEE",
+		1: "SPLIT",
+		2: "ALL_OUTGOING",
+		3: "ALL_INCOMING",
+		4: "CAPACITY_WEIGHTED",
+	}
+	FeeAttribution_value = map[string]int32{
+		"FULL_FEE":          0,
+		"SPLIT":             1,
+		"ALL_OUTGOING":      2,
+		"ALL_INCOMING":      3,
+		"CAPACITY_WEIGHTED": 4,
+	}
+)
+
+func (x FeeAttribution) Enum() *FeeAttribution {
+	p := new(FeeAttribution)
+	*p = x
+	return p
+}
+
+func (x FeeAttribution) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeeAttribution) Descriptor() protoreflect.EnumDescriptor {
+	return file_faraday_proto_enumTypes[0].Descriptor()
+}
+
+func (FeeAttribution) Type() protoreflect.EnumType {
+	return &file_faraday_proto_enumTypes[0]
+}
+
+func (x FeeAttribution) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FeeAttribution.Descriptor instead.
+func (FeeAttribution) EnumDescriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{0}
+}
+
 // Granularity describes the aggregation level at which the Bitcoin price should
 // be queried. Note that setting lower levels of granularity may require more
 // queries to the fiat backend.
@@ -74,11 +143,11 @@ func (x Granularity) String() string {
 }
 
 func (Granularity) Descriptor() protoreflect.EnumDescriptor {
-	return file_faraday_proto_enumTypes[0].Descriptor()
+	return file_faraday_proto_enumTypes[1].Descriptor()
 }
 
 func (Granularity) Type() protoreflect.EnumType {
-	return &file_faraday_proto_enumTypes[0]
+	return &file_faraday_proto_enumTypes[1]
 }
 
 func (x Granularity) Number() protoreflect.EnumNumber {
@@ -87,7 +156,7 @@ func (x Granularity) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Granularity.Descriptor instead.
 func (Granularity) EnumDescriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{0}
+	return file_faraday_proto_rawDescGZIP(), []int{1}
 }
 
 // FiatBackend is the API endpoint to be used for any fiat related queries.
@@ -146,11 +215,11 @@ func (x FiatBackend) String() string {
 }
 
 func (FiatBackend) Descriptor() protoreflect.EnumDescriptor {
-	return file_faraday_proto_enumTypes[1].Descriptor()
+	return file_faraday_proto_enumTypes[2].Descriptor()
 }
 
 func (FiatBackend) Type() protoreflect.EnumType {
-	return &file_faraday_proto_enumTypes[1]
+	return &file_faraday_proto_enumTypes[2]
 }
 
 func (x FiatBackend) Number() protoreflect.EnumNumber {
@@ -159,7 +228,7 @@ func (x FiatBackend) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use FiatBackend.Descriptor instead.
 func (FiatBackend) EnumDescriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{1}
+	return file_faraday_proto_rawDescGZIP(), []int{2}
 }
 
 type EntryType int32
@@ -251,11 +320,11 @@ func (x EntryType) String() string {
 }
 
 func (EntryType) Descriptor() protoreflect.EnumDescriptor {
-	return file_faraday_proto_enumTypes[2].Descriptor()
+	return file_faraday_proto_enumTypes[3].Descriptor()
 }
 
 func (EntryType) Type() protoreflect.EnumType {
-	return &file_faraday_proto_enumTypes[2]
+	return &file_faraday_proto_enumTypes[3]
 }
 
 func (x EntryType) Number() protoreflect.EnumNumber {
@@ -264,7 +333,116 @@ func (x EntryType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EntryType.Descriptor instead.
 func (EntryType) EnumDescriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{2}
+	return file_faraday_proto_rawDescGZIP(), []int{3}
+}
+
+type DriftType int32
+
+const (
+	// A live entry has no corresponding frozen entry.
+	DriftType_ADDED DriftType = 0
+	// A frozen entry has no corresponding live entry.
+	DriftType_REMOVED DriftType = 1
+	// A live entry's amount, note or fiat value differs from its frozen entry.
+	// Fiat values are only compared when fiat values are requested.
+	DriftType_CHANGED DriftType = 2
+)
+
+// Enum value maps for DriftType.
+var (
+	DriftType_name = map[int32]string{
+		0: "ADDED",
+		1: "REMOVED",
+		2: "CHANGED",
+	}
+	DriftType_value = map[string]int32{
+		"ADDED":   0,
+		"REMOVED": 1,
+		"CHANGED": 2,
+	}
+)
+
+func (x DriftType) Enum() *DriftType {
+	p := new(DriftType)
+	*p = x
+	return p
+}
+
+func (x DriftType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DriftType) Descriptor() protoreflect.EnumDescriptor {
+	return file_faraday_proto_enumTypes[4].Descriptor()
+}
+
+func (DriftType) Type() protoreflect.EnumType {
+	return &file_faraday_proto_enumTypes[4]
+}
+
+func (x DriftType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DriftType.Descriptor instead.
+func (DriftType) EnumDescriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{4}
+}
+
+type JobState int32
+
+const (
+	// The job is still running.
+	JobState_JOB_RUNNING JobState = 0
+	// The job completed successfully, and its result is available.
+	JobState_JOB_SUCCEEDED JobState = 1
+	// The job failed.
+	JobState_JOB_FAILED JobState = 2
+	// The job was cancelled before it completed.
+	JobState_JOB_CANCELLED JobState = 3
+)
+
+// Enum value maps for JobState.
+var (
+	JobState_name = map[int32]string{
+		0: "JOB_RUNNING",
+		1: "JOB_SUCCEEDED",
+		2: "JOB_FAILED",
+		3: "JOB_CANCELLED",
+	}
+	JobState_value = map[string]int32{
+		"JOB_RUNNING":   0,
+		"JOB_SUCCEEDED": 1,
+		"JOB_FAILED":    2,
+		"JOB_CANCELLED": 3,
+	}
+)
+
+func (x JobState) Enum() *JobState {
+	p := new(JobState)
+	*p = x
+	return p
+}
+
+func (x JobState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobState) Descriptor() protoreflect.EnumDescriptor {
+	return file_faraday_proto_enumTypes[5].Descriptor()
+}
+
+func (JobState) Type() protoreflect.EnumType {
+	return &file_faraday_proto_enumTypes[5]
+}
+
+func (x JobState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobState.Descriptor instead.
+func (JobState) EnumDescriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{5}
 }
 
 type CloseRecommendationRequest_Metric int32
@@ -309,11 +487,11 @@ func (x CloseRecommendationRequest_Metric) String() string {
 }
 
 func (CloseRecommendationRequest_Metric) Descriptor() protoreflect.EnumDescriptor {
-	return file_faraday_proto_enumTypes[3].Descriptor()
+	return file_faraday_proto_enumTypes[6].Descriptor()
 }
 
 func (CloseRecommendationRequest_Metric) Type() protoreflect.EnumType {
-	return &file_faraday_proto_enumTypes[3]
+	return &file_faraday_proto_enumTypes[6]
 }
 
 func (x CloseRecommendationRequest_Metric) Number() protoreflect.EnumNumber {
@@ -325,6 +503,119 @@ func (CloseRecommendationRequest_Metric) EnumDescriptor() ([]byte, []int) {
 	return file_faraday_proto_rawDescGZIP(), []int{0, 0}
 }
 
+type OutlierRecommendationsRequest_OutlierMethod int32
+
+const (
+	// Identify outliers using inter-quartile range fences around the lower
+	// and upper quartile.
+	OutlierRecommendationsRequest_IQR OutlierRecommendationsRequest_OutlierMethod = 0
+	// Identify outliers using their modified z-score, which is based on the
+	// median absolute deviation of the dataset.
+	OutlierRecommendationsRequest_MODIFIED_Z_SCORE OutlierRecommendationsRequest_OutlierMethod = 1
+	// Identify values beneath the lower percentile and above the upper
+	// percentile as outliers.
+	OutlierRecommendationsRequest_PERCENTILE OutlierRecommendationsRequest_OutlierMethod = 2
+	// Identify outliers using inter-quartile range fences calculated on the
+	// log of the dataset. This method is suited to heavy-tailed
+	// distributions.
+	OutlierRecommendationsRequest_LOG_IQR OutlierRecommendationsRequest_OutlierMethod = 3
+)
+
+// Enum value maps for OutlierRecommendationsRequest_OutlierMethod.
+var (
+	OutlierRecommendationsRequest_OutlierMethod_name = map[int32]string{
+		0: "IQR",
+		1: "MODIFIED_Z_SCORE",
+		2: "PERCENTILE",
+		3: "LOG_IQR",
+	}
+	OutlierRecommendationsRequest_OutlierMethod_value = map[string]int32{
+		"IQR":              0,
+		"MODIFIED_Z_SCORE": 1,
+		"PERCENTILE":       2,
+		"LOG_IQR":          3,
+	}
+)
+
+func (x OutlierRecommendationsRequest_OutlierMethod) Enum() *OutlierRecommendationsRequest_OutlierMethod {
+	p := new(OutlierRecommendationsRequest_OutlierMethod)
+	*p = x
+	return p
+}
+
+func (x OutlierRecommendationsRequest_OutlierMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OutlierRecommendationsRequest_OutlierMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_faraday_proto_enumTypes[7].Descriptor()
+}
+
+func (OutlierRecommendationsRequest_OutlierMethod) Type() protoreflect.EnumType {
+	return &file_faraday_proto_enumTypes[7]
+}
+
+func (x OutlierRecommendationsRequest_OutlierMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OutlierRecommendationsRequest_OutlierMethod.Descriptor instead.
+func (OutlierRecommendationsRequest_OutlierMethod) EnumDescriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type ChannelFlow_Role int32
+
+const (
+	ChannelFlow_DORMANT ChannelFlow_Role = 0
+	ChannelFlow_SOURCE  ChannelFlow_Role = 1
+	ChannelFlow_SINK    ChannelFlow_Role = 2
+	ChannelFlow_ROUTER  ChannelFlow_Role = 3
+)
+
+// Enum value maps for ChannelFlow_Role.
+var (
+	ChannelFlow_Role_name = map[int32]string{
+		0: "DORMANT",
+		1: "SOURCE",
+		2: "SINK",
+		3: "ROUTER",
+	}
+	ChannelFlow_Role_value = map[string]int32{
+		"DORMANT": 0,
+		"SOURCE":  1,
+		"SINK":    2,
+		"ROUTER":  3,
+	}
+)
+
+func (x ChannelFlow_Role) Enum() *ChannelFlow_Role {
+	p := new(ChannelFlow_Role)
+	*p = x
+	return p
+}
+
+func (x ChannelFlow_Role) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChannelFlow_Role) Descriptor() protoreflect.EnumDescriptor {
+	return file_faraday_proto_enumTypes[8].Descriptor()
+}
+
+func (ChannelFlow_Role) Type() protoreflect.EnumType {
+	return &file_faraday_proto_enumTypes[8]
+}
+
+func (x ChannelFlow_Role) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChannelFlow_Role.Descriptor instead.
+func (ChannelFlow_Role) EnumDescriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{36, 0}
+}
+
 type CloseRecommendationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -340,6 +631,10 @@ type CloseRecommendationRequest struct {
 	// Revenue: the revenue that the channel has produced per block that its
 	// funding transaction has been confirmed for.
 	Metric CloseRecommendationRequest_Metric `protobuf:"varint,2,opt,name=metric,proto3,enum=frdrpc.CloseRecommendationRequest_Metric" json:"metric,omitempty"`
+	// The model used to attribute each forward's fee to its incoming and
+	// outgoing channels for the revenue metric. If this value is not set, fees
+	// are split evenly.
+	FeeAttribution FeeAttribution `protobuf:"varint,3,opt,name=fee_attribution,json=feeAttribution,proto3,enum=frdrpc.FeeAttribution" json:"fee_attribution,omitempty"`
 }
 
 func (x *CloseRecommendationRequest) Reset() {
@@ -388,6 +683,13 @@ func (x *CloseRecommendationRequest) GetMetric() CloseRecommendationRequest_Metr
 	return CloseRecommendationRequest_UNKNOWN
 }
 
+func (x *CloseRecommendationRequest) GetFeeAttribution() FeeAttribution {
+	if x != nil {
+		return x.FeeAttribution
+	}
+	return FeeAttribution_FULL_FEE
+}
+
 type OutlierRecommendationsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -400,7 +702,24 @@ type OutlierRecommendationsRequest struct {
 	// Lower values will be more aggressive in recommending channel closes, and
 	// upper values will be more conservative. Recommended values are 1.5 for
 	// aggressive recommendations and 3 for conservative recommendations.
+	// This value is also used as the number of inter-quartile ranges for the
+	// LOG_IQR method.
 	OutlierMultiplier float32 `protobuf:"fixed32,2,opt,name=outlier_multiplier,json=outlierMultiplier,proto3" json:"outlier_multiplier,omitempty"`
+	// The method used to identify outliers. If this value is not set, outliers
+	// will be identified using inter-quartile ranges.
+	Method OutlierRecommendationsRequest_OutlierMethod `protobuf:"varint,3,opt,name=method,proto3,enum=frdrpc.OutlierRecommendationsRequest_OutlierMethod" json:"method,omitempty"`
+	// The absolute modified z-score above which a value is considered to be an
+	// outlier when the MODIFIED_Z_SCORE method is used. If this value is not set,
+	// the recommended value of 3.5 will be used.
+	ModifiedZScore float32 `protobuf:"fixed32,4,opt,name=modified_z_score,json=modifiedZScore,proto3" json:"modified_z_score,omitempty"`
+	// The percentile, expressed in [0;100], beneath which values are considered
+	// to be lower outliers when the PERCENTILE method is used. If this value and
+	// upper_percentile are not set, 5 will be used.
+	LowerPercentile float32 `protobuf:"fixed32,5,opt,name=lower_percentile,json=lowerPercentile,proto3" json:"lower_percentile,omitempty"`
+	// The percentile, expressed in [0;100], above which values are considered to
+	// be upper outliers when the PERCENTILE method is used. If this value is not
+	// set, 95 will be used.
+	UpperPercentile float32 `protobuf:"fixed32,6,opt,name=upper_percentile,json=upperPercentile,proto3" json:"upper_percentile,omitempty"`
 }
 
 func (x *OutlierRecommendationsRequest) Reset() {
@@ -449,6 +768,34 @@ func (x *OutlierRecommendationsRequest) GetOutlierMultiplier() float32 {
 	return 0
 }
 
+func (x *OutlierRecommendationsRequest) GetMethod() OutlierRecommendationsRequest_OutlierMethod {
+	if x != nil {
+		return x.Method
+	}
+	return OutlierRecommendationsRequest_IQR
+}
+
+func (x *OutlierRecommendationsRequest) GetModifiedZScore() float32 {
+	if x != nil {
+		return x.ModifiedZScore
+	}
+	return 0
+}
+
+func (x *OutlierRecommendationsRequest) GetLowerPercentile() float32 {
+	if x != nil {
+		return x.LowerPercentile
+	}
+	return 0
+}
+
+func (x *OutlierRecommendationsRequest) GetUpperPercentile() float32 {
+	if x != nil {
+		return x.UpperPercentile
+	}
+	return 0
+}
+
 type ThresholdRecommendationsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -543,6 +890,10 @@ type CloseRecommendationsResponse struct {
 	// the criteria for close recommendations (it is private, or has not been
 	// monitored for long enough).
 	Recommendations []*Recommendation `protobuf:"bytes,3,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+	// The bounds that were used to identify outliers. This field is only set
+	// for outlier recommendations when there were enough channels to calculate
+	// outliers.
+	OutlierBounds *OutlierBounds `protobuf:"bytes,4,opt,name=outlier_bounds,json=outlierBounds,proto3" json:"outlier_bounds,omitempty"`
 }
 
 func (x *CloseRecommendationsResponse) Reset() {
@@ -598,6 +949,72 @@ func (x *CloseRecommendationsResponse) GetRecommendations() []*Recommendation {
 	return nil
 }
 
+func (x *CloseRecommendationsResponse) GetOutlierBounds() *OutlierBounds {
+	if x != nil {
+		return x.OutlierBounds
+	}
+	return nil
+}
+
+type OutlierBounds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The value of the metric beneath which channels are considered to be lower
+	// outliers.
+	Lower float32 `protobuf:"fixed32,1,opt,name=lower,proto3" json:"lower,omitempty"`
+	// The value of the metric above which channels are considered to be upper
+	// outliers.
+	Upper float32 `protobuf:"fixed32,2,opt,name=upper,proto3" json:"upper,omitempty"`
+}
+
+func (x *OutlierBounds) Reset() {
+	*x = OutlierBounds{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutlierBounds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutlierBounds) ProtoMessage() {}
+
+func (x *OutlierBounds) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutlierBounds.ProtoReflect.Descriptor instead.
+func (*OutlierBounds) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OutlierBounds) GetLower() float32 {
+	if x != nil {
+		return x.Lower
+	}
+	return 0
+}
+
+func (x *OutlierBounds) GetUpper() float32 {
+	if x != nil {
+		return x.Upper
+	}
+	return 0
+}
+
 type Recommendation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -615,7 +1032,7 @@ type Recommendation struct {
 func (x *Recommendation) Reset() {
 	*x = Recommendation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faraday_proto_msgTypes[4]
+		mi := &file_faraday_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -628,7 +1045,7 @@ func (x *Recommendation) String() string {
 func (*Recommendation) ProtoMessage() {}
 
 func (x *Recommendation) ProtoReflect() protoreflect.Message {
-	mi := &file_faraday_proto_msgTypes[4]
+	mi := &file_faraday_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -641,7 +1058,7 @@ func (x *Recommendation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Recommendation.ProtoReflect.Descriptor instead.
 func (*Recommendation) Descriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{4}
+	return file_faraday_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Recommendation) GetChanPoint() string {
@@ -681,12 +1098,15 @@ type RevenueReportRequest struct {
 	// End time is end of the range over which the report will be
 	// generated, expressed as unix epoch offset in seconds.
 	EndTime uint64 `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// The model used to attribute each forward's fee to its incoming and
+	// outgoing channels. If this value is not set, fees are split evenly.
+	FeeAttribution FeeAttribution `protobuf:"varint,4,opt,name=fee_attribution,json=feeAttribution,proto3,enum=frdrpc.FeeAttribution" json:"fee_attribution,omitempty"`
 }
 
 func (x *RevenueReportRequest) Reset() {
 	*x = RevenueReportRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faraday_proto_msgTypes[5]
+		mi := &file_faraday_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -699,7 +1119,7 @@ func (x *RevenueReportRequest) String() string {
 func (*RevenueReportRequest) ProtoMessage() {}
 
 func (x *RevenueReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_faraday_proto_msgTypes[5]
+	mi := &file_faraday_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -712,7 +1132,7 @@ func (x *RevenueReportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevenueReportRequest.ProtoReflect.Descriptor instead.
 func (*RevenueReportRequest) Descriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{5}
+	return file_faraday_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *RevenueReportRequest) GetChanPoints() []string {
@@ -736,6 +1156,13 @@ func (x *RevenueReportRequest) GetEndTime() uint64 {
 	return 0
 }
 
+func (x *RevenueReportRequest) GetFeeAttribution() FeeAttribution {
+	if x != nil {
+		return x.FeeAttribution
+	}
+	return FeeAttribution_FULL_FEE
+}
+
 type RevenueReportResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -744,12 +1171,16 @@ type RevenueReportResponse struct {
 	// Reports is a set of pairwise revenue report generated for the channel(s)
 	// over the period specified.
 	Reports []*RevenueReport `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	// Unattributed forwards are forwards in the period specified that could not
+	// be included in the reports because the channel point of their incoming or
+	// outgoing channel could not be found.
+	UnattributedForwards []*UnattributedForward `protobuf:"bytes,2,rep,name=unattributed_forwards,json=unattributedForwards,proto3" json:"unattributed_forwards,omitempty"`
 }
 
 func (x *RevenueReportResponse) Reset() {
 	*x = RevenueReportResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faraday_proto_msgTypes[6]
+		mi := &file_faraday_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -762,7 +1193,7 @@ func (x *RevenueReportResponse) String() string {
 func (*RevenueReportResponse) ProtoMessage() {}
 
 func (x *RevenueReportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_faraday_proto_msgTypes[6]
+	mi := &file_faraday_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -775,7 +1206,7 @@ func (x *RevenueReportResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevenueReportResponse.ProtoReflect.Descriptor instead.
 func (*RevenueReportResponse) Descriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{6}
+	return file_faraday_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *RevenueReportResponse) GetReports() []*RevenueReport {
@@ -785,25 +1216,161 @@ func (x *RevenueReportResponse) GetReports() []*RevenueReport {
 	return nil
 }
 
-type RevenueReport struct {
+func (x *RevenueReportResponse) GetUnattributedForwards() []*UnattributedForward {
+	if x != nil {
+		return x.UnattributedForwards
+	}
+	return nil
+}
+
+type UnattributedForward struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Target channel is the channel that the report is generated for; incoming
-	// fields in the report mean that this channel was the incoming channel,
-	// and the pair as the outgoing, outgoing fields mean that this channel was
-	// the outgoing channel and the peer was the incoming channel.
-	TargetChannel string `protobuf:"bytes,1,opt,name=target_channel,json=targetChannel,proto3" json:"target_channel,omitempty"`
-	// Pair reports maps the channel point of a peer that we generated revenue
-	// with to a report detailing the revenue.
-	PairReports map[string]*PairReport `protobuf:"bytes,2,rep,name=pair_reports,json=pairReports,proto3" json:"pair_reports,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-}
+	// The unix timestamp in seconds at which the forward was settled.
+	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The short channel id that the forward arrived on.
+	ChanIdIn uint64 `protobuf:"varint,2,opt,name=chan_id_in,json=chanIdIn,proto3" json:"chan_id_in,omitempty"`
+	// The short channel id that the forward left our node on.
+	ChanIdOut uint64 `protobuf:"varint,3,opt,name=chan_id_out,json=chanIdOut,proto3" json:"chan_id_out,omitempty"`
+	// The channel point of the incoming channel, if it could be found. If it
+	// could not be found, this field is empty.
+	IncomingChanPoint string `protobuf:"bytes,4,opt,name=incoming_chan_point,json=incomingChanPoint,proto3" json:"incoming_chan_point,omitempty"`
+	// The channel point of the outgoing channel, if it could be found. If it
+	// could not be found, this field is empty.
+	OutgoingChanPoint string `protobuf:"bytes,5,opt,name=outgoing_chan_point,json=outgoingChanPoint,proto3" json:"outgoing_chan_point,omitempty"`
+	// The amount in millisatoshis that arrived on the incoming channel.
+	AmountInMsat uint64 `protobuf:"varint,6,opt,name=amount_in_msat,json=amountInMsat,proto3" json:"amount_in_msat,omitempty"`
+	// The amount in millisatoshis that left on the outgoing channel.
+	AmountOutMsat uint64 `protobuf:"varint,7,opt,name=amount_out_msat,json=amountOutMsat,proto3" json:"amount_out_msat,omitempty"`
+	// The fees in millisatoshis that we earned for the forward.
+	FeeMsat uint64 `protobuf:"varint,8,opt,name=fee_msat,json=feeMsat,proto3" json:"fee_msat,omitempty"`
+}
+
+func (x *UnattributedForward) Reset() {
+	*x = UnattributedForward{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnattributedForward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnattributedForward) ProtoMessage() {}
+
+func (x *UnattributedForward) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnattributedForward.ProtoReflect.Descriptor instead.
+func (*UnattributedForward) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UnattributedForward) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *UnattributedForward) GetChanIdIn() uint64 {
+	if x != nil {
+		return x.ChanIdIn
+	}
+	return 0
+}
+
+func (x *UnattributedForward) GetChanIdOut() uint64 {
+	if x != nil {
+		return x.ChanIdOut
+	}
+	return 0
+}
+
+func (x *UnattributedForward) GetIncomingChanPoint() string {
+	if x != nil {
+		return x.IncomingChanPoint
+	}
+	return ""
+}
+
+func (x *UnattributedForward) GetOutgoingChanPoint() string {
+	if x != nil {
+		return x.OutgoingChanPoint
+	}
+	return ""
+}
+
+func (x *UnattributedForward) GetAmountInMsat() uint64 {
+	if x != nil {
+		return x.AmountInMsat
+	}
+	return 0
+}
+
+func (x *UnattributedForward) GetAmountOutMsat() uint64 {
+	if x != nil {
+		return x.AmountOutMsat
+	}
+	return 0
+}
+
+func (x *UnattributedForward) GetFeeMsat() uint64 {
+	if x != nil {
+		return x.FeeMsat
+	}
+	return 0
+}
+
+type RevenueReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Target channel is the channel that the report is generated for; incoming
+	// fields in the report mean that this channel was the incoming channel,
+	// and the pair as the outgoing, outgoing fields mean that this channel was
+	// the outgoing channel and the peer was the incoming channel.
+	TargetChannel string `protobuf:"bytes,1,opt,name=target_channel,json=targetChannel,proto3" json:"target_channel,omitempty"`
+	// Pair reports maps the channel point of a peer that we generated revenue
+	// with to a report detailing the revenue.
+	PairReports map[string]*PairReport `protobuf:"bytes,2,rep,name=pair_reports,json=pairReports,proto3" json:"pair_reports,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The total inbound fees in millisatoshis that we charged for forwards
+	// that arrived on the target channel. Inbound fees are calculated using
+	// the fee policies that faraday recorded for our channels at the time of
+	// each forward. Forwards that faraday did not record policies for are
+	// not included, and are counted in undecomposed_forwards instead.
+	InboundSurchargesMsat uint64 `protobuf:"varint,3,opt,name=inbound_surcharges_msat,json=inboundSurchargesMsat,proto3" json:"inbound_surcharges_msat,omitempty"`
+	// The total inbound discounts in millisatoshis that we gave to forwards
+	// that arrived on the target channel, which is the revenue that the
+	// channel's inbound discount cost us.
+	InboundDiscountsMsat uint64 `protobuf:"varint,4,opt,name=inbound_discounts_msat,json=inboundDiscountsMsat,proto3" json:"inbound_discounts_msat,omitempty"`
+	// The number of forwards that arrived on the target channel whose fees
+	// could not be decomposed into their outbound and inbound components,
+	// because faraday did not record the fee policies that were in effect at
+	// the time of the forward. Their inbound fees are not included in our
+	// inbound surcharges and discounts.
+	UndecomposedForwards uint64 `protobuf:"varint,5,opt,name=undecomposed_forwards,json=undecomposedForwards,proto3" json:"undecomposed_forwards,omitempty"`
+}
 
 func (x *RevenueReport) Reset() {
 	*x = RevenueReport{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faraday_proto_msgTypes[7]
+		mi := &file_faraday_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -816,7 +1383,7 @@ func (x *RevenueReport) String() string {
 func (*RevenueReport) ProtoMessage() {}
 
 func (x *RevenueReport) ProtoReflect() protoreflect.Message {
-	mi := &file_faraday_proto_msgTypes[7]
+	mi := &file_faraday_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -829,7 +1396,7 @@ func (x *RevenueReport) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevenueReport.ProtoReflect.Descriptor instead.
 func (*RevenueReport) Descriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{7}
+	return file_faraday_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *RevenueReport) GetTargetChannel() string {
@@ -846,6 +1413,27 @@ func (x *RevenueReport) GetPairReports() map[string]*PairReport {
 	return nil
 }
 
+func (x *RevenueReport) GetInboundSurchargesMsat() uint64 {
+	if x != nil {
+		return x.InboundSurchargesMsat
+	}
+	return 0
+}
+
+func (x *RevenueReport) GetInboundDiscountsMsat() uint64 {
+	if x != nil {
+		return x.InboundDiscountsMsat
+	}
+	return 0
+}
+
+func (x *RevenueReport) GetUndecomposedForwards() uint64 {
+	if x != nil {
+		return x.UndecomposedForwards
+	}
+	return 0
+}
+
 type PairReport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -865,12 +1453,25 @@ type PairReport struct {
 	// attribute to the channel for its role as the incoming channel in
 	// forwards.
 	FeesIncomingMsat int64 `protobuf:"varint,4,opt,name=fees_incoming_msat,json=feesIncomingMsat,proto3" json:"fees_incoming_msat,omitempty"`
+	// Inbound surcharges msat is the amount of inbound fees in millisatoshis
+	// that we charged for forwards that arrived on our channel, in addition to
+	// the pair channel's outbound fee.
+	InboundSurchargesMsat uint64 `protobuf:"varint,5,opt,name=inbound_surcharges_msat,json=inboundSurchargesMsat,proto3" json:"inbound_surcharges_msat,omitempty"`
+	// Inbound discounts msat is the amount in millisatoshis of inbound fee
+	// discounts that we gave to forwards that arrived on our channel, which
+	// reduced the pair channel's outbound fee.
+	InboundDiscountsMsat uint64 `protobuf:"varint,6,opt,name=inbound_discounts_msat,json=inboundDiscountsMsat,proto3" json:"inbound_discounts_msat,omitempty"`
+	// The number of forwards from the pair channel that arrived on our
+	// channel whose fees could not be decomposed into their outbound and
+	// inbound components, because faraday did not record the fee policies
+	// that were in effect at the time of the forward.
+	UndecomposedForwards uint64 `protobuf:"varint,7,opt,name=undecomposed_forwards,json=undecomposedForwards,proto3" json:"undecomposed_forwards,omitempty"`
 }
 
 func (x *PairReport) Reset() {
 	*x = PairReport{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faraday_proto_msgTypes[8]
+		mi := &file_faraday_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -883,7 +1484,7 @@ func (x *PairReport) String() string {
 func (*PairReport) ProtoMessage() {}
 
 func (x *PairReport) ProtoReflect() protoreflect.Message {
-	mi := &file_faraday_proto_msgTypes[8]
+	mi := &file_faraday_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -896,7 +1497,7 @@ func (x *PairReport) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PairReport.ProtoReflect.Descriptor instead.
 func (*PairReport) Descriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{8}
+	return file_faraday_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *PairReport) GetAmountOutgoingMsat() int64 {
@@ -927,16 +1528,41 @@ func (x *PairReport) GetFeesIncomingMsat() int64 {
 	return 0
 }
 
+func (x *PairReport) GetInboundSurchargesMsat() uint64 {
+	if x != nil {
+		return x.InboundSurchargesMsat
+	}
+	return 0
+}
+
+func (x *PairReport) GetInboundDiscountsMsat() uint64 {
+	if x != nil {
+		return x.InboundDiscountsMsat
+	}
+	return 0
+}
+
+func (x *PairReport) GetUndecomposedForwards() uint64 {
+	if x != nil {
+		return x.UndecomposedForwards
+	}
+	return 0
+}
+
 type ChannelInsightsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The model used to attribute each forward's fee to its incoming and
+	// outgoing channels. If this value is not set, fees are split evenly.
+	FeeAttribution FeeAttribution `protobuf:"varint,1,opt,name=fee_attribution,json=feeAttribution,proto3,enum=frdrpc.FeeAttribution" json:"fee_attribution,omitempty"`
 }
 
 func (x *ChannelInsightsRequest) Reset() {
 	*x = ChannelInsightsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faraday_proto_msgTypes[9]
+		mi := &file_faraday_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -949,7 +1575,7 @@ func (x *ChannelInsightsRequest) String() string {
 func (*ChannelInsightsRequest) ProtoMessage() {}
 
 func (x *ChannelInsightsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_faraday_proto_msgTypes[9]
+	mi := &file_faraday_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -962,7 +1588,14 @@ func (x *ChannelInsightsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChannelInsightsRequest.ProtoReflect.Descriptor instead.
 func (*ChannelInsightsRequest) Descriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{9}
+	return file_faraday_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ChannelInsightsRequest) GetFeeAttribution() FeeAttribution {
+	if x != nil {
+		return x.FeeAttribution
+	}
+	return FeeAttribution_FULL_FEE
 }
 
 type ChannelInsightsResponse struct {
@@ -977,7 +1610,7 @@ type ChannelInsightsResponse struct {
 func (x *ChannelInsightsResponse) Reset() {
 	*x = ChannelInsightsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faraday_proto_msgTypes[10]
+		mi := &file_faraday_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -990,7 +1623,7 @@ func (x *ChannelInsightsResponse) String() string {
 func (*ChannelInsightsResponse) ProtoMessage() {}
 
 func (x *ChannelInsightsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_faraday_proto_msgTypes[10]
+	mi := &file_faraday_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1003,7 +1636,7 @@ func (x *ChannelInsightsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChannelInsightsResponse.ProtoReflect.Descriptor instead.
 func (*ChannelInsightsResponse) Descriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{10}
+	return file_faraday_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ChannelInsightsResponse) GetChannelInsights() []*ChannelInsight {
@@ -1045,7 +1678,7 @@ type ChannelInsight struct {
 func (x *ChannelInsight) Reset() {
 	*x = ChannelInsight{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faraday_proto_msgTypes[11]
+		mi := &file_faraday_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1058,7 +1691,7 @@ func (x *ChannelInsight) String() string {
 func (*ChannelInsight) ProtoMessage() {}
 
 func (x *ChannelInsight) ProtoReflect() protoreflect.Message {
-	mi := &file_faraday_proto_msgTypes[11]
+	mi := &file_faraday_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1071,7 +1704,7 @@ func (x *ChannelInsight) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChannelInsight.ProtoReflect.Descriptor instead.
 func (*ChannelInsight) Descriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{11}
+	return file_faraday_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ChannelInsight) GetChanPoint() string {
@@ -1148,7 +1781,7 @@ type ExchangeRateRequest struct {
 func (x *ExchangeRateRequest) Reset() {
 	*x = ExchangeRateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faraday_proto_msgTypes[12]
+		mi := &file_faraday_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1161,7 +1794,7 @@ func (x *ExchangeRateRequest) String() string {
 func (*ExchangeRateRequest) ProtoMessage() {}
 
 func (x *ExchangeRateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_faraday_proto_msgTypes[12]
+	mi := &file_faraday_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1174,7 +1807,7 @@ func (x *ExchangeRateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExchangeRateRequest.ProtoReflect.Descriptor instead.
 func (*ExchangeRateRequest) Descriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{12}
+	return file_faraday_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ExchangeRateRequest) GetTimestamps() []uint64 {
@@ -1217,7 +1850,7 @@ type ExchangeRateResponse struct {
 func (x *ExchangeRateResponse) Reset() {
 	*x = ExchangeRateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faraday_proto_msgTypes[13]
+		mi := &file_faraday_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1230,7 +1863,7 @@ func (x *ExchangeRateResponse) String() string {
 func (*ExchangeRateResponse) ProtoMessage() {}
 
 func (x *ExchangeRateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_faraday_proto_msgTypes[13]
+	mi := &file_faraday_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1243,7 +1876,7 @@ func (x *ExchangeRateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExchangeRateResponse.ProtoReflect.Descriptor instead.
 func (*ExchangeRateResponse) Descriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{13}
+	return file_faraday_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ExchangeRateResponse) GetRates() []*ExchangeRate {
@@ -1269,7 +1902,7 @@ type BitcoinPrice struct {
 func (x *BitcoinPrice) Reset() {
 	*x = BitcoinPrice{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faraday_proto_msgTypes[14]
+		mi := &file_faraday_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1282,7 +1915,7 @@ func (x *BitcoinPrice) String() string {
 func (*BitcoinPrice) ProtoMessage() {}
 
 func (x *BitcoinPrice) ProtoReflect() protoreflect.Message {
-	mi := &file_faraday_proto_msgTypes[14]
+	mi := &file_faraday_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1295,7 +1928,7 @@ func (x *BitcoinPrice) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BitcoinPrice.ProtoReflect.Descriptor instead.
 func (*BitcoinPrice) Descriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{14}
+	return file_faraday_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *BitcoinPrice) GetPrice() string {
@@ -1335,7 +1968,7 @@ type ExchangeRate struct {
 func (x *ExchangeRate) Reset() {
 	*x = ExchangeRate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faraday_proto_msgTypes[15]
+		mi := &file_faraday_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1348,7 +1981,7 @@ func (x *ExchangeRate) String() string {
 func (*ExchangeRate) ProtoMessage() {}
 
 func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
-	mi := &file_faraday_proto_msgTypes[15]
+	mi := &file_faraday_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1361,7 +1994,7 @@ func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
 func (*ExchangeRate) Descriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{15}
+	return file_faraday_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ExchangeRate) GetTimestamp() uint64 {
@@ -1401,12 +2034,21 @@ type NodeAuditRequest struct {
 	FiatBackend FiatBackend `protobuf:"varint,7,opt,name=fiat_backend,json=fiatBackend,proto3,enum=frdrpc.FiatBackend" json:"fiat_backend,omitempty"`
 	// Custom price points to use if the CUSTOM FiatBackend option is set.
 	CustomPrices []*BitcoinPrice `protobuf:"bytes,8,rep,name=custom_prices,json=customPrices,proto3" json:"custom_prices,omitempty"`
+	// Set to return an income statement which aggregates the entries for the
+	// period rather than the entries themselves.
+	Summary bool `protobuf:"varint,9,opt,name=summary,proto3" json:"summary,omitempty"`
+	// Set to produce the report from live data only, rather than using the
+	// frozen entries of closed periods that overlap with the report.
+	IgnoreClosedPeriods bool `protobuf:"varint,10,opt,name=ignore_closed_periods,json=ignoreClosedPeriods,proto3" json:"ignore_closed_periods,omitempty"`
+	// Set to sign the report with our node's key, so that it can be attested to
+	// with frcli's verifyreport command.
+	Sign bool `protobuf:"varint,11,opt,name=sign,proto3" json:"sign,omitempty"`
 }
 
 func (x *NodeAuditRequest) Reset() {
 	*x = NodeAuditRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faraday_proto_msgTypes[16]
+		mi := &file_faraday_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1419,7 +2061,7 @@ func (x *NodeAuditRequest) String() string {
 func (*NodeAuditRequest) ProtoMessage() {}
 
 func (x *NodeAuditRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_faraday_proto_msgTypes[16]
+	mi := &file_faraday_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1432,7 +2074,7 @@ func (x *NodeAuditRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeAuditRequest.ProtoReflect.Descriptor instead.
 func (*NodeAuditRequest) Descriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{16}
+	return file_faraday_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *NodeAuditRequest) GetStartTime() uint64 {
@@ -1484,6 +2126,27 @@ func (x *NodeAuditRequest) GetCustomPrices() []*BitcoinPrice {
 	return nil
 }
 
+func (x *NodeAuditRequest) GetSummary() bool {
+	if x != nil {
+		return x.Summary
+	}
+	return false
+}
+
+func (x *NodeAuditRequest) GetIgnoreClosedPeriods() bool {
+	if x != nil {
+		return x.IgnoreClosedPeriods
+	}
+	return false
+}
+
+func (x *NodeAuditRequest) GetSign() bool {
+	if x != nil {
+		return x.Sign
+	}
+	return false
+}
+
 type CustomCategory struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1514,7 +2177,7 @@ type CustomCategory struct {
 func (x *CustomCategory) Reset() {
 	*x = CustomCategory{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faraday_proto_msgTypes[17]
+		mi := &file_faraday_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1527,7 +2190,7 @@ func (x *CustomCategory) String() string {
 func (*CustomCategory) ProtoMessage() {}
 
 func (x *CustomCategory) ProtoReflect() protoreflect.Message {
-	mi := &file_faraday_proto_msgTypes[17]
+	mi := &file_faraday_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1540,7 +2203,7 @@ func (x *CustomCategory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CustomCategory.ProtoReflect.Descriptor instead.
 func (*CustomCategory) Descriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{17}
+	return file_faraday_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *CustomCategory) GetName() string {
@@ -1607,7 +2270,7 @@ type ReportEntry struct {
 func (x *ReportEntry) Reset() {
 	*x = ReportEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faraday_proto_msgTypes[18]
+		mi := &file_faraday_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1620,7 +2283,7 @@ func (x *ReportEntry) String() string {
 func (*ReportEntry) ProtoMessage() {}
 
 func (x *ReportEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_faraday_proto_msgTypes[18]
+	mi := &file_faraday_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1633,7 +2296,7 @@ func (x *ReportEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportEntry.ProtoReflect.Descriptor instead.
 func (*ReportEntry) Descriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{18}
+	return file_faraday_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ReportEntry) GetTimestamp() uint64 {
@@ -1727,12 +2390,20 @@ type NodeAuditResponse struct {
 
 	// On chain reports for the period queried.
 	Reports []*ReportEntry `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	// An income statement for the period queried, set if a summary was
+	// requested. Individual entries are not included in summary mode.
+	Summary *AuditSummary `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	// The differences between the frozen entries and live data for each closed
+	// period that overlaps with the report.
+	PeriodDrift []*PeriodDrift `protobuf:"bytes,3,rep,name=period_drift,json=periodDrift,proto3" json:"period_drift,omitempty"`
+	// The report's signature, set if a signature was requested.
+	Signature *ReportSignature `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
 func (x *NodeAuditResponse) Reset() {
 	*x = NodeAuditResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faraday_proto_msgTypes[19]
+		mi := &file_faraday_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1745,7 +2416,7 @@ func (x *NodeAuditResponse) String() string {
 func (*NodeAuditResponse) ProtoMessage() {}
 
 func (x *NodeAuditResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_faraday_proto_msgTypes[19]
+	mi := &file_faraday_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1758,7 +2429,7 @@ func (x *NodeAuditResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeAuditResponse.ProtoReflect.Descriptor instead.
 func (*NodeAuditResponse) Descriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{19}
+	return file_faraday_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *NodeAuditResponse) GetReports() []*ReportEntry {
@@ -1768,6 +2439,27 @@ func (x *NodeAuditResponse) GetReports() []*ReportEntry {
 	return nil
 }
 
+func (x *NodeAuditResponse) GetSummary() *AuditSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *NodeAuditResponse) GetPeriodDrift() []*PeriodDrift {
+	if x != nil {
+		return x.PeriodDrift
+	}
+	return nil
+}
+
+func (x *NodeAuditResponse) GetSignature() *ReportSignature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
 type CloseReportRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1776,12 +2468,15 @@ type CloseReportRequest struct {
 	// The funding outpoint of the channel the report should be created for,
 	// formatted txid:outpoint.
 	ChannelPoint string `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+	// Set to sign the report with our node's key, so that it can be attested to
+	// with frcli's verifyreport command.
+	Sign bool `protobuf:"varint,2,opt,name=sign,proto3" json:"sign,omitempty"`
 }
 
 func (x *CloseReportRequest) Reset() {
 	*x = CloseReportRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faraday_proto_msgTypes[20]
+		mi := &file_faraday_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1794,7 +2489,7 @@ func (x *CloseReportRequest) String() string {
 func (*CloseReportRequest) ProtoMessage() {}
 
 func (x *CloseReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_faraday_proto_msgTypes[20]
+	mi := &file_faraday_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1807,7 +2502,7 @@ func (x *CloseReportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CloseReportRequest.ProtoReflect.Descriptor instead.
 func (*CloseReportRequest) Descriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{20}
+	return file_faraday_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *CloseReportRequest) GetChannelPoint() string {
@@ -1817,6 +2512,13 @@ func (x *CloseReportRequest) GetChannelPoint() string {
 	return ""
 }
 
+func (x *CloseReportRequest) GetSign() bool {
+	if x != nil {
+		return x.Sign
+	}
+	return false
+}
+
 type CloseReportResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1836,12 +2538,14 @@ type CloseReportResponse struct {
 	// The fee we paid on chain for the close transaction in staoshis, note that
 	// this field will be zero if the remote party paid.
 	CloseFee string `protobuf:"bytes,6,opt,name=close_fee,json=closeFee,proto3" json:"close_fee,omitempty"`
+	// The report's signature, set if a signature was requested.
+	Signature *ReportSignature `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
 func (x *CloseReportResponse) Reset() {
 	*x = CloseReportResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faraday_proto_msgTypes[21]
+		mi := &file_faraday_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1854,7 +2558,7 @@ func (x *CloseReportResponse) String() string {
 func (*CloseReportResponse) ProtoMessage() {}
 
 func (x *CloseReportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_faraday_proto_msgTypes[21]
+	mi := &file_faraday_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1867,7 +2571,7 @@ func (x *CloseReportResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CloseReportResponse.ProtoReflect.Descriptor instead.
 func (*CloseReportResponse) Descriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{21}
+	return file_faraday_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *CloseReportResponse) GetChannelPoint() string {
@@ -1912,410 +2616,5329 @@ func (x *CloseReportResponse) GetCloseFee() string {
 	return ""
 }
 
-var File_faraday_proto protoreflect.FileDescriptor
+func (x *CloseReportResponse) GetSignature() *ReportSignature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
 
-var file_faraday_proto_rawDesc = []byte{
-	0x0a, 0x0d, 0x66, 0x61, 0x72, 0x61, 0x64, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x06, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x22, 0xf8, 0x01, 0x0a, 0x1a, 0x43, 0x6c, 0x6f, 0x73,
-	0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75,
-	0x6d, 0x5f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
-	0x72, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f,
-	0x73, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x06,
-	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x22, 0x6a, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a,
-	0x06, 0x55, 0x50, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x56,
-	0x45, 0x4e, 0x55, 0x45, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x49, 0x4e, 0x43, 0x4f, 0x4d, 0x49,
-	0x4e, 0x47, 0x5f, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x4f,
-	0x55, 0x54, 0x47, 0x4f, 0x49, 0x4e, 0x47, 0x5f, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x10, 0x04,
-	0x12, 0x10, 0x0a, 0x0c, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45,
-	0x10, 0x05, 0x22, 0x93, 0x01, 0x0a, 0x1d, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x52, 0x65,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x66, 0x72, 0x64, 0x72,
-	0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x72,
-	0x65, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x75, 0x74,
-	0x6c, 0x69, 0x65, 0x72, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x11, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x22, 0x8f, 0x01, 0x0a, 0x1f, 0x54, 0x68, 0x72,
-	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x0b,
-	0x72, 0x65, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x22, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65,
-	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x74, 0x68, 0x72, 0x65,
-	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xb8, 0x01, 0x0a, 0x1c, 0x43,
-	0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x72, 0x65, 0x64,
-	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x12, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x72, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x73, 0x12, 0x40, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66,
-	0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x6e, 0x0a, 0x0e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x5f,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61,
-	0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x27, 0x0a, 0x0f,
-	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x22, 0x71, 0x0a, 0x14, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65,
-	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1d,
-	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a,
-	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x48, 0x0a, 0x15, 0x52, 0x65, 0x76, 0x65,
-	0x6e, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2f, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65,
-	0x6e, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x73, 0x22, 0xd5, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x49, 0x0a, 0x0c, 0x70,
-	0x61, 0x69, 0x72, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x26, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x6e,
-	0x75, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x70, 0x61, 0x69, 0x72, 0x52,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x1a, 0x52, 0x0a, 0x10, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x72,
-	0x64, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xcc, 0x01, 0x0a, 0x0a, 0x50,
-	0x61, 0x69, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x73, 0x61,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f,
-	0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x66,
-	0x65, 0x65, 0x73, 0x5f, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x73, 0x61,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x66, 0x65, 0x65, 0x73, 0x4f, 0x75, 0x74,
-	0x67, 0x6f, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x73, 0x61,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49,
-	0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x66,
-	0x65, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x73, 0x61,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x66, 0x65, 0x65, 0x73, 0x49, 0x6e, 0x63,
-	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x61, 0x74, 0x22, 0x18, 0x0a, 0x16, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x5c, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e,
-	0x73, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41,
-	0x0a, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70,
-	0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74,
-	0x52, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74,
-	0x73, 0x22, 0xd1, 0x02, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x73,
-	0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x50, 0x6f,
-	0x69, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64,
-	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
-	0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
-	0x12, 0x25, 0x0a, 0x0e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
-	0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65,
-	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
-	0x65, 0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x6e, 0x63,
-	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x76, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x73, 0x61,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4f,
-	0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x66,
-	0x65, 0x65, 0x73, 0x5f, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x73, 0x45, 0x61, 0x72, 0x6e, 0x65,
-	0x64, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70,
-	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72,
-	0x69, 0x76, 0x61, 0x74, 0x65, 0x22, 0xeb, 0x01, 0x0a, 0x13, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
-	0x0a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x04, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x12, 0x35, 0x0a,
-	0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x72, 0x61, 0x6e,
-	0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61,
-	0x72, 0x69, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x0c, 0x66, 0x69, 0x61, 0x74, 0x5f, 0x62, 0x61, 0x63,
-	0x6b, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x66, 0x72, 0x64,
-	0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x61, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52,
-	0x0b, 0x66, 0x69, 0x61, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x39, 0x0a, 0x0d,
-	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x08, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x74,
-	0x63, 0x6f, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08,
-	0x02, 0x10, 0x03, 0x22, 0x48, 0x0a, 0x14, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x72,
-	0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x72, 0x64,
-	0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65,
-	0x52, 0x05, 0x72, 0x61, 0x74, 0x65, 0x73, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0x69, 0x0a,
-	0x0c, 0x42, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1a, 0x0a, 0x08,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x5f, 0x0a, 0x0c, 0x45, 0x78, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x31, 0x0a, 0x09, 0x62, 0x74, 0x63, 0x5f, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x72, 0x64, 0x72,
-	0x70, 0x63, 0x2e, 0x42, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52,
-	0x08, 0x62, 0x74, 0x63, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0xe4, 0x02, 0x0a, 0x10, 0x4e, 0x6f,
-	0x64, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a,
-	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x61,
-	0x62, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
-	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x69, 0x61, 0x74, 0x12, 0x35, 0x0a, 0x0b, 0x67,
-	0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x75, 0x6c,
-	0x61, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69,
-	0x74, 0x79, 0x12, 0x43, 0x0a, 0x11, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x63, 0x61, 0x74,
-	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x61, 0x74,
-	0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x10, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x61, 0x74,
-	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x0c, 0x66, 0x69, 0x61, 0x74, 0x5f,
-	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e,
-	0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x61, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x65,
-	0x6e, 0x64, 0x52, 0x0b, 0x66, 0x69, 0x61, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12,
-	0x39, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73,
-	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e,
-	0x42, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x0c, 0x63, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04,
-	0x22, 0x83, 0x01, 0x0a, 0x0e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x6e, 0x5f, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x6e, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x66, 0x66, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x66, 0x66, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12,
-	0x25, 0x0a, 0x0e, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
-	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x50, 0x61,
-	0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x22, 0xe9, 0x02, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12,
-	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x64, 0x69,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f,
-	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x61, 0x74,
-	0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x61,
-	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x61, 0x74, 0x12, 0x1c, 0x0a,
-	0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x6f, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12,
-	0x31, 0x0a, 0x09, 0x62, 0x74, 0x63, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x74, 0x63,
-	0x6f, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x08, 0x62, 0x74, 0x63, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x22, 0x42, 0x0a, 0x11, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70,
-	0x63, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x72,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x39, 0x0a, 0x12, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d,
-	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x69, 0x6e,
-	0x74, 0x22, 0xdd, 0x01, 0x0a, 0x13, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x2b,
-	0x0a, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c,
-	0x6f, 0x73, 0x65, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x78, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x70, 0x65,
-	0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x70, 0x65,
-	0x6e, 0x46, 0x65, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x66, 0x65,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x46, 0x65,
-	0x65, 0x2a, 0xa1, 0x01, 0x0a, 0x0b, 0x47, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74,
-	0x79, 0x12, 0x17, 0x0a, 0x13, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x47, 0x52, 0x41,
-	0x4e, 0x55, 0x4c, 0x41, 0x52, 0x49, 0x54, 0x59, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x49,
-	0x4e, 0x55, 0x54, 0x45, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x49, 0x56, 0x45, 0x5f, 0x4d,
-	0x49, 0x4e, 0x55, 0x54, 0x45, 0x53, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x49, 0x46, 0x54,
-	0x45, 0x45, 0x4e, 0x5f, 0x4d, 0x49, 0x4e, 0x55, 0x54, 0x45, 0x53, 0x10, 0x03, 0x12, 0x12, 0x0a,
-	0x0e, 0x54, 0x48, 0x49, 0x52, 0x54, 0x59, 0x5f, 0x4d, 0x49, 0x4e, 0x55, 0x54, 0x45, 0x53, 0x10,
-	0x04, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x4f, 0x55, 0x52, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x53,
-	0x49, 0x58, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x53, 0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x57,
-	0x45, 0x4c, 0x56, 0x45, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x53, 0x10, 0x07, 0x12, 0x07, 0x0a, 0x03,
-	0x44, 0x41, 0x59, 0x10, 0x08, 0x2a, 0x6a, 0x0a, 0x0b, 0x46, 0x69, 0x61, 0x74, 0x42, 0x61, 0x63,
-	0x6b, 0x65, 0x6e, 0x64, 0x12, 0x17, 0x0a, 0x13, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f,
-	0x46, 0x49, 0x41, 0x54, 0x42, 0x41, 0x43, 0x4b, 0x45, 0x4e, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a,
-	0x07, 0x43, 0x4f, 0x49, 0x4e, 0x43, 0x41, 0x50, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f,
-	0x49, 0x4e, 0x44, 0x45, 0x53, 0x4b, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x55, 0x53, 0x54,
-	0x4f, 0x4d, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x49, 0x4e, 0x47, 0x45, 0x43, 0x4b,
-	0x4f, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x42, 0x49, 0x54, 0x46, 0x49, 0x4e, 0x45, 0x58, 0x10,
-	0x05, 0x2a, 0xa2, 0x02, 0x0a, 0x09, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12,
-	0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x4f, 0x50,
-	0x45, 0x4e, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x43,
-	0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x02, 0x12, 0x14, 0x0a,
-	0x10, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x46, 0x45,
-	0x45, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x43,
-	0x4c, 0x4f, 0x53, 0x45, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50,
-	0x54, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x06,
-	0x12, 0x07, 0x0a, 0x03, 0x46, 0x45, 0x45, 0x10, 0x07, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x49, 0x52,
-	0x43, 0x55, 0x4c, 0x41, 0x52, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x10, 0x08, 0x12,
-	0x0b, 0x0a, 0x07, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x10, 0x09, 0x12, 0x0f, 0x0a, 0x0b,
-	0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x46, 0x45, 0x45, 0x10, 0x0a, 0x12, 0x14, 0x0a,
-	0x10, 0x43, 0x49, 0x52, 0x43, 0x55, 0x4c, 0x41, 0x52, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e,
-	0x54, 0x10, 0x0b, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x49, 0x52, 0x43, 0x55, 0x4c, 0x41, 0x52, 0x5f,
-	0x46, 0x45, 0x45, 0x10, 0x0c, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x57, 0x45, 0x45, 0x50, 0x10, 0x0d,
-	0x12, 0x0d, 0x0a, 0x09, 0x53, 0x57, 0x45, 0x45, 0x50, 0x5f, 0x46, 0x45, 0x45, 0x10, 0x0e, 0x12,
-	0x15, 0x0a, 0x11, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45,
-	0x5f, 0x46, 0x45, 0x45, 0x10, 0x0f, 0x32, 0xd8, 0x04, 0x0a, 0x0d, 0x46, 0x61, 0x72, 0x61, 0x64,
-	0x61, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x65, 0x0a, 0x16, 0x4f, 0x75, 0x74, 0x6c,
-	0x69, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x25, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x6c,
-	0x69, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x66, 0x72, 0x64, 0x72,
-	0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x69, 0x0a, 0x18, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x2e, 0x66, 0x72,
-	0x64, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x65,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c,
-	0x6f, 0x73, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x52, 0x65,
-	0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x2e, 0x66, 0x72,
-	0x64, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x66, 0x72, 0x64, 0x72,
-	0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x66, 0x72,
-	0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x73, 0x69,
-	0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x66, 0x72,
-	0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x73, 0x69,
-	0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c,
-	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x66,
-	0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x66, 0x72, 0x64, 0x72,
-	0x70, 0x63, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x4e, 0x6f, 0x64, 0x65, 0x41,
-	0x75, 0x64, 0x69, 0x74, 0x12, 0x18, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x6f,
-	0x64, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
-	0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x64, 0x69,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x43, 0x6c, 0x6f,
-	0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70,
-	0x63, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c,
-	0x6f, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x66, 0x61,
-	0x72, 0x61, 0x64, 0x61, 0x79, 0x2f, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+type CloseDryRunRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The funding outpoints of the channels to simulate closing, formatted
+	// txid:outpoint.
+	ChanPoints []string `protobuf:"bytes,1,rep,name=chan_points,json=chanPoints,proto3" json:"chan_points,omitempty"`
+	// The confirmation target to estimate close fees for. If this value is not
+	// set, a default of 6 blocks is used.
+	ConfTarget int32 `protobuf:"varint,2,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
+	// Start time is the beginning of the range of forwarding history that is
+	// used to project lost revenue, expressed as unix epoch offset in seconds.
+	// If this value is not set, the last 30 days of forwards are used.
+	StartTime uint64 `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// End time is the end of the range of forwarding history that is used to
+	// project lost revenue, expressed as unix epoch offset in seconds. If this
+	// value is not set, it defaults to the present.
+	EndTime uint64 `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *CloseDryRunRequest) Reset() {
+	*x = CloseDryRunRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-var (
-	file_faraday_proto_rawDescOnce sync.Once
-	file_faraday_proto_rawDescData = file_faraday_proto_rawDesc
-)
+func (x *CloseDryRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_faraday_proto_rawDescGZIP() []byte {
-	file_faraday_proto_rawDescOnce.Do(func() {
-		file_faraday_proto_rawDescData = protoimpl.X.CompressGZIP(file_faraday_proto_rawDescData)
-	})
-	return file_faraday_proto_rawDescData
+func (*CloseDryRunRequest) ProtoMessage() {}
+
+func (x *CloseDryRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_faraday_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_faraday_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
-var file_faraday_proto_goTypes = []any{
-	(Granularity)(0),                        // 0: frdrpc.Granularity
-	(FiatBackend)(0),                        // 1: frdrpc.FiatBackend
-	(EntryType)(0),                          // 2: frdrpc.EntryType
-	(CloseRecommendationRequest_Metric)(0),  // 3: frdrpc.CloseRecommendationRequest.Metric
-	(*CloseRecommendationRequest)(nil),      // 4: frdrpc.CloseRecommendationRequest
-	(*OutlierRecommendationsRequest)(nil),   // 5: frdrpc.OutlierRecommendationsRequest
-	(*ThresholdRecommendationsRequest)(nil), // 6: frdrpc.ThresholdRecommendationsRequest
-	(*CloseRecommendationsResponse)(nil),    // 7: frdrpc.CloseRecommendationsResponse
-	(*Recommendation)(nil),                  // 8: frdrpc.Recommendation
-	(*RevenueReportRequest)(nil),            // 9: frdrpc.RevenueReportRequest
-	(*RevenueReportResponse)(nil),           // 10: frdrpc.RevenueReportResponse
-	(*RevenueReport)(nil),                   // 11: frdrpc.RevenueReport
-	(*PairReport)(nil),                      // 12: frdrpc.PairReport
-	(*ChannelInsightsRequest)(nil),          // 13: frdrpc.ChannelInsightsRequest
-	(*ChannelInsightsResponse)(nil),         // 14: frdrpc.ChannelInsightsResponse
-	(*ChannelInsight)(nil),                  // 15: frdrpc.ChannelInsight
-	(*ExchangeRateRequest)(nil),             // 16: frdrpc.ExchangeRateRequest
-	(*ExchangeRateResponse)(nil),            // 17: frdrpc.ExchangeRateResponse
-	(*BitcoinPrice)(nil),                    // 18: frdrpc.BitcoinPrice
-	(*ExchangeRate)(nil),                    // 19: frdrpc.ExchangeRate
-	(*NodeAuditRequest)(nil),                // 20: frdrpc.NodeAuditRequest
-	(*CustomCategory)(nil),                  // 21: frdrpc.CustomCategory
-	(*ReportEntry)(nil),                     // 22: frdrpc.ReportEntry
-	(*NodeAuditResponse)(nil),               // 23: frdrpc.NodeAuditResponse
-	(*CloseReportRequest)(nil),              // 24: frdrpc.CloseReportRequest
-	(*CloseReportResponse)(nil),             // 25: frdrpc.CloseReportResponse
-	nil,                                     // 26: frdrpc.RevenueReport.PairReportsEntry
+// Deprecated: Use CloseDryRunRequest.ProtoReflect.Descriptor instead.
+func (*CloseDryRunRequest) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{24}
 }
-var file_faraday_proto_depIdxs = []int32{
-	3,  // 0: frdrpc.CloseRecommendationRequest.metric:type_name -> frdrpc.CloseRecommendationRequest.Metric
-	4,  // 1: frdrpc.OutlierRecommendationsRequest.rec_request:type_name -> frdrpc.CloseRecommendationRequest
-	4,  // 2: frdrpc.ThresholdRecommendationsRequest.rec_request:type_name -> frdrpc.CloseRecommendationRequest
-	8,  // 3: frdrpc.CloseRecommendationsResponse.recommendations:type_name -> frdrpc.Recommendation
-	11, // 4: frdrpc.RevenueReportResponse.reports:type_name -> frdrpc.RevenueReport
-	26, // 5: frdrpc.RevenueReport.pair_reports:type_name -> frdrpc.RevenueReport.PairReportsEntry
-	15, // 6: frdrpc.ChannelInsightsResponse.channel_insights:type_name -> frdrpc.ChannelInsight
-	0,  // 7: frdrpc.ExchangeRateRequest.granularity:type_name -> frdrpc.Granularity
-	1,  // 8: frdrpc.ExchangeRateRequest.fiat_backend:type_name -> frdrpc.FiatBackend
-	18, // 9: frdrpc.ExchangeRateRequest.custom_prices:type_name -> frdrpc.BitcoinPrice
-	19, // 10: frdrpc.ExchangeRateResponse.rates:type_name -> frdrpc.ExchangeRate
-	18, // 11: frdrpc.ExchangeRate.btc_price:type_name -> frdrpc.BitcoinPrice
-	0,  // 12: frdrpc.NodeAuditRequest.granularity:type_name -> frdrpc.Granularity
-	21, // 13: frdrpc.NodeAuditRequest.custom_categories:type_name -> frdrpc.CustomCategory
-	1,  // 14: frdrpc.NodeAuditRequest.fiat_backend:type_name -> frdrpc.FiatBackend
-	18, // 15: frdrpc.NodeAuditRequest.custom_prices:type_name -> frdrpc.BitcoinPrice
-	2,  // 16: frdrpc.ReportEntry.type:type_name -> frdrpc.EntryType
-	18, // 17: frdrpc.ReportEntry.btc_price:type_name -> frdrpc.BitcoinPrice
-	22, // 18: frdrpc.NodeAuditResponse.reports:type_name -> frdrpc.ReportEntry
-	12, // 19: frdrpc.RevenueReport.PairReportsEntry.value:type_name -> frdrpc.PairReport
-	5,  // 20: frdrpc.FaradayServer.OutlierRecommendations:input_type -> frdrpc.OutlierRecommendationsRequest
-	6,  // 21: frdrpc.FaradayServer.ThresholdRecommendations:input_type -> frdrpc.ThresholdRecommendationsRequest
-	9,  // 22: frdrpc.FaradayServer.RevenueReport:input_type -> frdrpc.RevenueReportRequest
-	13, // 23: frdrpc.FaradayServer.ChannelInsights:input_type -> frdrpc.ChannelInsightsRequest
-	16, // 24: frdrpc.FaradayServer.ExchangeRate:input_type -> frdrpc.ExchangeRateRequest
-	20, // 25: frdrpc.FaradayServer.NodeAudit:input_type -> frdrpc.NodeAuditRequest
-	24, // 26: frdrpc.FaradayServer.CloseReport:input_type -> frdrpc.CloseReportRequest
-	7,  // 27: frdrpc.FaradayServer.OutlierRecommendations:output_type -> frdrpc.CloseRecommendationsResponse
-	7,  // 28: frdrpc.FaradayServer.ThresholdRecommendations:output_type -> frdrpc.CloseRecommendationsResponse
-	10, // 29: frdrpc.FaradayServer.RevenueReport:output_type -> frdrpc.RevenueReportResponse
-	14, // 30: frdrpc.FaradayServer.ChannelInsights:output_type -> frdrpc.ChannelInsightsResponse
-	17, // 31: frdrpc.FaradayServer.ExchangeRate:output_type -> frdrpc.ExchangeRateResponse
-	23, // 32: frdrpc.FaradayServer.NodeAudit:output_type -> frdrpc.NodeAuditResponse
-	25, // 33: frdrpc.FaradayServer.CloseReport:output_type -> frdrpc.CloseReportResponse
-	27, // [27:34] is the sub-list for method output_type
-	20, // [20:27] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+
+func (x *CloseDryRunRequest) GetChanPoints() []string {
+	if x != nil {
+		return x.ChanPoints
+	}
+	return nil
 }
 
-func init() { file_faraday_proto_init() }
-func file_faraday_proto_init() {
-	if File_faraday_proto != nil {
-		return
+func (x *CloseDryRunRequest) GetConfTarget() int32 {
+	if x != nil {
+		return x.ConfTarget
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_faraday_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*CloseRecommendationRequest); i {
+	return 0
+}
+
+func (x *CloseDryRunRequest) GetStartTime() uint64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *CloseDryRunRequest) GetEndTime() uint64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+type CloseDryRunResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The fee rate, in sat/kw, that close fees were estimated with.
+	FeeRateSatPerKw uint64 `protobuf:"varint,1,opt,name=fee_rate_sat_per_kw,json=feeRateSatPerKw,proto3" json:"fee_rate_sat_per_kw,omitempty"`
+	// Close fee estimates for each of the channels.
+	Estimates []*CloseEstimate `protobuf:"bytes,2,rep,name=estimates,proto3" json:"estimates,omitempty"`
+	// The pairwise forwarding flows that include at least one of the channels
+	// being closed, ordered by the fees they earned.
+	LostFlows []*LostFlow `protobuf:"bytes,3,rep,name=lost_flows,json=lostFlows,proto3" json:"lost_flows,omitempty"`
+	// The total fees, in millisatoshis, earned over the period by flows that
+	// would no longer have a route through our node.
+	LostFeesMsat uint64 `protobuf:"varint,4,opt,name=lost_fees_msat,json=lostFeesMsat,proto3" json:"lost_fees_msat,omitempty"`
+	// The total fees, in millisatoshis, earned over the period by flows that
+	// could still be routed through other channels with the same peers.
+	ReroutableFeesMsat uint64 `protobuf:"varint,5,opt,name=reroutable_fees_msat,json=reroutableFeesMsat,proto3" json:"reroutable_fees_msat,omitempty"`
+	// The average fees, in millisatoshis, that we would lose per day based on
+	// the lost fees over the period.
+	LostFeesPerDayMsat uint64 `protobuf:"varint,6,opt,name=lost_fees_per_day_msat,json=lostFeesPerDayMsat,proto3" json:"lost_fees_per_day_msat,omitempty"`
+}
+
+func (x *CloseDryRunResponse) Reset() {
+	*x = CloseDryRunResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseDryRunResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseDryRunResponse) ProtoMessage() {}
+
+func (x *CloseDryRunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseDryRunResponse.ProtoReflect.Descriptor instead.
+func (*CloseDryRunResponse) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CloseDryRunResponse) GetFeeRateSatPerKw() uint64 {
+	if x != nil {
+		return x.FeeRateSatPerKw
+	}
+	return 0
+}
+
+func (x *CloseDryRunResponse) GetEstimates() []*CloseEstimate {
+	if x != nil {
+		return x.Estimates
+	}
+	return nil
+}
+
+func (x *CloseDryRunResponse) GetLostFlows() []*LostFlow {
+	if x != nil {
+		return x.LostFlows
+	}
+	return nil
+}
+
+func (x *CloseDryRunResponse) GetLostFeesMsat() uint64 {
+	if x != nil {
+		return x.LostFeesMsat
+	}
+	return 0
+}
+
+func (x *CloseDryRunResponse) GetReroutableFeesMsat() uint64 {
+	if x != nil {
+		return x.ReroutableFeesMsat
+	}
+	return 0
+}
+
+func (x *CloseDryRunResponse) GetLostFeesPerDayMsat() uint64 {
+	if x != nil {
+		return x.LostFeesPerDayMsat
+	}
+	return 0
+}
+
+type CloseEstimate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The funding outpoint of the channel.
+	ChanPoint string `protobuf:"bytes,1,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
+	// True if we opened the channel, false if the remote peer did.
+	Initiator bool `protobuf:"varint,2,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	// Our current balance in the channel in satoshis.
+	LocalBalanceSat int64 `protobuf:"varint,3,opt,name=local_balance_sat,json=localBalanceSat,proto3" json:"local_balance_sat,omitempty"`
+	// The fee, in satoshis, that we would pay to close the channel
+	// cooperatively. Note that this field will be zero if the remote party
+	// opened the channel.
+	CooperativeFeeSat int64 `protobuf:"varint,4,opt,name=cooperative_fee_sat,json=cooperativeFeeSat,proto3" json:"cooperative_fee_sat,omitempty"`
+	// The total fee, in satoshis, that we would pay to force close the channel,
+	// including the commitment fee and the fees to sweep our balance and resolve
+	// pending htlcs.
+	ForceFeeSat int64 `protobuf:"varint,5,opt,name=force_fee_sat,json=forceFeeSat,proto3" json:"force_fee_sat,omitempty"`
+	// The commitment transaction fee in satoshis, note that this field will be
+	// zero if the remote party opened the channel.
+	ForceCommitFeeSat int64 `protobuf:"varint,6,opt,name=force_commit_fee_sat,json=forceCommitFeeSat,proto3" json:"force_commit_fee_sat,omitempty"`
+	// The fee, in satoshis, to sweep our balance after a force close.
+	ForceSweepFeeSat int64 `protobuf:"varint,7,opt,name=force_sweep_fee_sat,json=forceSweepFeeSat,proto3" json:"force_sweep_fee_sat,omitempty"`
+	// The fee, in satoshis, to resolve pending htlcs after a force close.
+	ForceHtlcFeeSat int64 `protobuf:"varint,8,opt,name=force_htlc_fee_sat,json=forceHtlcFeeSat,proto3" json:"force_htlc_fee_sat,omitempty"`
+	// The number of blocks that our funds would be time locked for after a
+	// force close.
+	CsvDelay uint64 `protobuf:"varint,9,opt,name=csv_delay,json=csvDelay,proto3" json:"csv_delay,omitempty"`
+}
+
+func (x *CloseEstimate) Reset() {
+	*x = CloseEstimate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseEstimate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseEstimate) ProtoMessage() {}
+
+func (x *CloseEstimate) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseEstimate.ProtoReflect.Descriptor instead.
+func (*CloseEstimate) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CloseEstimate) GetChanPoint() string {
+	if x != nil {
+		return x.ChanPoint
+	}
+	return ""
+}
+
+func (x *CloseEstimate) GetInitiator() bool {
+	if x != nil {
+		return x.Initiator
+	}
+	return false
+}
+
+func (x *CloseEstimate) GetLocalBalanceSat() int64 {
+	if x != nil {
+		return x.LocalBalanceSat
+	}
+	return 0
+}
+
+func (x *CloseEstimate) GetCooperativeFeeSat() int64 {
+	if x != nil {
+		return x.CooperativeFeeSat
+	}
+	return 0
+}
+
+func (x *CloseEstimate) GetForceFeeSat() int64 {
+	if x != nil {
+		return x.ForceFeeSat
+	}
+	return 0
+}
+
+func (x *CloseEstimate) GetForceCommitFeeSat() int64 {
+	if x != nil {
+		return x.ForceCommitFeeSat
+	}
+	return 0
+}
+
+func (x *CloseEstimate) GetForceSweepFeeSat() int64 {
+	if x != nil {
+		return x.ForceSweepFeeSat
+	}
+	return 0
+}
+
+func (x *CloseEstimate) GetForceHtlcFeeSat() int64 {
+	if x != nil {
+		return x.ForceHtlcFeeSat
+	}
+	return 0
+}
+
+func (x *CloseEstimate) GetCsvDelay() uint64 {
+	if x != nil {
+		return x.CsvDelay
+	}
+	return 0
+}
+
+type LostFlow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The funding outpoint of the channel that forwards arrived on.
+	IncomingChanPoint string `protobuf:"bytes,1,opt,name=incoming_chan_point,json=incomingChanPoint,proto3" json:"incoming_chan_point,omitempty"`
+	// The funding outpoint of the channel that forwards left our node on.
+	OutgoingChanPoint string `protobuf:"bytes,2,opt,name=outgoing_chan_point,json=outgoingChanPoint,proto3" json:"outgoing_chan_point,omitempty"`
+	// The amount, in millisatoshis, forwarded out over the outgoing channel.
+	AmountMsat uint64 `protobuf:"varint,3,opt,name=amount_msat,json=amountMsat,proto3" json:"amount_msat,omitempty"`
+	// The fees, in millisatoshis, earned by the flow.
+	FeesMsat uint64 `protobuf:"varint,4,opt,name=fees_msat,json=feesMsat,proto3" json:"fees_msat,omitempty"`
+	// True if the peers of the closed channels in the flow have other channels
+	// with our node, so the flow could still be routed through our node.
+	AlternativeRoute bool `protobuf:"varint,5,opt,name=alternative_route,json=alternativeRoute,proto3" json:"alternative_route,omitempty"`
+}
+
+func (x *LostFlow) Reset() {
+	*x = LostFlow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LostFlow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LostFlow) ProtoMessage() {}
+
+func (x *LostFlow) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LostFlow.ProtoReflect.Descriptor instead.
+func (*LostFlow) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *LostFlow) GetIncomingChanPoint() string {
+	if x != nil {
+		return x.IncomingChanPoint
+	}
+	return ""
+}
+
+func (x *LostFlow) GetOutgoingChanPoint() string {
+	if x != nil {
+		return x.OutgoingChanPoint
+	}
+	return ""
+}
+
+func (x *LostFlow) GetAmountMsat() uint64 {
+	if x != nil {
+		return x.AmountMsat
+	}
+	return 0
+}
+
+func (x *LostFlow) GetFeesMsat() uint64 {
+	if x != nil {
+		return x.FeesMsat
+	}
+	return 0
+}
+
+func (x *LostFlow) GetAlternativeRoute() bool {
+	if x != nil {
+		return x.AlternativeRoute
+	}
+	return false
+}
+
+type ForwardingFailuresRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Start time is beginning of the range over which the report will be
+	// generated, expressed as unix epoch offset in seconds.
+	StartTime uint64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// End time is end of the range over which the report will be generated,
+	// expressed as unix epoch offset in seconds. If this value is not set, it
+	// defaults to the present.
+	EndTime uint64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *ForwardingFailuresRequest) Reset() {
+	*x = ForwardingFailuresRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForwardingFailuresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardingFailuresRequest) ProtoMessage() {}
+
+func (x *ForwardingFailuresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForwardingFailuresRequest.ProtoReflect.Descriptor instead.
+func (*ForwardingFailuresRequest) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ForwardingFailuresRequest) GetStartTime() uint64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *ForwardingFailuresRequest) GetEndTime() uint64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+type ForwardingFailuresResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The total number of forwards that failed over the period.
+	FailureCount uint64 `protobuf:"varint,1,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	// The total amount, in millisatoshis, that we failed to forward.
+	MissedVolumeMsat uint64 `protobuf:"varint,2,opt,name=missed_volume_msat,json=missedVolumeMsat,proto3" json:"missed_volume_msat,omitempty"`
+	// The total fees, in millisatoshis, that we would have earned if the
+	// forwards had succeeded.
+	MissedFeesMsat uint64 `protobuf:"varint,3,opt,name=missed_fees_msat,json=missedFeesMsat,proto3" json:"missed_fees_msat,omitempty"`
+	// Failed forwards for each outgoing channel, ordered by missed fees.
+	Channels []*ChannelFailures `protobuf:"bytes,4,rep,name=channels,proto3" json:"channels,omitempty"`
+}
+
+func (x *ForwardingFailuresResponse) Reset() {
+	*x = ForwardingFailuresResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForwardingFailuresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardingFailuresResponse) ProtoMessage() {}
+
+func (x *ForwardingFailuresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForwardingFailuresResponse.ProtoReflect.Descriptor instead.
+func (*ForwardingFailuresResponse) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ForwardingFailuresResponse) GetFailureCount() uint64 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
+}
+
+func (x *ForwardingFailuresResponse) GetMissedVolumeMsat() uint64 {
+	if x != nil {
+		return x.MissedVolumeMsat
+	}
+	return 0
+}
+
+func (x *ForwardingFailuresResponse) GetMissedFeesMsat() uint64 {
+	if x != nil {
+		return x.MissedFeesMsat
+	}
+	return 0
+}
+
+func (x *ForwardingFailuresResponse) GetChannels() []*ChannelFailures {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+type ChannelFailures struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The short channel id that forwards were requested over.
+	ChannelId uint64 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// The funding outpoint of the outgoing channel. This field is empty if the
+	// short channel id is not one of our channels.
+	ChanPoint string `protobuf:"bytes,2,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
+	// The number of forwards that failed for this channel.
+	FailureCount uint64 `protobuf:"varint,3,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	// The amount, in millisatoshis, that we failed to forward.
+	MissedVolumeMsat uint64 `protobuf:"varint,4,opt,name=missed_volume_msat,json=missedVolumeMsat,proto3" json:"missed_volume_msat,omitempty"`
+	// The fees, in millisatoshis, that we missed.
+	MissedFeesMsat uint64 `protobuf:"varint,5,opt,name=missed_fees_msat,json=missedFeesMsat,proto3" json:"missed_fees_msat,omitempty"`
+	// The failed forwards for this channel for each failure reason.
+	Reasons []*FailureReason `protobuf:"bytes,6,rep,name=reasons,proto3" json:"reasons,omitempty"`
+}
+
+func (x *ChannelFailures) Reset() {
+	*x = ChannelFailures{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelFailures) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelFailures) ProtoMessage() {}
+
+func (x *ChannelFailures) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelFailures.ProtoReflect.Descriptor instead.
+func (*ChannelFailures) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ChannelFailures) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *ChannelFailures) GetChanPoint() string {
+	if x != nil {
+		return x.ChanPoint
+	}
+	return ""
+}
+
+func (x *ChannelFailures) GetFailureCount() uint64 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
+}
+
+func (x *ChannelFailures) GetMissedVolumeMsat() uint64 {
+	if x != nil {
+		return x.MissedVolumeMsat
+	}
+	return 0
+}
+
+func (x *ChannelFailures) GetMissedFeesMsat() uint64 {
+	if x != nil {
+		return x.MissedFeesMsat
+	}
+	return 0
+}
+
+func (x *ChannelFailures) GetReasons() []*FailureReason {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+type FailureReason struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The reason that forwards failed. This is lnd's failure detail if one was
+	// provided, the wire failure code if not, or DOWNSTREAM_FAILURE if the
+	// forward failed after it left our node.
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The number of forwards that failed for this reason.
+	FailureCount uint64 `protobuf:"varint,2,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	// The amount, in millisatoshis, that we failed to forward.
+	MissedVolumeMsat uint64 `protobuf:"varint,3,opt,name=missed_volume_msat,json=missedVolumeMsat,proto3" json:"missed_volume_msat,omitempty"`
+	// The fees, in millisatoshis, that we missed.
+	MissedFeesMsat uint64 `protobuf:"varint,4,opt,name=missed_fees_msat,json=missedFeesMsat,proto3" json:"missed_fees_msat,omitempty"`
+}
+
+func (x *FailureReason) Reset() {
+	*x = FailureReason{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FailureReason) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailureReason) ProtoMessage() {}
+
+func (x *FailureReason) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailureReason.ProtoReflect.Descriptor instead.
+func (*FailureReason) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *FailureReason) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *FailureReason) GetFailureCount() uint64 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
+}
+
+func (x *FailureReason) GetMissedVolumeMsat() uint64 {
+	if x != nil {
+		return x.MissedVolumeMsat
+	}
+	return 0
+}
+
+func (x *FailureReason) GetMissedFeesMsat() uint64 {
+	if x != nil {
+		return x.MissedFeesMsat
+	}
+	return 0
+}
+
+type PairFlowsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Start time is beginning of the range over which the report will be
+	// generated, expressed as unix epoch offset in seconds. If this value is
+	// not set, the report covers the 30 days before the end time.
+	StartTime uint64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// End time is end of the range over which the report will be generated,
+	// expressed as unix epoch offset in seconds. If this value is not set, it
+	// defaults to the present.
+	EndTime uint64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// The duration of each time bucket in seconds. If this value is not set, the
+	// report contains a single bucket covering the full period.
+	BucketSeconds uint64 `protobuf:"varint,3,opt,name=bucket_seconds,json=bucketSeconds,proto3" json:"bucket_seconds,omitempty"`
+	// The turnover, expressed as total volume divided by capacity, below which a
+	// channel is classified as dormant. If this value is not set, a default of
+	// 0.01 is used.
+	DormantTurnover float32 `protobuf:"fixed32,4,opt,name=dormant_turnover,json=dormantTurnover,proto3" json:"dormant_turnover,omitempty"`
+	// The imbalance between incoming and outgoing volume, in (0, 1], above which
+	// a channel is classified as a source or sink rather than a router. If this
+	// value is not set, a default of 0.5 is used.
+	DirectionThreshold float32 `protobuf:"fixed32,5,opt,name=direction_threshold,json=directionThreshold,proto3" json:"direction_threshold,omitempty"`
+}
+
+func (x *PairFlowsRequest) Reset() {
+	*x = PairFlowsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairFlowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairFlowsRequest) ProtoMessage() {}
+
+func (x *PairFlowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairFlowsRequest.ProtoReflect.Descriptor instead.
+func (*PairFlowsRequest) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PairFlowsRequest) GetStartTime() uint64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *PairFlowsRequest) GetEndTime() uint64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *PairFlowsRequest) GetBucketSeconds() uint64 {
+	if x != nil {
+		return x.BucketSeconds
+	}
+	return 0
+}
+
+func (x *PairFlowsRequest) GetDormantTurnover() float32 {
+	if x != nil {
+		return x.DormantTurnover
+	}
+	return 0
+}
+
+func (x *PairFlowsRequest) GetDirectionThreshold() float32 {
+	if x != nil {
+		return x.DirectionThreshold
+	}
+	return 0
+}
+
+type PairFlowsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Pairwise flows for each time bucket.
+	Buckets []*FlowBucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	// A flow summary for each open channel, ordered by turnover.
+	Channels []*ChannelFlow `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
+}
+
+func (x *PairFlowsResponse) Reset() {
+	*x = PairFlowsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairFlowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairFlowsResponse) ProtoMessage() {}
+
+func (x *PairFlowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairFlowsResponse.ProtoReflect.Descriptor instead.
+func (*PairFlowsResponse) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PairFlowsResponse) GetBuckets() []*FlowBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *PairFlowsResponse) GetChannels() []*ChannelFlow {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+type FlowBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The start of the bucket's period as a unix timestamp, inclusive.
+	StartTime uint64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The end of the bucket's period as a unix timestamp, exclusive.
+	EndTime uint64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// The pairwise flows in the bucket, ordered by amount.
+	Flows []*PairFlow `protobuf:"bytes,3,rep,name=flows,proto3" json:"flows,omitempty"`
+}
+
+func (x *FlowBucket) Reset() {
+	*x = FlowBucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlowBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlowBucket) ProtoMessage() {}
+
+func (x *FlowBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlowBucket.ProtoReflect.Descriptor instead.
+func (*FlowBucket) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *FlowBucket) GetStartTime() uint64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *FlowBucket) GetEndTime() uint64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *FlowBucket) GetFlows() []*PairFlow {
+	if x != nil {
+		return x.Flows
+	}
+	return nil
+}
+
+type PairFlow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The funding outpoint of the channel that forwards arrived on.
+	IncomingChanPoint string `protobuf:"bytes,1,opt,name=incoming_chan_point,json=incomingChanPoint,proto3" json:"incoming_chan_point,omitempty"`
+	// The funding outpoint of the channel that forwards left our node on.
+	OutgoingChanPoint string `protobuf:"bytes,2,opt,name=outgoing_chan_point,json=outgoingChanPoint,proto3" json:"outgoing_chan_point,omitempty"`
+	// The amount, in millisatoshis, forwarded out over the outgoing channel.
+	AmountMsat uint64 `protobuf:"varint,3,opt,name=amount_msat,json=amountMsat,proto3" json:"amount_msat,omitempty"`
+	// The fees, in millisatoshis, earned by the flow.
+	FeesMsat uint64 `protobuf:"varint,4,opt,name=fees_msat,json=feesMsat,proto3" json:"fees_msat,omitempty"`
+	// The amount forwarded as a fraction of the smaller of the two channels'
+	// capacities.
+	Normalized float64 `protobuf:"fixed64,5,opt,name=normalized,proto3" json:"normalized,omitempty"`
+}
+
+func (x *PairFlow) Reset() {
+	*x = PairFlow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairFlow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairFlow) ProtoMessage() {}
+
+func (x *PairFlow) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairFlow.ProtoReflect.Descriptor instead.
+func (*PairFlow) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PairFlow) GetIncomingChanPoint() string {
+	if x != nil {
+		return x.IncomingChanPoint
+	}
+	return ""
+}
+
+func (x *PairFlow) GetOutgoingChanPoint() string {
+	if x != nil {
+		return x.OutgoingChanPoint
+	}
+	return ""
+}
+
+func (x *PairFlow) GetAmountMsat() uint64 {
+	if x != nil {
+		return x.AmountMsat
+	}
+	return 0
+}
+
+func (x *PairFlow) GetFeesMsat() uint64 {
+	if x != nil {
+		return x.FeesMsat
+	}
+	return 0
+}
+
+func (x *PairFlow) GetNormalized() float64 {
+	if x != nil {
+		return x.Normalized
+	}
+	return 0
+}
+
+type ChannelFlow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The funding outpoint of the channel.
+	ChanPoint string `protobuf:"bytes,1,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
+	// The capacity of the channel in satoshis.
+	CapacitySat int64 `protobuf:"varint,2,opt,name=capacity_sat,json=capacitySat,proto3" json:"capacity_sat,omitempty"`
+	// The amount, in millisatoshis, that arrived on the channel.
+	AmountIncomingMsat uint64 `protobuf:"varint,3,opt,name=amount_incoming_msat,json=amountIncomingMsat,proto3" json:"amount_incoming_msat,omitempty"`
+	// The amount, in millisatoshis, that left our node on the channel.
+	AmountOutgoingMsat uint64 `protobuf:"varint,4,opt,name=amount_outgoing_msat,json=amountOutgoingMsat,proto3" json:"amount_outgoing_msat,omitempty"`
+	// The total volume of the channel as a multiple of its capacity.
+	Turnover float64 `protobuf:"fixed64,5,opt,name=turnover,proto3" json:"turnover,omitempty"`
+	// The imbalance between incoming and outgoing volume in [-1, 1]. Channels
+	// with only incoming volume have a direction of 1, and channels with only
+	// outgoing volume have a direction of -1.
+	Direction float64 `protobuf:"fixed64,6,opt,name=direction,proto3" json:"direction,omitempty"`
+	// The classification of the channel. Sources are channels that forwards
+	// mostly arrive on, which accumulate local balance. Sinks are channels that
+	// forwards mostly leave on, which deplete local balance. Routers have
+	// balanced flows, and dormant channels have little volume.
+	Role ChannelFlow_Role `protobuf:"varint,7,opt,name=role,proto3,enum=frdrpc.ChannelFlow_Role" json:"role,omitempty"`
+}
+
+func (x *ChannelFlow) Reset() {
+	*x = ChannelFlow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelFlow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelFlow) ProtoMessage() {}
+
+func (x *ChannelFlow) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelFlow.ProtoReflect.Descriptor instead.
+func (*ChannelFlow) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ChannelFlow) GetChanPoint() string {
+	if x != nil {
+		return x.ChanPoint
+	}
+	return ""
+}
+
+func (x *ChannelFlow) GetCapacitySat() int64 {
+	if x != nil {
+		return x.CapacitySat
+	}
+	return 0
+}
+
+func (x *ChannelFlow) GetAmountIncomingMsat() uint64 {
+	if x != nil {
+		return x.AmountIncomingMsat
+	}
+	return 0
+}
+
+func (x *ChannelFlow) GetAmountOutgoingMsat() uint64 {
+	if x != nil {
+		return x.AmountOutgoingMsat
+	}
+	return 0
+}
+
+func (x *ChannelFlow) GetTurnover() float64 {
+	if x != nil {
+		return x.Turnover
+	}
+	return 0
+}
+
+func (x *ChannelFlow) GetDirection() float64 {
+	if x != nil {
+		return x.Direction
+	}
+	return 0
+}
+
+func (x *ChannelFlow) GetRole() ChannelFlow_Role {
+	if x != nil {
+		return x.Role
+	}
+	return ChannelFlow_DORMANT
+}
+
+type OpenRecommendationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Start time is beginning of the range over which fees and demand are
+	// measured, expressed as unix epoch offset in seconds. If this value is
+	// not set, the 30 days before the end time are used.
+	StartTime uint64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// End time is end of the range over which fees and demand are measured,
+	// expressed as unix epoch offset in seconds. If this value is not set, it
+	// defaults to the present.
+	EndTime uint64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// The confirmation target to estimate opening fees for. If this value is
+	// not set, a default of 6 blocks is used.
+	ConfTarget int32 `protobuf:"varint,3,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
+	// The number of days of outgoing demand that suggested channels should
+	// provide liquidity for. If this value is not set, a default of 7 days is
+	// used.
+	LiquidityDays float32 `protobuf:"fixed32,4,opt,name=liquidity_days,json=liquidityDays,proto3" json:"liquidity_days,omitempty"`
+	// The smallest channel size, in satoshis, that will be suggested. If this
+	// value is not set, a default of 1,000,000 satoshis is used.
+	MinChannelSizeSat int64 `protobuf:"varint,5,opt,name=min_channel_size_sat,json=minChannelSizeSat,proto3" json:"min_channel_size_sat,omitempty"`
+	// The largest channel size, in satoshis, that will be suggested. If this
+	// value is not set, a default of 16,777,215 satoshis is used.
+	MaxChannelSizeSat int64 `protobuf:"varint,6,opt,name=max_channel_size_sat,json=maxChannelSizeSat,proto3" json:"max_channel_size_sat,omitempty"`
+	// The model used to attribute each forward's fee to its incoming and
+	// outgoing channels when calculating the fees earned with each peer. If
+	// this value is not set, fees are split evenly.
+	FeeAttribution FeeAttribution `protobuf:"varint,7,opt,name=fee_attribution,json=feeAttribution,proto3,enum=frdrpc.FeeAttribution" json:"fee_attribution,omitempty"`
+}
+
+func (x *OpenRecommendationsRequest) Reset() {
+	*x = OpenRecommendationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenRecommendationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenRecommendationsRequest) ProtoMessage() {}
+
+func (x *OpenRecommendationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenRecommendationsRequest.ProtoReflect.Descriptor instead.
+func (*OpenRecommendationsRequest) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *OpenRecommendationsRequest) GetStartTime() uint64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *OpenRecommendationsRequest) GetEndTime() uint64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *OpenRecommendationsRequest) GetConfTarget() int32 {
+	if x != nil {
+		return x.ConfTarget
+	}
+	return 0
+}
+
+func (x *OpenRecommendationsRequest) GetLiquidityDays() float32 {
+	if x != nil {
+		return x.LiquidityDays
+	}
+	return 0
+}
+
+func (x *OpenRecommendationsRequest) GetMinChannelSizeSat() int64 {
+	if x != nil {
+		return x.MinChannelSizeSat
+	}
+	return 0
+}
+
+func (x *OpenRecommendationsRequest) GetMaxChannelSizeSat() int64 {
+	if x != nil {
+		return x.MaxChannelSizeSat
+	}
+	return 0
+}
+
+func (x *OpenRecommendationsRequest) GetFeeAttribution() FeeAttribution {
+	if x != nil {
+		return x.FeeAttribution
+	}
+	return FeeAttribution_FULL_FEE
+}
+
+type OpenRecommendationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The fee rate, in sat/kw, that opening costs were estimated with.
+	FeeRateSatPerKw uint64 `protobuf:"varint,1,opt,name=fee_rate_sat_per_kw,json=feeRateSatPerKw,proto3" json:"fee_rate_sat_per_kw,omitempty"`
+	// Open recommendations, ordered by payback period.
+	Recommendations []*OpenRecommendation `protobuf:"bytes,2,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+}
+
+func (x *OpenRecommendationsResponse) Reset() {
+	*x = OpenRecommendationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenRecommendationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenRecommendationsResponse) ProtoMessage() {}
+
+func (x *OpenRecommendationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenRecommendationsResponse.ProtoReflect.Descriptor instead.
+func (*OpenRecommendationsResponse) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *OpenRecommendationsResponse) GetFeeRateSatPerKw() uint64 {
+	if x != nil {
+		return x.FeeRateSatPerKw
+	}
+	return 0
+}
+
+func (x *OpenRecommendationsResponse) GetRecommendations() []*OpenRecommendation {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+type OpenRecommendation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hex encoded public key of the peer.
+	Pubkey string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// The number of channels we currently have open with the peer.
+	Channels uint32 `protobuf:"varint,2,opt,name=channels,proto3" json:"channels,omitempty"`
+	// The total capacity, in satoshis, of our channels with the peer.
+	CapacitySat int64 `protobuf:"varint,3,opt,name=capacity_sat,json=capacitySat,proto3" json:"capacity_sat,omitempty"`
+	// Our total balance, in satoshis, in channels with the peer.
+	LocalBalanceSat int64 `protobuf:"varint,4,opt,name=local_balance_sat,json=localBalanceSat,proto3" json:"local_balance_sat,omitempty"`
+	// The fees, in millisatoshis, that our channels with the peer earned.
+	FeesEarnedMsat uint64 `protobuf:"varint,5,opt,name=fees_earned_msat,json=feesEarnedMsat,proto3" json:"fees_earned_msat,omitempty"`
+	// The amount, in millisatoshis, that we forwarded out to the peer.
+	OutgoingVolumeMsat uint64 `protobuf:"varint,6,opt,name=outgoing_volume_msat,json=outgoingVolumeMsat,proto3" json:"outgoing_volume_msat,omitempty"`
+	// The amount, in millisatoshis, that we failed to forward out to the peer
+	// because we had insufficient balance.
+	MissedVolumeMsat uint64 `protobuf:"varint,7,opt,name=missed_volume_msat,json=missedVolumeMsat,proto3" json:"missed_volume_msat,omitempty"`
+	// The fees, in millisatoshis, that we missed for failed forwards.
+	MissedFeesMsat uint64 `protobuf:"varint,8,opt,name=missed_fees_msat,json=missedFeesMsat,proto3" json:"missed_fees_msat,omitempty"`
+	// The fees earned and missed per day, as a fraction of the capacity that
+	// we have with the peer.
+	DailyYield float64 `protobuf:"fixed64,9,opt,name=daily_yield,json=dailyYield,proto3" json:"daily_yield,omitempty"`
+	// The size, in satoshis, of the channel we suggest opening.
+	SuggestedSizeSat int64 `protobuf:"varint,10,opt,name=suggested_size_sat,json=suggestedSizeSat,proto3" json:"suggested_size_sat,omitempty"`
+	// The fees, in millisatoshis, that we expect the new channel to earn per
+	// day if it earns the same yield as our existing channels with the peer.
+	ExpectedDailyFeesMsat uint64 `protobuf:"varint,11,opt,name=expected_daily_fees_msat,json=expectedDailyFeesMsat,proto3" json:"expected_daily_fees_msat,omitempty"`
+	// The estimated on chain fee, in satoshis, to open the channel.
+	OpenCostSat int64 `protobuf:"varint,12,opt,name=open_cost_sat,json=openCostSat,proto3" json:"open_cost_sat,omitempty"`
+	// The number of days that the channel is expected to take to earn back
+	// its opening fee. This value is infinite if the channel is not expected
+	// to earn any fees.
+	PaybackDays float64 `protobuf:"fixed64,13,opt,name=payback_days,json=paybackDays,proto3" json:"payback_days,omitempty"`
+}
+
+func (x *OpenRecommendation) Reset() {
+	*x = OpenRecommendation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenRecommendation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenRecommendation) ProtoMessage() {}
+
+func (x *OpenRecommendation) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenRecommendation.ProtoReflect.Descriptor instead.
+func (*OpenRecommendation) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *OpenRecommendation) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *OpenRecommendation) GetChannels() uint32 {
+	if x != nil {
+		return x.Channels
+	}
+	return 0
+}
+
+func (x *OpenRecommendation) GetCapacitySat() int64 {
+	if x != nil {
+		return x.CapacitySat
+	}
+	return 0
+}
+
+func (x *OpenRecommendation) GetLocalBalanceSat() int64 {
+	if x != nil {
+		return x.LocalBalanceSat
+	}
+	return 0
+}
+
+func (x *OpenRecommendation) GetFeesEarnedMsat() uint64 {
+	if x != nil {
+		return x.FeesEarnedMsat
+	}
+	return 0
+}
+
+func (x *OpenRecommendation) GetOutgoingVolumeMsat() uint64 {
+	if x != nil {
+		return x.OutgoingVolumeMsat
+	}
+	return 0
+}
+
+func (x *OpenRecommendation) GetMissedVolumeMsat() uint64 {
+	if x != nil {
+		return x.MissedVolumeMsat
+	}
+	return 0
+}
+
+func (x *OpenRecommendation) GetMissedFeesMsat() uint64 {
+	if x != nil {
+		return x.MissedFeesMsat
+	}
+	return 0
+}
+
+func (x *OpenRecommendation) GetDailyYield() float64 {
+	if x != nil {
+		return x.DailyYield
+	}
+	return 0
+}
+
+func (x *OpenRecommendation) GetSuggestedSizeSat() int64 {
+	if x != nil {
+		return x.SuggestedSizeSat
+	}
+	return 0
+}
+
+func (x *OpenRecommendation) GetExpectedDailyFeesMsat() uint64 {
+	if x != nil {
+		return x.ExpectedDailyFeesMsat
+	}
+	return 0
+}
+
+func (x *OpenRecommendation) GetOpenCostSat() int64 {
+	if x != nil {
+		return x.OpenCostSat
+	}
+	return 0
+}
+
+func (x *OpenRecommendation) GetPaybackDays() float64 {
+	if x != nil {
+		return x.PaybackDays
+	}
+	return 0
+}
+
+type PolicyHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The channel points of the channels to report on. If this value is not
+	// set, all channels with recorded policies are included.
+	ChanPoints []string `protobuf:"bytes,1,rep,name=chan_points,json=chanPoints,proto3" json:"chan_points,omitempty"`
+	// Start time is beginning of the range over which the report will be
+	// generated, expressed as unix epoch offset in seconds.
+	StartTime uint64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// End time is end of the range over which the report will be generated,
+	// expressed as unix epoch offset in seconds. If this value is not set, it
+	// defaults to the present.
+	EndTime uint64 `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *PolicyHistoryRequest) Reset() {
+	*x = PolicyHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PolicyHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyHistoryRequest) ProtoMessage() {}
+
+func (x *PolicyHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyHistoryRequest.ProtoReflect.Descriptor instead.
+func (*PolicyHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PolicyHistoryRequest) GetChanPoints() []string {
+	if x != nil {
+		return x.ChanPoints
+	}
+	return nil
+}
+
+func (x *PolicyHistoryRequest) GetStartTime() uint64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *PolicyHistoryRequest) GetEndTime() uint64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+type PolicyHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The policy history for each channel, ordered by channel point.
+	Channels []*ChannelPolicyHistory `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+}
+
+func (x *PolicyHistoryResponse) Reset() {
+	*x = PolicyHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PolicyHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyHistoryResponse) ProtoMessage() {}
+
+func (x *PolicyHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyHistoryResponse.ProtoReflect.Descriptor instead.
+func (*PolicyHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PolicyHistoryResponse) GetChannels() []*ChannelPolicyHistory {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+type ChannelPolicyHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The outpoint of the channel.
+	ChanPoint string `protobuf:"bytes,1,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
+	// The policies that the channel had over the period, ordered by time.
+	Periods []*PolicyPeriod `protobuf:"bytes,2,rep,name=periods,proto3" json:"periods,omitempty"`
+	// The estimated fee elasticity of the channel: the average percentage
+	// change in its daily volume for each percentage change in its fee rate.
+	// Negative values indicate that the channel's volume fell when its fee
+	// rate was raised. This value is only set if elasticity_points is
+	// non-zero.
+	Elasticity float64 `protobuf:"fixed64,3,opt,name=elasticity,proto3" json:"elasticity,omitempty"`
+	// The number of fee rate changes that the elasticity is based on.
+	ElasticityPoints uint32 `protobuf:"varint,4,opt,name=elasticity_points,json=elasticityPoints,proto3" json:"elasticity_points,omitempty"`
+}
+
+func (x *ChannelPolicyHistory) Reset() {
+	*x = ChannelPolicyHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelPolicyHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelPolicyHistory) ProtoMessage() {}
+
+func (x *ChannelPolicyHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelPolicyHistory.ProtoReflect.Descriptor instead.
+func (*ChannelPolicyHistory) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ChannelPolicyHistory) GetChanPoint() string {
+	if x != nil {
+		return x.ChanPoint
+	}
+	return ""
+}
+
+func (x *ChannelPolicyHistory) GetPeriods() []*PolicyPeriod {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+func (x *ChannelPolicyHistory) GetElasticity() float64 {
+	if x != nil {
+		return x.Elasticity
+	}
+	return 0
+}
+
+func (x *ChannelPolicyHistory) GetElasticityPoints() uint32 {
+	if x != nil {
+		return x.ElasticityPoints
+	}
+	return 0
+}
+
+type PolicyPeriod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The time that the policy took effect, or the start of the report if it
+	// took effect earlier, expressed as unix epoch offset in seconds.
+	StartTime uint64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The time that the policy was replaced, or the end of the report if it
+	// was still in effect, expressed as unix epoch offset in seconds.
+	EndTime uint64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// The base fee in millisatoshis that we charged for outgoing forwards.
+	FeeBaseMsat uint64 `protobuf:"varint,3,opt,name=fee_base_msat,json=feeBaseMsat,proto3" json:"fee_base_msat,omitempty"`
+	// The fee rate in parts per million charged for outgoing forwards.
+	FeeRatePpm int64 `protobuf:"varint,4,opt,name=fee_rate_ppm,json=feeRatePpm,proto3" json:"fee_rate_ppm,omitempty"`
+	// The base fee in millisatoshis that we charged for incoming forwards.
+	// Negative values are inbound discounts.
+	InboundFeeBaseMsat int64 `protobuf:"varint,5,opt,name=inbound_fee_base_msat,json=inboundFeeBaseMsat,proto3" json:"inbound_fee_base_msat,omitempty"`
+	// The fee rate in parts per million that we charged for incoming
+	// forwards. Negative values are inbound discounts.
+	InboundFeeRatePpm int64 `protobuf:"varint,6,opt,name=inbound_fee_rate_ppm,json=inboundFeeRatePpm,proto3" json:"inbound_fee_rate_ppm,omitempty"`
+	// The time lock delta that we required for forwards.
+	TimeLockDelta uint32 `protobuf:"varint,7,opt,name=time_lock_delta,json=timeLockDelta,proto3" json:"time_lock_delta,omitempty"`
+	// The minimum htlc size in millisatoshis that we would forward.
+	MinHtlcMsat uint64 `protobuf:"varint,8,opt,name=min_htlc_msat,json=minHtlcMsat,proto3" json:"min_htlc_msat,omitempty"`
+	// The maximum htlc size in millisatoshis that we would forward.
+	MaxHtlcMsat uint64 `protobuf:"varint,9,opt,name=max_htlc_msat,json=maxHtlcMsat,proto3" json:"max_htlc_msat,omitempty"`
+	// Whether forwarding over the channel was disabled.
+	Disabled bool `protobuf:"varint,10,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// The number of forwards that left our node on the channel.
+	ForwardCount uint64 `protobuf:"varint,11,opt,name=forward_count,json=forwardCount,proto3" json:"forward_count,omitempty"`
+	// The total amount in millisatoshis forwarded out over the channel.
+	VolumeMsat uint64 `protobuf:"varint,12,opt,name=volume_msat,json=volumeMsat,proto3" json:"volume_msat,omitempty"`
+	// The total fees in millisatoshis earned by forwards over the channel.
+	FeesMsat uint64 `protobuf:"varint,13,opt,name=fees_msat,json=feesMsat,proto3" json:"fees_msat,omitempty"`
+	// The average amount in millisatoshis forwarded per day.
+	VolumePerDayMsat float64 `protobuf:"fixed64,14,opt,name=volume_per_day_msat,json=volumePerDayMsat,proto3" json:"volume_per_day_msat,omitempty"`
+}
+
+func (x *PolicyPeriod) Reset() {
+	*x = PolicyPeriod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PolicyPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyPeriod) ProtoMessage() {}
+
+func (x *PolicyPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyPeriod.ProtoReflect.Descriptor instead.
+func (*PolicyPeriod) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PolicyPeriod) GetStartTime() uint64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *PolicyPeriod) GetEndTime() uint64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *PolicyPeriod) GetFeeBaseMsat() uint64 {
+	if x != nil {
+		return x.FeeBaseMsat
+	}
+	return 0
+}
+
+func (x *PolicyPeriod) GetFeeRatePpm() int64 {
+	if x != nil {
+		return x.FeeRatePpm
+	}
+	return 0
+}
+
+func (x *PolicyPeriod) GetInboundFeeBaseMsat() int64 {
+	if x != nil {
+		return x.InboundFeeBaseMsat
+	}
+	return 0
+}
+
+func (x *PolicyPeriod) GetInboundFeeRatePpm() int64 {
+	if x != nil {
+		return x.InboundFeeRatePpm
+	}
+	return 0
+}
+
+func (x *PolicyPeriod) GetTimeLockDelta() uint32 {
+	if x != nil {
+		return x.TimeLockDelta
+	}
+	return 0
+}
+
+func (x *PolicyPeriod) GetMinHtlcMsat() uint64 {
+	if x != nil {
+		return x.MinHtlcMsat
+	}
+	return 0
+}
+
+func (x *PolicyPeriod) GetMaxHtlcMsat() uint64 {
+	if x != nil {
+		return x.MaxHtlcMsat
+	}
+	return 0
+}
+
+func (x *PolicyPeriod) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *PolicyPeriod) GetForwardCount() uint64 {
+	if x != nil {
+		return x.ForwardCount
+	}
+	return 0
+}
+
+func (x *PolicyPeriod) GetVolumeMsat() uint64 {
+	if x != nil {
+		return x.VolumeMsat
+	}
+	return 0
+}
+
+func (x *PolicyPeriod) GetFeesMsat() uint64 {
+	if x != nil {
+		return x.FeesMsat
+	}
+	return 0
+}
+
+func (x *PolicyPeriod) GetVolumePerDayMsat() float64 {
+	if x != nil {
+		return x.VolumePerDayMsat
+	}
+	return 0
+}
+
+type BalanceSheetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unix time at which to produce the balance sheet. If this value is
+	// not set, it defaults to the present.
+	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Set to produce a balance sheet without conversion to fiat. If set, fiat
+	// values will display as 0.
+	DisableFiat bool `protobuf:"varint,2,opt,name=disable_fiat,json=disableFiat,proto3" json:"disable_fiat,omitempty"`
+	// The level of granularity at which we wish to produce fiat prices.
+	Granularity Granularity `protobuf:"varint,3,opt,name=granularity,proto3,enum=frdrpc.Granularity" json:"granularity,omitempty"`
+	// The api to be used for fiat related queries.
+	FiatBackend FiatBackend `protobuf:"varint,4,opt,name=fiat_backend,json=fiatBackend,proto3,enum=frdrpc.FiatBackend" json:"fiat_backend,omitempty"`
+	// Custom price points to use if the CUSTOM FiatBackend option is set.
+	CustomPrices []*BitcoinPrice `protobuf:"bytes,5,rep,name=custom_prices,json=customPrices,proto3" json:"custom_prices,omitempty"`
+}
+
+func (x *BalanceSheetRequest) Reset() {
+	*x = BalanceSheetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BalanceSheetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceSheetRequest) ProtoMessage() {}
+
+func (x *BalanceSheetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceSheetRequest.ProtoReflect.Descriptor instead.
+func (*BalanceSheetRequest) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *BalanceSheetRequest) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *BalanceSheetRequest) GetDisableFiat() bool {
+	if x != nil {
+		return x.DisableFiat
+	}
+	return false
+}
+
+func (x *BalanceSheetRequest) GetGranularity() Granularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return Granularity_UNKNOWN_GRANULARITY
+}
+
+func (x *BalanceSheetRequest) GetFiatBackend() FiatBackend {
+	if x != nil {
+		return x.FiatBackend
+	}
+	return FiatBackend_UNKNOWN_FIATBACKEND
+}
+
+func (x *BalanceSheetRequest) GetCustomPrices() []*BitcoinPrice {
+	if x != nil {
+		return x.CustomPrices
+	}
+	return nil
+}
+
+type BalanceSheetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unix time that the balance sheet was produced for.
+	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Our confirmed on chain wallet balance.
+	OnChain *Balance `protobuf:"bytes,2,opt,name=on_chain,json=onChain,proto3" json:"on_chain,omitempty"`
+	// Our local balance in open and pending open channels.
+	Channels *Balance `protobuf:"bytes,3,opt,name=channels,proto3" json:"channels,omitempty"`
+	// Our balance in channels that are waiting for their close transaction to
+	// confirm, and in force closed channels that have not yet been fully
+	// resolved on chain.
+	PendingClose *Balance `protobuf:"bytes,4,opt,name=pending_close,json=pendingClose,proto3" json:"pending_close,omitempty"`
+	// The value of outgoing htlcs that are in flight in our open channels.
+	UnsettledHtlcs *Balance `protobuf:"bytes,5,opt,name=unsettled_htlcs,json=unsettledHtlcs,proto3" json:"unsettled_htlcs,omitempty"`
+	// The sum of all of our balances.
+	Total *Balance `protobuf:"bytes,6,opt,name=total,proto3" json:"total,omitempty"`
+	// The bitcoin price and timestamp used to calculate our fiat values.
+	BtcPrice *BitcoinPrice `protobuf:"bytes,7,opt,name=btc_price,json=btcPrice,proto3" json:"btc_price,omitempty"`
+}
+
+func (x *BalanceSheetResponse) Reset() {
+	*x = BalanceSheetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BalanceSheetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceSheetResponse) ProtoMessage() {}
+
+func (x *BalanceSheetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceSheetResponse.ProtoReflect.Descriptor instead.
+func (*BalanceSheetResponse) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *BalanceSheetResponse) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *BalanceSheetResponse) GetOnChain() *Balance {
+	if x != nil {
+		return x.OnChain
+	}
+	return nil
+}
+
+func (x *BalanceSheetResponse) GetChannels() *Balance {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *BalanceSheetResponse) GetPendingClose() *Balance {
+	if x != nil {
+		return x.PendingClose
+	}
+	return nil
+}
+
+func (x *BalanceSheetResponse) GetUnsettledHtlcs() *Balance {
+	if x != nil {
+		return x.UnsettledHtlcs
+	}
+	return nil
+}
+
+func (x *BalanceSheetResponse) GetTotal() *Balance {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *BalanceSheetResponse) GetBtcPrice() *BitcoinPrice {
+	if x != nil {
+		return x.BtcPrice
+	}
+	return nil
+}
+
+type Balance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The balance in millisatoshis. Reconstructed balances may be negative if
+	// lnd's records between the time requested and the present are
+	// incomplete.
+	AmountMsat int64 `protobuf:"varint,1,opt,name=amount_msat,json=amountMsat,proto3" json:"amount_msat,omitempty"`
+	// The balance in bitcoin.
+	Btc string `protobuf:"bytes,2,opt,name=btc,proto3" json:"btc,omitempty"`
+	// The fiat value of the balance in the currency specified in the
+	// btc_price field.
+	Fiat string `protobuf:"bytes,3,opt,name=fiat,proto3" json:"fiat,omitempty"`
+}
+
+func (x *Balance) Reset() {
+	*x = Balance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Balance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Balance) ProtoMessage() {}
+
+func (x *Balance) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Balance.ProtoReflect.Descriptor instead.
+func (*Balance) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Balance) GetAmountMsat() int64 {
+	if x != nil {
+		return x.AmountMsat
+	}
+	return 0
+}
+
+func (x *Balance) GetBtc() string {
+	if x != nil {
+		return x.Btc
+	}
+	return ""
+}
+
+func (x *Balance) GetFiat() string {
+	if x != nil {
+		return x.Fiat
+	}
+	return ""
+}
+
+type AuditSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Forwarding fees and receipts.
+	Income *SummarySection `protobuf:"bytes,1,opt,name=income,proto3" json:"income,omitempty"`
+	// Payments and the off chain and on chain fees we paid.
+	Expenses *SummarySection `protobuf:"bytes,2,opt,name=expenses,proto3" json:"expenses,omitempty"`
+	// Entries that moved funds between our own balances, such as channel opens,
+	// closes, sweeps and circular payments, which do not affect our earnings.
+	Transfers *SummarySection `protobuf:"bytes,3,opt,name=transfers,proto3" json:"transfers,omitempty"`
+	// Our net earnings in msat, which is our income less our expenses.
+	NetAmountMsat int64 `protobuf:"varint,4,opt,name=net_amount_msat,json=netAmountMsat,proto3" json:"net_amount_msat,omitempty"`
+	// The fiat value of our net earnings.
+	NetFiat string `protobuf:"bytes,5,opt,name=net_fiat,json=netFiat,proto3" json:"net_fiat,omitempty"`
+	// The currency that fiat values are expressed in, empty if fiat values were
+	// not requested.
+	Currency string `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+}
+
+func (x *AuditSummary) Reset() {
+	*x = AuditSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditSummary) ProtoMessage() {}
+
+func (x *AuditSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditSummary.ProtoReflect.Descriptor instead.
+func (*AuditSummary) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AuditSummary) GetIncome() *SummarySection {
+	if x != nil {
+		return x.Income
+	}
+	return nil
+}
+
+func (x *AuditSummary) GetExpenses() *SummarySection {
+	if x != nil {
+		return x.Expenses
+	}
+	return nil
+}
+
+func (x *AuditSummary) GetTransfers() *SummarySection {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+func (x *AuditSummary) GetNetAmountMsat() int64 {
+	if x != nil {
+		return x.NetAmountMsat
+	}
+	return 0
+}
+
+func (x *AuditSummary) GetNetFiat() string {
+	if x != nil {
+		return x.NetFiat
+	}
+	return ""
+}
+
+func (x *AuditSummary) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type SummarySection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The lines in the section, sorted by type, category and then chain.
+	Lines []*SummaryLine `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	// The total number of entries in the section.
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// The net amount of the section in msat. Credits are positive and debits
+	// are negative.
+	AmountMsat int64 `protobuf:"varint,3,opt,name=amount_msat,json=amountMsat,proto3" json:"amount_msat,omitempty"`
+	// The net fiat value of the section.
+	Fiat string `protobuf:"bytes,4,opt,name=fiat,proto3" json:"fiat,omitempty"`
+}
+
+func (x *SummarySection) Reset() {
+	*x = SummarySection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SummarySection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SummarySection) ProtoMessage() {}
+
+func (x *SummarySection) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SummarySection.ProtoReflect.Descriptor instead.
+func (*SummarySection) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SummarySection) GetLines() []*SummaryLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *SummarySection) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *SummarySection) GetAmountMsat() int64 {
+	if x != nil {
+		return x.AmountMsat
+	}
+	return 0
+}
+
+func (x *SummarySection) GetFiat() string {
+	if x != nil {
+		return x.Fiat
+	}
+	return ""
+}
+
+type SummaryLine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The type of the entries aggregated.
+	Type EntryType `protobuf:"varint,1,opt,name=type,proto3,enum=frdrpc.EntryType" json:"type,omitempty"`
+	// The custom category of the entries aggregated, if any.
+	CustomCategory string `protobuf:"bytes,2,opt,name=custom_category,json=customCategory,proto3" json:"custom_category,omitempty"`
+	// Whether the entries aggregated occurred on chain.
+	OnChain bool `protobuf:"varint,3,opt,name=on_chain,json=onChain,proto3" json:"on_chain,omitempty"`
+	// The number of entries aggregated.
+	Count uint64 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	// The net amount of the entries in msat. Credits are positive and debits
+	// are negative.
+	AmountMsat int64 `protobuf:"varint,5,opt,name=amount_msat,json=amountMsat,proto3" json:"amount_msat,omitempty"`
+	// The net fiat value of the entries.
+	Fiat string `protobuf:"bytes,6,opt,name=fiat,proto3" json:"fiat,omitempty"`
+}
+
+func (x *SummaryLine) Reset() {
+	*x = SummaryLine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SummaryLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SummaryLine) ProtoMessage() {}
+
+func (x *SummaryLine) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SummaryLine.ProtoReflect.Descriptor instead.
+func (*SummaryLine) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SummaryLine) GetType() EntryType {
+	if x != nil {
+		return x.Type
+	}
+	return EntryType_UNKNOWN
+}
+
+func (x *SummaryLine) GetCustomCategory() string {
+	if x != nil {
+		return x.CustomCategory
+	}
+	return ""
+}
+
+func (x *SummaryLine) GetOnChain() bool {
+	if x != nil {
+		return x.OnChain
+	}
+	return false
+}
+
+func (x *SummaryLine) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *SummaryLine) GetAmountMsat() int64 {
+	if x != nil {
+		return x.AmountMsat
+	}
+	return 0
+}
+
+func (x *SummaryLine) GetFiat() string {
+	if x != nil {
+		return x.Fiat
+	}
+	return ""
+}
+
+type ClosePeriodRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unix time from which to close the period, inclusive.
+	StartTime uint64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The unix time until which to close the period, exclusive. This time may
+	// not be in the future.
+	EndTime uint64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Set to close the period without conversion to fiat. If set, fiat values
+	// will be frozen as 0.
+	DisableFiat bool `protobuf:"varint,3,opt,name=disable_fiat,json=disableFiat,proto3" json:"disable_fiat,omitempty"`
+	// The level of granularity at which we wish to produce fiat prices.
+	Granularity Granularity `protobuf:"varint,4,opt,name=granularity,proto3,enum=frdrpc.Granularity" json:"granularity,omitempty"`
+	// An optional set of custom categories which will be frozen in the period's
+	// entries.
+	CustomCategories []*CustomCategory `protobuf:"bytes,5,rep,name=custom_categories,json=customCategories,proto3" json:"custom_categories,omitempty"`
+	// The api to be used for fiat related queries.
+	FiatBackend FiatBackend `protobuf:"varint,6,opt,name=fiat_backend,json=fiatBackend,proto3,enum=frdrpc.FiatBackend" json:"fiat_backend,omitempty"`
+	// Custom price points to use if the CUSTOM FiatBackend option is set.
+	CustomPrices []*BitcoinPrice `protobuf:"bytes,7,rep,name=custom_prices,json=customPrices,proto3" json:"custom_prices,omitempty"`
+}
+
+func (x *ClosePeriodRequest) Reset() {
+	*x = ClosePeriodRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClosePeriodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosePeriodRequest) ProtoMessage() {}
+
+func (x *ClosePeriodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosePeriodRequest.ProtoReflect.Descriptor instead.
+func (*ClosePeriodRequest) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ClosePeriodRequest) GetStartTime() uint64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *ClosePeriodRequest) GetEndTime() uint64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *ClosePeriodRequest) GetDisableFiat() bool {
+	if x != nil {
+		return x.DisableFiat
+	}
+	return false
+}
+
+func (x *ClosePeriodRequest) GetGranularity() Granularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return Granularity_UNKNOWN_GRANULARITY
+}
+
+func (x *ClosePeriodRequest) GetCustomCategories() []*CustomCategory {
+	if x != nil {
+		return x.CustomCategories
+	}
+	return nil
+}
+
+func (x *ClosePeriodRequest) GetFiatBackend() FiatBackend {
+	if x != nil {
+		return x.FiatBackend
+	}
+	return FiatBackend_UNKNOWN_FIATBACKEND
+}
+
+func (x *ClosePeriodRequest) GetCustomPrices() []*BitcoinPrice {
+	if x != nil {
+		return x.CustomPrices
+	}
+	return nil
+}
+
+type ClosePeriodResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The period that was closed.
+	Period *ClosedPeriod `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+}
+
+func (x *ClosePeriodResponse) Reset() {
+	*x = ClosePeriodResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClosePeriodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosePeriodResponse) ProtoMessage() {}
+
+func (x *ClosePeriodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosePeriodResponse.ProtoReflect.Descriptor instead.
+func (*ClosePeriodResponse) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ClosePeriodResponse) GetPeriod() *ClosedPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+type ListClosedPeriodsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListClosedPeriodsRequest) Reset() {
+	*x = ListClosedPeriodsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListClosedPeriodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClosedPeriodsRequest) ProtoMessage() {}
+
+func (x *ListClosedPeriodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClosedPeriodsRequest.ProtoReflect.Descriptor instead.
+func (*ListClosedPeriodsRequest) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{52}
+}
+
+type ListClosedPeriodsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The periods that have been closed, sorted by start time.
+	Periods []*ClosedPeriod `protobuf:"bytes,1,rep,name=periods,proto3" json:"periods,omitempty"`
+}
+
+func (x *ListClosedPeriodsResponse) Reset() {
+	*x = ListClosedPeriodsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListClosedPeriodsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClosedPeriodsResponse) ProtoMessage() {}
+
+func (x *ListClosedPeriodsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClosedPeriodsResponse.ProtoReflect.Descriptor instead.
+func (*ListClosedPeriodsResponse) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListClosedPeriodsResponse) GetPeriods() []*ClosedPeriod {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+type ClosedPeriod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unix time from which the period was closed, inclusive.
+	StartTime uint64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The unix time until which the period was closed, exclusive.
+	EndTime uint64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// The unix time at which the period was closed.
+	ClosedAt uint64 `protobuf:"varint,3,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	// The version of faraday that produced the period's entries.
+	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	// The number of entries frozen in the period.
+	EntryCount uint64 `protobuf:"varint,5,opt,name=entry_count,json=entryCount,proto3" json:"entry_count,omitempty"`
+	// The bitcoin prices used to value the period's entries.
+	Prices []*BitcoinPrice `protobuf:"bytes,6,rep,name=prices,proto3" json:"prices,omitempty"`
+	// The hex encoded sha256 digest of the canonical encoding of the period's
+	// snapshot.
+	Digest string `protobuf:"bytes,7,opt,name=digest,proto3" json:"digest,omitempty"`
+	// Our node's signature over the digest's raw bytes, produced by lnd's
+	// SignMessage, which can be checked with lnd's VerifyMessage.
+	Signature string `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
+	// The version of the canonical encoding that the digest was calculated
+	// over.
+	EncodingVersion uint32 `protobuf:"varint,9,opt,name=encoding_version,json=encodingVersion,proto3" json:"encoding_version,omitempty"`
+}
+
+func (x *ClosedPeriod) Reset() {
+	*x = ClosedPeriod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClosedPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosedPeriod) ProtoMessage() {}
+
+func (x *ClosedPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosedPeriod.ProtoReflect.Descriptor instead.
+func (*ClosedPeriod) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ClosedPeriod) GetStartTime() uint64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *ClosedPeriod) GetEndTime() uint64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *ClosedPeriod) GetClosedAt() uint64 {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return 0
+}
+
+func (x *ClosedPeriod) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ClosedPeriod) GetEntryCount() uint64 {
+	if x != nil {
+		return x.EntryCount
+	}
+	return 0
+}
+
+func (x *ClosedPeriod) GetPrices() []*BitcoinPrice {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *ClosedPeriod) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+func (x *ClosedPeriod) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *ClosedPeriod) GetEncodingVersion() uint32 {
+	if x != nil {
+		return x.EncodingVersion
+	}
+	return 0
+}
+
+type PeriodDrift struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The closed period.
+	Period *ClosedPeriod `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	// The differences between the period's frozen entries and live data, for
+	// the portion of the period covered by the report.
+	Drift []*EntryDrift `protobuf:"bytes,2,rep,name=drift,proto3" json:"drift,omitempty"`
+}
+
+func (x *PeriodDrift) Reset() {
+	*x = PeriodDrift{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeriodDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeriodDrift) ProtoMessage() {}
+
+func (x *PeriodDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeriodDrift.ProtoReflect.Descriptor instead.
+func (*PeriodDrift) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *PeriodDrift) GetPeriod() *ClosedPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+func (x *PeriodDrift) GetDrift() []*EntryDrift {
+	if x != nil {
+		return x.Drift
+	}
+	return nil
+}
+
+type EntryDrift struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The type of difference.
+	Type DriftType `protobuf:"varint,1,opt,name=type,proto3,enum=frdrpc.DriftType" json:"type,omitempty"`
+	// The closed period's entry, unset for added entries.
+	Frozen *ReportEntry `protobuf:"bytes,2,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	// The live entry, unset for removed entries.
+	Live *ReportEntry `protobuf:"bytes,3,opt,name=live,proto3" json:"live,omitempty"`
+}
+
+func (x *EntryDrift) Reset() {
+	*x = EntryDrift{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntryDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntryDrift) ProtoMessage() {}
+
+func (x *EntryDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntryDrift.ProtoReflect.Descriptor instead.
+func (*EntryDrift) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *EntryDrift) GetType() DriftType {
+	if x != nil {
+		return x.Type
+	}
+	return DriftType_ADDED
+}
+
+func (x *EntryDrift) GetFrozen() *ReportEntry {
+	if x != nil {
+		return x.Frozen
+	}
+	return nil
+}
+
+func (x *EntryDrift) GetLive() *ReportEntry {
+	if x != nil {
+		return x.Live
+	}
+	return nil
+}
+
+type ReportSignature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hex encoded sha256 digest of the report's canonical encoding,
+	// calculated with this signature unset. The canonical encoding is defined
+	// in docs/accounting.md, and does not depend on the protobuf library used.
+	Digest string `protobuf:"bytes,1,opt,name=digest,proto3" json:"digest,omitempty"`
+	// The zbase32 encoded signature over the digest's raw bytes, produced by
+	// lnd's SignMessage.
+	Signature string `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	// The hex encoded public key of the node that signed the report.
+	Pubkey string `protobuf:"bytes,3,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// The version of the canonical encoding that the digest was calculated
+	// over.
+	EncodingVersion uint32 `protobuf:"varint,4,opt,name=encoding_version,json=encodingVersion,proto3" json:"encoding_version,omitempty"`
+}
+
+func (x *ReportSignature) Reset() {
+	*x = ReportSignature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportSignature) ProtoMessage() {}
+
+func (x *ReportSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportSignature.ProtoReflect.Descriptor instead.
+func (*ReportSignature) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ReportSignature) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+func (x *ReportSignature) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *ReportSignature) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *ReportSignature) GetEncodingVersion() uint32 {
+	if x != nil {
+		return x.EncodingVersion
+	}
+	return 0
+}
+
+type ExportAuditDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unix time from which to export data, inclusive.
+	StartTime uint64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The unix time until which to export data, exclusive. If this field is not
+	// set, data will be exported until the present.
+	EndTime uint64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *ExportAuditDataRequest) Reset() {
+	*x = ExportAuditDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportAuditDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAuditDataRequest) ProtoMessage() {}
+
+func (x *ExportAuditDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAuditDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportAuditDataRequest) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ExportAuditDataRequest) GetStartTime() uint64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *ExportAuditDataRequest) GetEndTime() uint64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+type ExportAuditDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The json encoded export, which can be used to produce audits for any
+	// period within the range exported.
+	Export []byte `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
+}
+
+func (x *ExportAuditDataResponse) Reset() {
+	*x = ExportAuditDataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportAuditDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAuditDataResponse) ProtoMessage() {}
+
+func (x *ExportAuditDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAuditDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportAuditDataResponse) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ExportAuditDataResponse) GetExport() []byte {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+type BakeMacaroonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The permission entities that the macaroon grants access to, at least one
+	// of audit, report, insights, rates, recommendation and macaroon must be
+	// provided.
+	Entities []string `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
+	// Set to only grant read access to the entities requested.
+	ReadOnly bool `protobuf:"varint,2,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	// The root key ID used to create the macaroon, defaults to 0.
+	RootKeyId uint64 `protobuf:"varint,3,opt,name=root_key_id,json=rootKeyId,proto3" json:"root_key_id,omitempty"`
+	// The unix time from which the macaroon may request audits, inclusive. If
+	// either audit_start_time or audit_end_time are set, audit requests must
+	// fall within the range set.
+	AuditStartTime uint64 `protobuf:"varint,4,opt,name=audit_start_time,json=auditStartTime,proto3" json:"audit_start_time,omitempty"`
+	// The unix time until which the macaroon may request audits, exclusive. If
+	// this field is not set, but audit_start_time is, audits may be requested
+	// until the present.
+	AuditEndTime uint64 `protobuf:"varint,5,opt,name=audit_end_time,json=auditEndTime,proto3" json:"audit_end_time,omitempty"`
+	// Set to strip fiat values from the responses of the macaroon's requests,
+	// and to deny the macaroon access to exchange rates.
+	StripFiat bool `protobuf:"varint,6,opt,name=strip_fiat,json=stripFiat,proto3" json:"strip_fiat,omitempty"`
+}
+
+func (x *BakeMacaroonRequest) Reset() {
+	*x = BakeMacaroonRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BakeMacaroonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BakeMacaroonRequest) ProtoMessage() {}
+
+func (x *BakeMacaroonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BakeMacaroonRequest.ProtoReflect.Descriptor instead.
+func (*BakeMacaroonRequest) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *BakeMacaroonRequest) GetEntities() []string {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *BakeMacaroonRequest) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+func (x *BakeMacaroonRequest) GetRootKeyId() uint64 {
+	if x != nil {
+		return x.RootKeyId
+	}
+	return 0
+}
+
+func (x *BakeMacaroonRequest) GetAuditStartTime() uint64 {
+	if x != nil {
+		return x.AuditStartTime
+	}
+	return 0
+}
+
+func (x *BakeMacaroonRequest) GetAuditEndTime() uint64 {
+	if x != nil {
+		return x.AuditEndTime
+	}
+	return 0
+}
+
+func (x *BakeMacaroonRequest) GetStripFiat() bool {
+	if x != nil {
+		return x.StripFiat
+	}
+	return false
+}
+
+type BakeMacaroonResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hex encoded macaroon.
+	Macaroon string `protobuf:"bytes,1,opt,name=macaroon,proto3" json:"macaroon,omitempty"`
+}
+
+func (x *BakeMacaroonResponse) Reset() {
+	*x = BakeMacaroonResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BakeMacaroonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BakeMacaroonResponse) ProtoMessage() {}
+
+func (x *BakeMacaroonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BakeMacaroonResponse.ProtoReflect.Descriptor instead.
+func (*BakeMacaroonResponse) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *BakeMacaroonResponse) GetMacaroon() string {
+	if x != nil {
+		return x.Macaroon
+	}
+	return ""
+}
+
+type StartAuditResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the audit job.
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+}
+
+func (x *StartAuditResponse) Reset() {
+	*x = StartAuditResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartAuditResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartAuditResponse) ProtoMessage() {}
+
+func (x *StartAuditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartAuditResponse.ProtoReflect.Descriptor instead.
+func (*StartAuditResponse) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *StartAuditResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type JobStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the job.
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+}
+
+func (x *JobStatusRequest) Reset() {
+	*x = JobStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobStatusRequest) ProtoMessage() {}
+
+func (x *JobStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobStatusRequest.ProtoReflect.Descriptor instead.
+func (*JobStatusRequest) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *JobStatusRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type JobStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the job.
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// The current state of the job.
+	State JobState `protobuf:"varint,2,opt,name=state,proto3,enum=frdrpc.JobState" json:"state,omitempty"`
+	// The unix timestamp at which the job was started.
+	CreatedAt uint64 `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The unix timestamp at which the job finished, zero if it is running.
+	FinishedAt uint64 `protobuf:"varint,4,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	// The error that the job failed with, set if the job failed.
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// The number of pages that the job has fetched from lnd.
+	PagesFetched uint64 `protobuf:"varint,6,opt,name=pages_fetched,json=pagesFetched,proto3" json:"pages_fetched,omitempty"`
+	// The number of fiat prices that the job has fetched.
+	PricesFetched uint64 `protobuf:"varint,7,opt,name=prices_fetched,json=pricesFetched,proto3" json:"prices_fetched,omitempty"`
+	// The number of report entries that the job has built.
+	EntriesBuilt uint64 `protobuf:"varint,8,opt,name=entries_built,json=entriesBuilt,proto3" json:"entries_built,omitempty"`
+}
+
+func (x *JobStatusResponse) Reset() {
+	*x = JobStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobStatusResponse) ProtoMessage() {}
+
+func (x *JobStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobStatusResponse.ProtoReflect.Descriptor instead.
+func (*JobStatusResponse) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *JobStatusResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *JobStatusResponse) GetState() JobState {
+	if x != nil {
+		return x.State
+	}
+	return JobState_JOB_RUNNING
+}
+
+func (x *JobStatusResponse) GetCreatedAt() uint64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *JobStatusResponse) GetFinishedAt() uint64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+func (x *JobStatusResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *JobStatusResponse) GetPagesFetched() uint64 {
+	if x != nil {
+		return x.PagesFetched
+	}
+	return 0
+}
+
+func (x *JobStatusResponse) GetPricesFetched() uint64 {
+	if x != nil {
+		return x.PricesFetched
+	}
+	return 0
+}
+
+func (x *JobStatusResponse) GetEntriesBuilt() uint64 {
+	if x != nil {
+		return x.EntriesBuilt
+	}
+	return 0
+}
+
+type CancelJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the job to cancel.
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+}
+
+func (x *CancelJobRequest) Reset() {
+	*x = CancelJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelJobRequest) ProtoMessage() {}
+
+func (x *CancelJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelJobRequest.ProtoReflect.Descriptor instead.
+func (*CancelJobRequest) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CancelJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type CancelJobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelJobResponse) Reset() {
+	*x = CancelJobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelJobResponse) ProtoMessage() {}
+
+func (x *CancelJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelJobResponse.ProtoReflect.Descriptor instead.
+func (*CancelJobResponse) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{66}
+}
+
+type JobResultRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the job.
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+}
+
+func (x *JobResultRequest) Reset() {
+	*x = JobResultRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobResultRequest) ProtoMessage() {}
+
+func (x *JobResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobResultRequest.ProtoReflect.Descriptor instead.
+func (*JobResultRequest) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *JobResultRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type SubscribeAlertsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SubscribeAlertsRequest) Reset() {
+	*x = SubscribeAlertsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeAlertsRequest) ProtoMessage() {}
+
+func (x *SubscribeAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeAlertsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{68}
+}
+
+type Alert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the rule that raised the alert.
+	Rule string `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	// The channel that the alert is for, empty for alerts about the node as a
+	// whole.
+	ChanPoint string `protobuf:"bytes,2,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
+	// A human readable description of the alert.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// The unix timestamp at which the alert was raised.
+	Timestamp uint64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *Alert) Reset() {
+	*x = Alert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Alert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alert) ProtoMessage() {}
+
+func (x *Alert) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alert.ProtoReflect.Descriptor instead.
+func (*Alert) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *Alert) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *Alert) GetChanPoint() string {
+	if x != nil {
+		return x.ChanPoint
+	}
+	return ""
+}
+
+func (x *Alert) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Alert) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type SubscribeEntriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The sequence number of the last entry that the client received. All
+	// recorded entries after this sequence number are sent before new entries
+	// are streamed. If this value is zero, all recorded entries are sent.
+	AfterSequence uint64 `protobuf:"varint,1,opt,name=after_sequence,json=afterSequence,proto3" json:"after_sequence,omitempty"`
+}
+
+func (x *SubscribeEntriesRequest) Reset() {
+	*x = SubscribeEntriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeEntriesRequest) ProtoMessage() {}
+
+func (x *SubscribeEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeEntriesRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SubscribeEntriesRequest) GetAfterSequence() uint64 {
+	if x != nil {
+		return x.AfterSequence
+	}
+	return 0
+}
+
+type JournalEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The sequence number of the entry. Sequence numbers start at one and
+	// increase by one for each entry that is recorded, in the order that entries
+	// are recorded. This order may differ from entry timestamp order, because
+	// some entries are only reported after the time at which they occurred.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// The accounting entry.
+	Entry *ReportEntry `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *JournalEntry) Reset() {
+	*x = JournalEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JournalEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JournalEntry) ProtoMessage() {}
+
+func (x *JournalEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JournalEntry.ProtoReflect.Descriptor instead.
+func (*JournalEntry) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *JournalEntry) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *JournalEntry) GetEntry() *ReportEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+var File_faraday_proto protoreflect.FileDescriptor
+
+var file_faraday_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x66, 0x61, 0x72, 0x61, 0x64, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x06, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x22, 0xb9, 0x02, 0x0a, 0x1a, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75,
+	0x6d, 0x5f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x06,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x3f, 0x0a, 0x0f, 0x66, 0x65, 0x65, 0x5f, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x16, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x65, 0x65, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6a, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a,
+	0x0a, 0x06, 0x55, 0x50, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45,
+	0x56, 0x45, 0x4e, 0x55, 0x45, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x49, 0x4e, 0x43, 0x4f, 0x4d,
+	0x49, 0x4e, 0x47, 0x5f, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f,
+	0x4f, 0x55, 0x54, 0x47, 0x4f, 0x49, 0x4e, 0x47, 0x5f, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x10,
+	0x04, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x56, 0x4f, 0x4c, 0x55, 0x4d,
+	0x45, 0x10, 0x05, 0x22, 0xad, 0x03, 0x0a, 0x1d, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x66, 0x72, 0x64,
+	0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a,
+	0x72, 0x65, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x75,
+	0x74, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x11, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x06, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x66, 0x72, 0x64, 0x72,
+	0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x5f, 0x7a, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x0e, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5a, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x29, 0x0a, 0x10, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x6c, 0x6f, 0x77, 0x65,
+	0x72, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x75,
+	0x70, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x75, 0x70, 0x70, 0x65, 0x72, 0x50, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x22, 0x4b, 0x0a, 0x0d, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65,
+	0x72, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x51, 0x52, 0x10, 0x00,
+	0x12, 0x14, 0x0a, 0x10, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x49, 0x45, 0x44, 0x5f, 0x5a, 0x5f, 0x53,
+	0x43, 0x4f, 0x52, 0x45, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e,
+	0x54, 0x49, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x4c, 0x4f, 0x47, 0x5f, 0x49, 0x51,
+	0x52, 0x10, 0x03, 0x22, 0x8f, 0x01, 0x0a, 0x1f, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x66,
+	0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x0a, 0x72, 0x65, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xf6, 0x01, 0x0a, 0x1c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x2f, 0x0a,
+	0x13, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x73,
+	0x69, 0x64, 0x65, 0x72, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x40,
+	0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63,
+	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x3c, 0x0a, 0x0e, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x6f, 0x75, 0x6e,
+	0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70,
+	0x63, 0x2e, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x52,
+	0x0d, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x22, 0x3b,
+	0x0a, 0x0d, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05,
+	0x6c, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x70, 0x70, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x75, 0x70, 0x70, 0x65, 0x72, 0x22, 0x6e, 0x0a, 0x0e, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x72, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x22, 0xb2, 0x01, 0x0a, 0x14,
+	0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x3f, 0x0a, 0x0f, 0x66, 0x65, 0x65, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70,
+	0x63, 0x2e, 0x46, 0x65, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0e, 0x66, 0x65, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x9a, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66, 0x72,
+	0x64, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x50, 0x0a, 0x15, 0x75,
+	0x6e, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x72, 0x64,
+	0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64,
+	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x14, 0x75, 0x6e, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0xba, 0x02,
+	0x0a, 0x13, 0x55, 0x6e, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x46, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x5f, 0x69,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x49, 0x64, 0x49,
+	0x6e, 0x12, 0x1e, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x5f, 0x6f, 0x75, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x49, 0x64, 0x4f, 0x75,
+	0x74, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x2e, 0x0a, 0x13, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x6d,
+	0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x49, 0x6e, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x66, 0x65, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x22, 0xf8, 0x02, 0x0a, 0x0d, 0x52,
+	0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x12, 0x49, 0x0a, 0x0c, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x66, 0x72, 0x64, 0x72,
+	0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0b, 0x70, 0x61, 0x69, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x36,
+	0x0a, 0x17, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x73, 0x75, 0x72, 0x63, 0x68, 0x61,
+	0x72, 0x67, 0x65, 0x73, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x15, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x75, 0x72, 0x63, 0x68, 0x61, 0x72, 0x67,
+	0x65, 0x73, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e,
+	0x64, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5f, 0x6d, 0x73, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x33, 0x0a, 0x15,
+	0x75, 0x6e, 0x64, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x66, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x75, 0x6e, 0x64,
+	0x65, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x73, 0x1a, 0x52, 0x0a, 0x10, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e,
+	0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xef, 0x02, 0x0a, 0x0a, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f,
+	0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x12, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69,
+	0x6e, 0x67, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x6f,
+	0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x10, 0x66, 0x65, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67,
+	0x4d, 0x73, 0x61, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69,
+	0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x12, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69,
+	0x6e, 0x67, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x69,
+	0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x10, 0x66, 0x65, 0x65, 0x73, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67,
+	0x4d, 0x73, 0x61, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f,
+	0x73, 0x75, 0x72, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x75,
+	0x72, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x34, 0x0a, 0x16,
+	0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x69, 0x6e,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x4d, 0x73,
+	0x61, 0x74, 0x12, 0x33, 0x0a, 0x15, 0x75, 0x6e, 0x64, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73,
+	0x65, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x14, 0x75, 0x6e, 0x64, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x46,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0x59, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3f, 0x0a, 0x0f, 0x66, 0x65, 0x65, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x66, 0x72, 0x64,
+	0x72, 0x70, 0x63, 0x2e, 0x46, 0x65, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x5c, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x73,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a,
+	0x10, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x52,
+	0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x73,
+	0x22, 0xd1, 0x02, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x73, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x6e, 0x63, 0x6f,
+	0x6d, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x73, 0x61, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4f, 0x75,
+	0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x65,
+	0x65, 0x73, 0x5f, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x73, 0x45, 0x61, 0x72, 0x6e, 0x65, 0x64,
+	0x4d, 0x73, 0x61, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x22, 0xeb, 0x01, 0x0a, 0x13, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04,
+	0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x12, 0x35, 0x0a, 0x0b,
+	0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x75,
+	0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x0c, 0x66, 0x69, 0x61, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x66, 0x72, 0x64, 0x72,
+	0x70, 0x63, 0x2e, 0x46, 0x69, 0x61, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x0b,
+	0x66, 0x69, 0x61, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x39, 0x0a, 0x0d, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x74, 0x63,
+	0x6f, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x02,
+	0x10, 0x03, 0x22, 0x48, 0x0a, 0x14, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x61,
+	0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x72, 0x64, 0x72,
+	0x70, 0x63, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52,
+	0x05, 0x72, 0x61, 0x74, 0x65, 0x73, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0x69, 0x0a, 0x0c,
+	0x42, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x5f, 0x0a, 0x0c, 0x45, 0x78, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x31, 0x0a, 0x09, 0x62, 0x74, 0x63, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70,
+	0x63, 0x2e, 0x42, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x08,
+	0x62, 0x74, 0x63, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0xc6, 0x03, 0x0a, 0x10, 0x4e, 0x6f, 0x64,
+	0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x66, 0x69, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x69, 0x61, 0x74, 0x12, 0x35, 0x0a, 0x0b, 0x67, 0x72,
+	0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61,
+	0x72, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x43, 0x0a, 0x11, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66,
+	0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x52, 0x10, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x0c, 0x66, 0x69, 0x61, 0x74, 0x5f, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x66,
+	0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x61, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x52, 0x0b, 0x66, 0x69, 0x61, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x39,
+	0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x42,
+	0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x0c, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x12, 0x32, 0x0a, 0x15, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x63, 0x6c,
+	0x6f, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x13, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x4a, 0x04, 0x08, 0x03, 0x10,
+	0x04, 0x22, 0x83, 0x01, 0x0a, 0x0e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x6e, 0x5f, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x6e, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x66, 0x66, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x66, 0x66, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x50,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x22, 0xe9, 0x02, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a,
+	0x0f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69,
+	0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x61, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x6f, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65,
+	0x12, 0x31, 0x0a, 0x09, 0x62, 0x74, 0x63, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x74,
+	0x63, 0x6f, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x08, 0x62, 0x74, 0x63, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x22, 0xe1, 0x01, 0x0a, 0x11, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x72, 0x64,
+	0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x72, 0x64, 0x72,
+	0x70, 0x63, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
+	0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x36, 0x0a, 0x0c, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x5f, 0x64, 0x72, 0x69, 0x66, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x44, 0x72,
+	0x69, 0x66, 0x74, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x44, 0x72, 0x69, 0x66, 0x74,
+	0x12, 0x35, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x4d, 0x0a, 0x12, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x22, 0x94, 0x02, 0x0a, 0x13, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x78, 0x69, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6f, 0x70, 0x65, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c,
+	0x6f, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x72, 0x64, 0x72,
+	0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x90, 0x01,
+	0x0a, 0x12, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x22, 0xb5, 0x02, 0x0a, 0x13, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x13, 0x66, 0x65, 0x65, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61,
+	0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x33, 0x0a, 0x09, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66, 0x72, 0x64, 0x72,
+	0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x52, 0x09, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x0a, 0x6c,
+	0x6f, 0x73, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x73, 0x74, 0x46, 0x6c, 0x6f,
+	0x77, 0x52, 0x09, 0x6c, 0x6f, 0x73, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x24, 0x0a, 0x0e,
+	0x6c, 0x6f, 0x73, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6c, 0x6f, 0x73, 0x74, 0x46, 0x65, 0x65, 0x73, 0x4d, 0x73,
+	0x61, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x12, 0x72, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x65, 0x65, 0x73,
+	0x4d, 0x73, 0x61, 0x74, 0x12, 0x32, 0x0a, 0x16, 0x6c, 0x6f, 0x73, 0x74, 0x5f, 0x66, 0x65, 0x65,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x79, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6c, 0x6f, 0x73, 0x74, 0x46, 0x65, 0x65, 0x73, 0x50, 0x65,
+	0x72, 0x44, 0x61, 0x79, 0x4d, 0x73, 0x61, 0x74, 0x22, 0xf6, 0x02, 0x0a, 0x0d, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68,
+	0x61, 0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x68, 0x61, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x53, 0x61, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x6f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x11, 0x63, 0x6f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x76, 0x65, 0x46, 0x65, 0x65,
+	0x53, 0x61, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65,
+	0x5f, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x2f, 0x0a, 0x14, 0x66, 0x6f, 0x72, 0x63, 0x65,
+	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x2d, 0x0a, 0x13, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x5f, 0x73, 0x77, 0x65, 0x65, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x53, 0x77, 0x65, 0x65,
+	0x70, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x2b, 0x0a, 0x12, 0x66, 0x6f, 0x72, 0x63, 0x65,
+	0x5f, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x48, 0x74, 0x6c, 0x63, 0x46, 0x65,
+	0x65, 0x53, 0x61, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x73, 0x76, 0x5f, 0x64, 0x65, 0x6c, 0x61,
+	0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x73, 0x76, 0x44, 0x65, 0x6c, 0x61,
+	0x79, 0x22, 0xd5, 0x01, 0x0a, 0x08, 0x4c, 0x6f, 0x73, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x12, 0x2e,
+	0x0a, 0x13, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x5f,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x63,
+	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x2e,
+	0x0a, 0x13, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x5f,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x75, 0x74,
+	0x67, 0x6f, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x66, 0x65, 0x65, 0x73, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x2b, 0x0a, 0x11,
+	0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x22, 0x55, 0x0a, 0x19, 0x46, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x22, 0xce, 0x01, 0x0a, 0x1a, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x46,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4d, 0x73,
+	0x61, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x65,
+	0x73, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x69,
+	0x73, 0x73, 0x65, 0x64, 0x46, 0x65, 0x65, 0x73, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x33, 0x0a, 0x08,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x46,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x22, 0xfd, 0x01, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x73, 0x73,
+	0x65, 0x64, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64,
+	0x5f, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x46, 0x65, 0x65, 0x73, 0x4d, 0x73, 0x61, 0x74,
+	0x12, 0x2f, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x73, 0x22, 0xa4, 0x01, 0x0a, 0x0d, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x69,
+	0x73, 0x73, 0x65, 0x64, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x28,
+	0x0a, 0x10, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x6d, 0x73,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64,
+	0x46, 0x65, 0x65, 0x73, 0x4d, 0x73, 0x61, 0x74, 0x22, 0xcf, 0x01, 0x0a, 0x10, 0x50, 0x61, 0x69,
+	0x72, 0x46, 0x6c, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x29,
+	0x0a, 0x10, 0x64, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x74, 0x5f, 0x74, 0x75, 0x72, 0x6e, 0x6f, 0x76,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x64, 0x6f, 0x72, 0x6d, 0x61, 0x6e,
+	0x74, 0x54, 0x75, 0x72, 0x6e, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x13, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x12, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x72, 0x0a, 0x11, 0x50, 0x61,
+	0x69, 0x72, 0x46, 0x6c, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2c, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x42, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x2f, 0x0a,
+	0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x46, 0x6c, 0x6f, 0x77, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x22, 0x6e,
+	0x0a, 0x0a, 0x46, 0x6c, 0x6f, 0x77, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65,
+	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x50,
+	0x61, 0x69, 0x72, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x22, 0xc8,
+	0x01, 0x0a, 0x08, 0x50, 0x61, 0x69, 0x72, 0x46, 0x6c, 0x6f, 0x77, 0x12, 0x2e, 0x0a, 0x13, 0x69,
+	0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69,
+	0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x6f,
+	0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69,
+	0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x66, 0x65, 0x65, 0x73, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x66, 0x65, 0x65, 0x73, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x6f, 0x72,
+	0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6e,
+	0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x22, 0xd2, 0x02, 0x0a, 0x0b, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x46, 0x6c, 0x6f, 0x77, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61,
+	0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x68, 0x61, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61,
+	0x63, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x53, 0x61, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x6d,
+	0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x30, 0x0a,
+	0x14, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67,
+	0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x61, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x74, 0x75, 0x72, 0x6e, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x08, 0x74, 0x75, 0x72, 0x6e, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x46, 0x6c, 0x6f, 0x77, 0x2e, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x35, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12,
+	0x0b, 0x0a, 0x07, 0x44, 0x4f, 0x52, 0x4d, 0x41, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x49, 0x4e, 0x4b,
+	0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x52, 0x10, 0x03, 0x22, 0xc1,
+	0x02, 0x0a, 0x1a, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x5f,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f,
+	0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x69, 0x71, 0x75,
+	0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x0d, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x44, 0x61, 0x79, 0x73, 0x12,
+	0x2f, 0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6d,
+	0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x61, 0x74,
+	0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11,
+	0x6d, 0x61, 0x78, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x61,
+	0x74, 0x12, 0x3f, 0x0a, 0x0f, 0x66, 0x65, 0x65, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x66, 0x72, 0x64,
+	0x72, 0x70, 0x63, 0x2e, 0x46, 0x65, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x91, 0x01, 0x0a, 0x1b, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x13, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73,
+	0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0f, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77,
+	0x12, 0x44, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x72, 0x64, 0x72,
+	0x70, 0x63, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x9a, 0x04, 0x0a, 0x12, 0x4f, 0x70, 0x65, 0x6e, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x61,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74,
+	0x79, 0x53, 0x61, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x61, 0x74,
+	0x12, 0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x5f,
+	0x6d, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x73,
+	0x45, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x6f, 0x75,
+	0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x6d, 0x73,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69,
+	0x6e, 0x67, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x12,
+	0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x6d, 0x73,
+	0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x69,
+	0x73, 0x73, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x46, 0x65, 0x65, 0x73,
+	0x4d, 0x73, 0x61, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x79, 0x69,
+	0x65, 0x6c, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x64, 0x61, 0x69, 0x6c, 0x79,
+	0x59, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x10, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x53, 0x69, 0x7a, 0x65,
+	0x53, 0x61, 0x74, 0x12, 0x37, 0x0a, 0x18, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x44,
+	0x61, 0x69, 0x6c, 0x79, 0x46, 0x65, 0x65, 0x73, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x22, 0x0a, 0x0d,
+	0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x6e, 0x43, 0x6f, 0x73, 0x74, 0x53, 0x61, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x64, 0x61, 0x79, 0x73,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x44,
+	0x61, 0x79, 0x73, 0x22, 0x71, 0x0a, 0x14, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x68, 0x61, 0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65,
+	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x51, 0x0a, 0x15, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x38, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x22, 0xb2, 0x01, 0x0a, 0x14, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x2e, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6c, 0x61, 0x73, 0x74, 0x69, 0x63, 0x69, 0x74, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x65, 0x6c, 0x61, 0x73, 0x74, 0x69, 0x63, 0x69, 0x74,
+	0x79, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x6c, 0x61, 0x73, 0x74, 0x69, 0x63, 0x69, 0x74, 0x79, 0x5f,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x65, 0x6c,
+	0x61, 0x73, 0x74, 0x69, 0x63, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x90,
+	0x04, 0x0a, 0x0c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x65, 0x65,
+	0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x66, 0x65, 0x65, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x20, 0x0a,
+	0x0c, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x70, 0x6d, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x50, 0x70, 0x6d, 0x12,
+	0x31, 0x0a, 0x15, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x62,
+	0x61, 0x73, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12,
+	0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x73,
+	0x61, 0x74, 0x12, 0x2f, 0x0a, 0x14, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x66, 0x65,
+	0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x70, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x11, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65,
+	0x50, 0x70, 0x6d, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x74, 0x69,
+	0x6d, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x22, 0x0a, 0x0d, 0x6d,
+	0x69, 0x6e, 0x5f, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x48, 0x74, 0x6c, 0x63, 0x4d, 0x73, 0x61, 0x74, 0x12,
+	0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x6d, 0x73, 0x61, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x48, 0x74, 0x6c, 0x63, 0x4d,
+	0x73, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x6d,
+	0x73, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x6d, 0x73,
+	0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x66, 0x65, 0x65, 0x73, 0x4d, 0x73,
+	0x61, 0x74, 0x12, 0x2d, 0x0a, 0x13, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x64, 0x61, 0x79, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x10, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x4d, 0x73, 0x61,
+	0x74, 0x22, 0x80, 0x02, 0x0a, 0x13, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x68, 0x65,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x66, 0x69, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x69, 0x61, 0x74, 0x12, 0x35, 0x0a, 0x0b, 0x67, 0x72,
+	0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61,
+	0x72, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x36, 0x0a, 0x0c, 0x66, 0x69, 0x61, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63,
+	0x2e, 0x46, 0x69, 0x61, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x0b, 0x66, 0x69,
+	0x61, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x39, 0x0a, 0x0d, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x74, 0x63, 0x6f, 0x69,
+	0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x73, 0x22, 0xd7, 0x02, 0x0a, 0x14, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x53, 0x68, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2a, 0x0a, 0x08, 0x6f,
+	0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x07,
+	0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x2b, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x66, 0x72, 0x64, 0x72,
+	0x70, 0x63, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x73, 0x12, 0x34, 0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x66, 0x72,
+	0x64, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0c, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0f, 0x75, 0x6e,
+	0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f, 0x68, 0x74, 0x6c, 0x63, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x0e, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x48,
+	0x74, 0x6c, 0x63, 0x73, 0x12, 0x25, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x31, 0x0a, 0x09, 0x62,
+	0x74, 0x63, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x52, 0x08, 0x62, 0x74, 0x63, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x50,
+	0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x74,
+	0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x74, 0x63, 0x12, 0x12, 0x0a, 0x04,
+	0x66, 0x69, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x61, 0x74,
+	0x22, 0x87, 0x02, 0x0a, 0x0c, 0x41, 0x75, 0x64, 0x69, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x12, 0x2e, 0x0a, 0x06, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x69, 0x6e, 0x63, 0x6f, 0x6d,
+	0x65, 0x12, 0x32, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x65, 0x78, 0x70,
+	0x65, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e,
+	0x65, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d,
+	0x73, 0x61, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x74, 0x5f, 0x66, 0x69, 0x61, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x46, 0x69, 0x61, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x86, 0x01, 0x0a, 0x0e, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a,
+	0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66,
+	0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x4c, 0x69, 0x6e,
+	0x65, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x66, 0x69, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66,
+	0x69, 0x61, 0x74, 0x22, 0xc3, 0x01, 0x0a, 0x0b, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x4c,
+	0x69, 0x6e, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d,
+	0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x61, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x61, 0x74, 0x22, 0xe0, 0x02, 0x0a, 0x12, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x69, 0x61, 0x74, 0x12, 0x35, 0x0a,
+	0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x72, 0x61, 0x6e,
+	0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x11, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x10, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x0c, 0x66, 0x69, 0x61,
+	0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x61, 0x74, 0x42, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x52, 0x0b, 0x66, 0x69, 0x61, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x12, 0x39, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70,
+	0x63, 0x2e, 0x42, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x0c,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x22, 0x43, 0x0a, 0x13,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x64, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x22, 0x1a, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4b, 0x0a,
+	0x19, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x72,
+	0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x52, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x22, 0xaf, 0x02, 0x0a, 0x0c, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x64, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e,
+	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x6e,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b,
+	0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a,
+	0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x52, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64,
+	0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x69, 0x67,
+	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x65, 0x6e, 0x63,
+	0x6f, 0x64, 0x69, 0x6e, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x65, 0x0a, 0x0b,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x44, 0x72, 0x69, 0x66, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x72,
+	0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x28, 0x0a, 0x05, 0x64, 0x72, 0x69,
+	0x66, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70,
+	0x63, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52, 0x05, 0x64, 0x72,
+	0x69, 0x66, 0x74, 0x22, 0x89, 0x01, 0x0a, 0x0a, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x44, 0x72, 0x69,
+	0x66, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x11, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x72, 0x69, 0x66, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x66, 0x72, 0x6f,
+	0x7a, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x72, 0x64, 0x72,
+	0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
+	0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x12, 0x27, 0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x22,
+	0x8a, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62,
+	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65,
+	0x79, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x65, 0x6e, 0x63,
+	0x6f, 0x64, 0x69, 0x6e, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x0a, 0x16,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x22, 0x31, 0x0a, 0x17, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x22, 0xdd, 0x01, 0x0a, 0x13, 0x42, 0x61, 0x6b, 0x65, 0x4d, 0x61, 0x63, 0x61,
+	0x72, 0x6f, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f,
+	0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64,
+	0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x1e, 0x0a, 0x0b, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x6b, 0x65, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x6f, 0x6f, 0x74, 0x4b,
+	0x65, 0x79, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x61, 0x75, 0x64, 0x69, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x24,
+	0x0a, 0x0e, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x66, 0x69,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x74, 0x72, 0x69, 0x70, 0x46,
+	0x69, 0x61, 0x74, 0x22, 0x32, 0x0a, 0x14, 0x42, 0x61, 0x6b, 0x65, 0x4d, 0x61, 0x63, 0x61, 0x72,
+	0x6f, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
+	0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d,
+	0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x22, 0x2b, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a,
+	0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a,
+	0x6f, 0x62, 0x49, 0x64, 0x22, 0x29, 0x0a, 0x10, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22,
+	0x99, 0x02, 0x0a, 0x11, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x66, 0x72,
+	0x64, 0x72, 0x70, 0x63, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61,
+	0x67, 0x65, 0x73, 0x5f, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x70, 0x61, 0x67, 0x65, 0x73, 0x46, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12,
+	0x25, 0x0a, 0x0e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x5f, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x46,
+	0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x5f, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x42, 0x75, 0x69, 0x6c, 0x74, 0x22, 0x29, 0x0a, 0x10, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x0a, 0x10, 0x4a,
+	0x6f, 0x62, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x72, 0x0a, 0x05, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x22, 0x40, 0x0a, 0x17, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x61, 0x66, 0x74, 0x65, 0x72, 0x53, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x55, 0x0a, 0x0c, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x2a, 0x64, 0x0a,
+	0x0e, 0x46, 0x65, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x0c, 0x0a, 0x08, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x46, 0x45, 0x45, 0x10, 0x00, 0x12, 0x09, 0x0a,
+	0x05, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x4c, 0x4c, 0x5f,
+	0x4f, 0x55, 0x54, 0x47, 0x4f, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x4c,
+	0x4c, 0x5f, 0x49, 0x4e, 0x43, 0x4f, 0x4d, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11,
+	0x43, 0x41, 0x50, 0x41, 0x43, 0x49, 0x54, 0x59, 0x5f, 0x57, 0x45, 0x49, 0x47, 0x48, 0x54, 0x45,
+	0x44, 0x10, 0x04, 0x2a, 0xa1, 0x01, 0x0a, 0x0b, 0x47, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x17, 0x0a, 0x13, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x47,
+	0x52, 0x41, 0x4e, 0x55, 0x4c, 0x41, 0x52, 0x49, 0x54, 0x59, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x4d, 0x49, 0x4e, 0x55, 0x54, 0x45, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x49, 0x56, 0x45,
+	0x5f, 0x4d, 0x49, 0x4e, 0x55, 0x54, 0x45, 0x53, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x49,
+	0x46, 0x54, 0x45, 0x45, 0x4e, 0x5f, 0x4d, 0x49, 0x4e, 0x55, 0x54, 0x45, 0x53, 0x10, 0x03, 0x12,
+	0x12, 0x0a, 0x0e, 0x54, 0x48, 0x49, 0x52, 0x54, 0x59, 0x5f, 0x4d, 0x49, 0x4e, 0x55, 0x54, 0x45,
+	0x53, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x4f, 0x55, 0x52, 0x10, 0x05, 0x12, 0x0d, 0x0a,
+	0x09, 0x53, 0x49, 0x58, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x53, 0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c,
+	0x54, 0x57, 0x45, 0x4c, 0x56, 0x45, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x53, 0x10, 0x07, 0x12, 0x07,
+	0x0a, 0x03, 0x44, 0x41, 0x59, 0x10, 0x08, 0x2a, 0x6a, 0x0a, 0x0b, 0x46, 0x69, 0x61, 0x74, 0x42,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x17, 0x0a, 0x13, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x5f, 0x46, 0x49, 0x41, 0x54, 0x42, 0x41, 0x43, 0x4b, 0x45, 0x4e, 0x44, 0x10, 0x00, 0x12,
+	0x0b, 0x0a, 0x07, 0x43, 0x4f, 0x49, 0x4e, 0x43, 0x41, 0x50, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08,
+	0x43, 0x4f, 0x49, 0x4e, 0x44, 0x45, 0x53, 0x4b, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x55,
+	0x53, 0x54, 0x4f, 0x4d, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x49, 0x4e, 0x47, 0x45,
+	0x43, 0x4b, 0x4f, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x42, 0x49, 0x54, 0x46, 0x49, 0x4e, 0x45,
+	0x58, 0x10, 0x05, 0x2a, 0xa2, 0x02, 0x0a, 0x09, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x16,
+	0x0a, 0x12, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f,
+	0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45,
+	0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x02, 0x12,
+	0x14, 0x0a, 0x10, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x5f,
+	0x46, 0x45, 0x45, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c,
+	0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x43, 0x45,
+	0x49, 0x50, 0x54, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54,
+	0x10, 0x06, 0x12, 0x07, 0x0a, 0x03, 0x46, 0x45, 0x45, 0x10, 0x07, 0x12, 0x14, 0x0a, 0x10, 0x43,
+	0x49, 0x52, 0x43, 0x55, 0x4c, 0x41, 0x52, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x10,
+	0x08, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x10, 0x09, 0x12, 0x0f,
+	0x0a, 0x0b, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x46, 0x45, 0x45, 0x10, 0x0a, 0x12,
+	0x14, 0x0a, 0x10, 0x43, 0x49, 0x52, 0x43, 0x55, 0x4c, 0x41, 0x52, 0x5f, 0x50, 0x41, 0x59, 0x4d,
+	0x45, 0x4e, 0x54, 0x10, 0x0b, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x49, 0x52, 0x43, 0x55, 0x4c, 0x41,
+	0x52, 0x5f, 0x46, 0x45, 0x45, 0x10, 0x0c, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x57, 0x45, 0x45, 0x50,
+	0x10, 0x0d, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x57, 0x45, 0x45, 0x50, 0x5f, 0x46, 0x45, 0x45, 0x10,
+	0x0e, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x43, 0x4c, 0x4f,
+	0x53, 0x45, 0x5f, 0x46, 0x45, 0x45, 0x10, 0x0f, 0x2a, 0x30, 0x0a, 0x09, 0x44, 0x72, 0x69, 0x66,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a,
+	0x07, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x51, 0x0a, 0x08, 0x4a, 0x6f,
+	0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x4a, 0x4f, 0x42, 0x5f, 0x52, 0x55,
+	0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x4a, 0x4f, 0x42, 0x5f, 0x53,
+	0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x4a, 0x4f,
+	0x42, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x4a, 0x4f,
+	0x42, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x32, 0x94, 0x0e,
+	0x0a, 0x0d, 0x46, 0x61, 0x72, 0x61, 0x64, 0x61, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12,
+	0x65, 0x0a, 0x16, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x2e, 0x66, 0x72, 0x64, 0x72,
+	0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x18, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x27, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x66, 0x72,
+	0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x1c, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65,
+	0x6e, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75,
+	0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x52, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68,
+	0x74, 0x73, 0x12, 0x1e, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40,
+	0x0a, 0x09, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x12, 0x18, 0x2e, 0x66, 0x72,
+	0x64, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x46, 0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x1a, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x66, 0x72,
+	0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x1a, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63,
+	0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5b, 0x0a, 0x12, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e,
+	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x66, 0x72, 0x64, 0x72,
+	0x70, 0x63, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a,
+	0x09, 0x50, 0x61, 0x69, 0x72, 0x46, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x18, 0x2e, 0x66, 0x72, 0x64,
+	0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x46, 0x6c, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61,
+	0x69, 0x72, 0x46, 0x6c, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5e, 0x0a, 0x13, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x66, 0x72, 0x64,
+	0x72, 0x70, 0x63, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4c, 0x0a, 0x0d, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x1c, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a,
+	0x0c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x68, 0x65, 0x65, 0x74, 0x12, 0x1b, 0x2e,
+	0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x68,
+	0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x66, 0x72, 0x64,
+	0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x68, 0x65, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1a, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63,
+	0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x58, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x20, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0f, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x2e,
+	0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49,
+	0x0a, 0x0c, 0x42, 0x61, 0x6b, 0x65, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x12, 0x1b,
+	0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x6b, 0x65, 0x4d, 0x61, 0x63, 0x61,
+	0x72, 0x6f, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x66, 0x72,
+	0x64, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x6b, 0x65, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x12, 0x18, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a,
+	0x09, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x2e, 0x66, 0x72, 0x64,
+	0x72, 0x70, 0x63, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x4a, 0x6f,
+	0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x40, 0x0a, 0x09, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x12, 0x18, 0x2e, 0x66,
+	0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x40, 0x0a, 0x09, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18,
+	0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70,
+	0x63, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e,
+	0x41, 0x6c, 0x65, 0x72, 0x74, 0x30, 0x01, 0x12, 0x4b, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x66, 0x72,
+	0x64, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x66,
+	0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x30, 0x01, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x6c, 0x61, 0x62, 0x73,
+	0x2f, 0x66, 0x61, 0x72, 0x61, 0x64, 0x61, 0x79, 0x2f, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_faraday_proto_rawDescOnce sync.Once
+	file_faraday_proto_rawDescData = file_faraday_proto_rawDesc
+)
+
+func file_faraday_proto_rawDescGZIP() []byte {
+	file_faraday_proto_rawDescOnce.Do(func() {
+		file_faraday_proto_rawDescData = protoimpl.X.CompressGZIP(file_faraday_proto_rawDescData)
+	})
+	return file_faraday_proto_rawDescData
+}
+
+var file_faraday_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_faraday_proto_msgTypes = make([]protoimpl.MessageInfo, 73)
+var file_faraday_proto_goTypes = []any{
+	(FeeAttribution)(0),                    // 0: frdrpc.FeeAttribution
+	(Granularity)(0),                       // 1: frdrpc.Granularity
+	(FiatBackend)(0),                       // 2: frdrpc.FiatBackend
+	(EntryType)(0),                         // 3: frdrpc.EntryType
+	(DriftType)(0),                         // 4: frdrpc.DriftType
+	(JobState)(0),                          // 5: frdrpc.JobState
+	(CloseRecommendationRequest_Metric)(0), // 6: frdrpc.CloseRecommendationRequest.Metric
+	(OutlierRecommendationsRequest_OutlierMethod)(0), // 7: frdrpc.OutlierRecommendationsRequest.OutlierMethod
+	(ChannelFlow_Role)(0),                            // 8: frdrpc.ChannelFlow.Role
+	(*CloseRecommendationRequest)(nil),               // 9: frdrpc.CloseRecommendationRequest
+	(*OutlierRecommendationsRequest)(nil),            // 10: frdrpc.OutlierRecommendationsRequest
+	(*ThresholdRecommendationsRequest)(nil),          // 11: frdrpc.ThresholdRecommendationsRequest
+	(*CloseRecommendationsResponse)(nil),             // 12: frdrpc.CloseRecommendationsResponse
+	(*OutlierBounds)(nil),                            // 13: frdrpc.OutlierBounds
+	(*Recommendation)(nil),                           // 14: frdrpc.Recommendation
+	(*RevenueReportRequest)(nil),                     // 15: frdrpc.RevenueReportRequest
+	(*RevenueReportResponse)(nil),                    // 16: frdrpc.RevenueReportResponse
+	(*UnattributedForward)(nil),                      // 17: frdrpc.UnattributedForward
+	(*RevenueReport)(nil),                            // 18: frdrpc.RevenueReport
+	(*PairReport)(nil),                               // 19: frdrpc.PairReport
+	(*ChannelInsightsRequest)(nil),                   // 20: frdrpc.ChannelInsightsRequest
+	(*ChannelInsightsResponse)(nil),                  // 21: frdrpc.ChannelInsightsResponse
+	(*ChannelInsight)(nil),                           // 22: frdrpc.ChannelInsight
+	(*ExchangeRateRequest)(nil),                      // 23: frdrpc.ExchangeRateRequest
+	(*ExchangeRateResponse)(nil),                     // 24: frdrpc.ExchangeRateResponse
+	(*BitcoinPrice)(nil),                             // 25: frdrpc.BitcoinPrice
+	(*ExchangeRate)(nil),                             // 26: frdrpc.ExchangeRate
+	(*NodeAuditRequest)(nil),                         // 27: frdrpc.NodeAuditRequest
+	(*CustomCategory)(nil),                           // 28: frdrpc.CustomCategory
+	(*ReportEntry)(nil),                              // 29: frdrpc.ReportEntry
+	(*NodeAuditResponse)(nil),                        // 30: frdrpc.NodeAuditResponse
+	(*CloseReportRequest)(nil),                       // 31: frdrpc.CloseReportRequest
+	(*CloseReportResponse)(nil),                      // 32: frdrpc.CloseReportResponse
+	(*CloseDryRunRequest)(nil),                       // 33: frdrpc.CloseDryRunRequest
+	(*CloseDryRunResponse)(nil),                      // 34: frdrpc.CloseDryRunResponse
+	(*CloseEstimate)(nil),                            // 35: frdrpc.CloseEstimate
+	(*LostFlow)(nil),                                 // 36: frdrpc.LostFlow
+	(*ForwardingFailuresRequest)(nil),                // 37: frdrpc.ForwardingFailuresRequest
+	(*ForwardingFailuresResponse)(nil),               // 38: frdrpc.ForwardingFailuresResponse
+	(*ChannelFailures)(nil),                          // 39: frdrpc.ChannelFailures
+	(*FailureReason)(nil),                            // 40: frdrpc.FailureReason
+	(*PairFlowsRequest)(nil),                         // 41: frdrpc.PairFlowsRequest
+	(*PairFlowsResponse)(nil),                        // 42: frdrpc.PairFlowsResponse
+	(*FlowBucket)(nil),                               // 43: frdrpc.FlowBucket
+	(*PairFlow)(nil),                                 // 44: frdrpc.PairFlow
+	(*ChannelFlow)(nil),                              // 45: frdrpc.ChannelFlow
+	(*OpenRecommendationsRequest)(nil),               // 46: frdrpc.OpenRecommendationsRequest
+	(*OpenRecommendationsResponse)(nil),              // 47: frdrpc.OpenRecommendationsResponse
+	(*OpenRecommendation)(nil),                       // 48: frdrpc.OpenRecommendation
+	(*PolicyHistoryRequest)(nil),                     // 49: frdrpc.PolicyHistoryRequest
+	(*PolicyHistoryResponse)(nil),                    // 50: frdrpc.PolicyHistoryResponse
+	(*ChannelPolicyHistory)(nil),                     // 51: frdrpc.ChannelPolicyHistory
+	(*PolicyPeriod)(nil),                             // 52: frdrpc.PolicyPeriod
+	(*BalanceSheetRequest)(nil),                      // 53: frdrpc.BalanceSheetRequest
+	(*BalanceSheetResponse)(nil),                     // 54: frdrpc.BalanceSheetResponse
+	(*Balance)(nil),                                  // 55: frdrpc.Balance
+	(*AuditSummary)(nil),                             // 56: frdrpc.AuditSummary
+	(*SummarySection)(nil),                           // 57: frdrpc.SummarySection
+	(*SummaryLine)(nil),                              // 58: frdrpc.SummaryLine
+	(*ClosePeriodRequest)(nil),                       // 59: frdrpc.ClosePeriodRequest
+	(*ClosePeriodResponse)(nil),                      // 60: frdrpc.ClosePeriodResponse
+	(*ListClosedPeriodsRequest)(nil),                 // 61: frdrpc.ListClosedPeriodsRequest
+	(*ListClosedPeriodsResponse)(nil),                // 62: frdrpc.ListClosedPeriodsResponse
+	(*ClosedPeriod)(nil),                             // 63: frdrpc.ClosedPeriod
+	(*PeriodDrift)(nil),                              // 64: frdrpc.PeriodDrift
+	(*EntryDrift)(nil),                               // 65: frdrpc.EntryDrift
+	(*ReportSignature)(nil),                          // 66: frdrpc.ReportSignature
+	(*ExportAuditDataRequest)(nil),                   // 67: frdrpc.ExportAuditDataRequest
+	(*ExportAuditDataResponse)(nil),                  // 68: frdrpc.ExportAuditDataResponse
+	(*BakeMacaroonRequest)(nil),                      // 69: frdrpc.BakeMacaroonRequest
+	(*BakeMacaroonResponse)(nil),                     // 70: frdrpc.BakeMacaroonResponse
+	(*StartAuditResponse)(nil),                       // 71: frdrpc.StartAuditResponse
+	(*JobStatusRequest)(nil),                         // 72: frdrpc.JobStatusRequest
+	(*JobStatusResponse)(nil),                        // 73: frdrpc.JobStatusResponse
+	(*CancelJobRequest)(nil),                         // 74: frdrpc.CancelJobRequest
+	(*CancelJobResponse)(nil),                        // 75: frdrpc.CancelJobResponse
+	(*JobResultRequest)(nil),                         // 76: frdrpc.JobResultRequest
+	(*SubscribeAlertsRequest)(nil),                   // 77: frdrpc.SubscribeAlertsRequest
+	(*Alert)(nil),                                    // 78: frdrpc.Alert
+	(*SubscribeEntriesRequest)(nil),                  // 79: frdrpc.SubscribeEntriesRequest
+	(*JournalEntry)(nil),                             // 80: frdrpc.JournalEntry
+	nil,                                              // 81: frdrpc.RevenueReport.PairReportsEntry
+}
+var file_faraday_proto_depIdxs = []int32{
+	6,  // 0: frdrpc.CloseRecommendationRequest.metric:type_name -> frdrpc.CloseRecommendationRequest.Metric
+	0,  // 1: frdrpc.CloseRecommendationRequest.fee_attribution:type_name -> frdrpc.FeeAttribution
+	9,  // 2: frdrpc.OutlierRecommendationsRequest.rec_request:type_name -> frdrpc.CloseRecommendationRequest
+	7,  // 3: frdrpc.OutlierRecommendationsRequest.method:type_name -> frdrpc.OutlierRecommendationsRequest.OutlierMethod
+	9,  // 4: frdrpc.ThresholdRecommendationsRequest.rec_request:type_name -> frdrpc.CloseRecommendationRequest
+	14, // 5: frdrpc.CloseRecommendationsResponse.recommendations:type_name -> frdrpc.Recommendation
+	13, // 6: frdrpc.CloseRecommendationsResponse.outlier_bounds:type_name -> frdrpc.OutlierBounds
+	0,  // 7: frdrpc.RevenueReportRequest.fee_attribution:type_name -> frdrpc.FeeAttribution
+	18, // 8: frdrpc.RevenueReportResponse.reports:type_name -> frdrpc.RevenueReport
+	17, // 9: frdrpc.RevenueReportResponse.unattributed_forwards:type_name -> frdrpc.UnattributedForward
+	81, // 10: frdrpc.RevenueReport.pair_reports:type_name -> frdrpc.RevenueReport.PairReportsEntry
+	0,  // 11: frdrpc.ChannelInsightsRequest.fee_attribution:type_name -> frdrpc.FeeAttribution
+	22, // 12: frdrpc.ChannelInsightsResponse.channel_insights:type_name -> frdrpc.ChannelInsight
+	1,  // 13: frdrpc.ExchangeRateRequest.granularity:type_name -> frdrpc.Granularity
+	2,  // 14: frdrpc.ExchangeRateRequest.fiat_backend:type_name -> frdrpc.FiatBackend
+	25, // 15: frdrpc.ExchangeRateRequest.custom_prices:type_name -> frdrpc.BitcoinPrice
+	26, // 16: frdrpc.ExchangeRateResponse.rates:type_name -> frdrpc.ExchangeRate
+	25, // 17: frdrpc.ExchangeRate.btc_price:type_name -> frdrpc.BitcoinPrice
+	1,  // 18: frdrpc.NodeAuditRequest.granularity:type_name -> frdrpc.Granularity
+	28, // 19: frdrpc.NodeAuditRequest.custom_categories:type_name -> frdrpc.CustomCategory
+	2,  // 20: frdrpc.NodeAuditRequest.fiat_backend:type_name -> frdrpc.FiatBackend
+	25, // 21: frdrpc.NodeAuditRequest.custom_prices:type_name -> frdrpc.BitcoinPrice
+	3,  // 22: frdrpc.ReportEntry.type:type_name -> frdrpc.EntryType
+	25, // 23: frdrpc.ReportEntry.btc_price:type_name -> frdrpc.BitcoinPrice
+	29, // 24: frdrpc.NodeAuditResponse.reports:type_name -> frdrpc.ReportEntry
+	56, // 25: frdrpc.NodeAuditResponse.summary:type_name -> frdrpc.AuditSummary
+	64, // 26: frdrpc.NodeAuditResponse.period_drift:type_name -> frdrpc.PeriodDrift
+	66, // 27: frdrpc.NodeAuditResponse.signature:type_name -> frdrpc.ReportSignature
+	66, // 28: frdrpc.CloseReportResponse.signature:type_name -> frdrpc.ReportSignature
+	35, // 29: frdrpc.CloseDryRunResponse.estimates:type_name -> frdrpc.CloseEstimate
+	36, // 30: frdrpc.CloseDryRunResponse.lost_flows:type_name -> frdrpc.LostFlow
+	39, // 31: frdrpc.ForwardingFailuresResponse.channels:type_name -> frdrpc.ChannelFailures
+	40, // 32: frdrpc.ChannelFailures.reasons:type_name -> frdrpc.FailureReason
+	43, // 33: frdrpc.PairFlowsResponse.buckets:type_name -> frdrpc.FlowBucket
+	45, // 34: frdrpc.PairFlowsResponse.channels:type_name -> frdrpc.ChannelFlow
+	44, // 35: frdrpc.FlowBucket.flows:type_name -> frdrpc.PairFlow
+	8,  // 36: frdrpc.ChannelFlow.role:type_name -> frdrpc.ChannelFlow.Role
+	0,  // 37: frdrpc.OpenRecommendationsRequest.fee_attribution:type_name -> frdrpc.FeeAttribution
+	48, // 38: frdrpc.OpenRecommendationsResponse.recommendations:type_name -> frdrpc.OpenRecommendation
+	51, // 39: frdrpc.PolicyHistoryResponse.channels:type_name -> frdrpc.ChannelPolicyHistory
+	52, // 40: frdrpc.ChannelPolicyHistory.periods:type_name -> frdrpc.PolicyPeriod
+	1,  // 41: frdrpc.BalanceSheetRequest.granularity:type_name -> frdrpc.Granularity
+	2,  // 42: frdrpc.BalanceSheetRequest.fiat_backend:type_name -> frdrpc.FiatBackend
+	25, // 43: frdrpc.BalanceSheetRequest.custom_prices:type_name -> frdrpc.BitcoinPrice
+	55, // 44: frdrpc.BalanceSheetResponse.on_chain:type_name -> frdrpc.Balance
+	55, // 45: frdrpc.BalanceSheetResponse.channels:type_name -> frdrpc.Balance
+	55, // 46: frdrpc.BalanceSheetResponse.pending_close:type_name -> frdrpc.Balance
+	55, // 47: frdrpc.BalanceSheetResponse.unsettled_htlcs:type_name -> frdrpc.Balance
+	55, // 48: frdrpc.BalanceSheetResponse.total:type_name -> frdrpc.Balance
+	25, // 49: frdrpc.BalanceSheetResponse.btc_price:type_name -> frdrpc.BitcoinPrice
+	57, // 50: frdrpc.AuditSummary.income:type_name -> frdrpc.SummarySection
+	57, // 51: frdrpc.AuditSummary.expenses:type_name -> frdrpc.SummarySection
+	57, // 52: frdrpc.AuditSummary.transfers:type_name -> frdrpc.SummarySection
+	58, // 53: frdrpc.SummarySection.lines:type_name -> frdrpc.SummaryLine
+	3,  // 54: frdrpc.SummaryLine.type:type_name -> frdrpc.EntryType
+	1,  // 55: frdrpc.ClosePeriodRequest.granularity:type_name -> frdrpc.Granularity
+	28, // 56: frdrpc.ClosePeriodRequest.custom_categories:type_name -> frdrpc.CustomCategory
+	2,  // 57: frdrpc.ClosePeriodRequest.fiat_backend:type_name -> frdrpc.FiatBackend
+	25, // 58: frdrpc.ClosePeriodRequest.custom_prices:type_name -> frdrpc.BitcoinPrice
+	63, // 59: frdrpc.ClosePeriodResponse.period:type_name -> frdrpc.ClosedPeriod
+	63, // 60: frdrpc.ListClosedPeriodsResponse.periods:type_name -> frdrpc.ClosedPeriod
+	25, // 61: frdrpc.ClosedPeriod.prices:type_name -> frdrpc.BitcoinPrice
+	63, // 62: frdrpc.PeriodDrift.period:type_name -> frdrpc.ClosedPeriod
+	65, // 63: frdrpc.PeriodDrift.drift:type_name -> frdrpc.EntryDrift
+	4,  // 64: frdrpc.EntryDrift.type:type_name -> frdrpc.DriftType
+	29, // 65: frdrpc.EntryDrift.frozen:type_name -> frdrpc.ReportEntry
+	29, // 66: frdrpc.EntryDrift.live:type_name -> frdrpc.ReportEntry
+	5,  // 67: frdrpc.JobStatusResponse.state:type_name -> frdrpc.JobState
+	29, // 68: frdrpc.JournalEntry.entry:type_name -> frdrpc.ReportEntry
+	19, // 69: frdrpc.RevenueReport.PairReportsEntry.value:type_name -> frdrpc.PairReport
+	10, // 70: frdrpc.FaradayServer.OutlierRecommendations:input_type -> frdrpc.OutlierRecommendationsRequest
+	11, // 71: frdrpc.FaradayServer.ThresholdRecommendations:input_type -> frdrpc.ThresholdRecommendationsRequest
+	15, // 72: frdrpc.FaradayServer.RevenueReport:input_type -> frdrpc.RevenueReportRequest
+	20, // 73: frdrpc.FaradayServer.ChannelInsights:input_type -> frdrpc.ChannelInsightsRequest
+	23, // 74: frdrpc.FaradayServer.ExchangeRate:input_type -> frdrpc.ExchangeRateRequest
+	27, // 75: frdrpc.FaradayServer.NodeAudit:input_type -> frdrpc.NodeAuditRequest
+	31, // 76: frdrpc.FaradayServer.CloseReport:input_type -> frdrpc.CloseReportRequest
+	33, // 77: frdrpc.FaradayServer.CloseDryRun:input_type -> frdrpc.CloseDryRunRequest
+	37, // 78: frdrpc.FaradayServer.ForwardingFailures:input_type -> frdrpc.ForwardingFailuresRequest
+	41, // 79: frdrpc.FaradayServer.PairFlows:input_type -> frdrpc.PairFlowsRequest
+	46, // 80: frdrpc.FaradayServer.OpenRecommendations:input_type -> frdrpc.OpenRecommendationsRequest
+	49, // 81: frdrpc.FaradayServer.PolicyHistory:input_type -> frdrpc.PolicyHistoryRequest
+	53, // 82: frdrpc.FaradayServer.BalanceSheet:input_type -> frdrpc.BalanceSheetRequest
+	59, // 83: frdrpc.FaradayServer.ClosePeriod:input_type -> frdrpc.ClosePeriodRequest
+	61, // 84: frdrpc.FaradayServer.ListClosedPeriods:input_type -> frdrpc.ListClosedPeriodsRequest
+	67, // 85: frdrpc.FaradayServer.ExportAuditData:input_type -> frdrpc.ExportAuditDataRequest
+	69, // 86: frdrpc.FaradayServer.BakeMacaroon:input_type -> frdrpc.BakeMacaroonRequest
+	27, // 87: frdrpc.FaradayServer.StartAudit:input_type -> frdrpc.NodeAuditRequest
+	72, // 88: frdrpc.FaradayServer.JobStatus:input_type -> frdrpc.JobStatusRequest
+	74, // 89: frdrpc.FaradayServer.CancelJob:input_type -> frdrpc.CancelJobRequest
+	76, // 90: frdrpc.FaradayServer.JobResult:input_type -> frdrpc.JobResultRequest
+	77, // 91: frdrpc.FaradayServer.SubscribeAlerts:input_type -> frdrpc.SubscribeAlertsRequest
+	79, // 92: frdrpc.FaradayServer.SubscribeEntries:input_type -> frdrpc.SubscribeEntriesRequest
+	12, // 93: frdrpc.FaradayServer.OutlierRecommendations:output_type -> frdrpc.CloseRecommendationsResponse
+	12, // 94: frdrpc.FaradayServer.ThresholdRecommendations:output_type -> frdrpc.CloseRecommendationsResponse
+	16, // 95: frdrpc.FaradayServer.RevenueReport:output_type -> frdrpc.RevenueReportResponse
+	21, // 96: frdrpc.FaradayServer.ChannelInsights:output_type -> frdrpc.ChannelInsightsResponse
+	24, // 97: frdrpc.FaradayServer.ExchangeRate:output_type -> frdrpc.ExchangeRateResponse
+	30, // 98: frdrpc.FaradayServer.NodeAudit:output_type -> frdrpc.NodeAuditResponse
+	32, // 99: frdrpc.FaradayServer.CloseReport:output_type -> frdrpc.CloseReportResponse
+	34, // 100: frdrpc.FaradayServer.CloseDryRun:output_type -> frdrpc.CloseDryRunResponse
+	38, // 101: frdrpc.FaradayServer.ForwardingFailures:output_type -> frdrpc.ForwardingFailuresResponse
+	42, // 102: frdrpc.FaradayServer.PairFlows:output_type -> frdrpc.PairFlowsResponse
+	47, // 103: frdrpc.FaradayServer.OpenRecommendations:output_type -> frdrpc.OpenRecommendationsResponse
+	50, // 104: frdrpc.FaradayServer.PolicyHistory:output_type -> frdrpc.PolicyHistoryResponse
+	54, // 105: frdrpc.FaradayServer.BalanceSheet:output_type -> frdrpc.BalanceSheetResponse
+	60, // 106: frdrpc.FaradayServer.ClosePeriod:output_type -> frdrpc.ClosePeriodResponse
+	62, // 107: frdrpc.FaradayServer.ListClosedPeriods:output_type -> frdrpc.ListClosedPeriodsResponse
+	68, // 108: frdrpc.FaradayServer.ExportAuditData:output_type -> frdrpc.ExportAuditDataResponse
+	70, // 109: frdrpc.FaradayServer.BakeMacaroon:output_type -> frdrpc.BakeMacaroonResponse
+	71, // 110: frdrpc.FaradayServer.StartAudit:output_type -> frdrpc.StartAuditResponse
+	73, // 111: frdrpc.FaradayServer.JobStatus:output_type -> frdrpc.JobStatusResponse
+	75, // 112: frdrpc.FaradayServer.CancelJob:output_type -> frdrpc.CancelJobResponse
+	30, // 113: frdrpc.FaradayServer.JobResult:output_type -> frdrpc.NodeAuditResponse
+	78, // 114: frdrpc.FaradayServer.SubscribeAlerts:output_type -> frdrpc.Alert
+	80, // 115: frdrpc.FaradayServer.SubscribeEntries:output_type -> frdrpc.JournalEntry
+	93, // [93:116] is the sub-list for method output_type
+	70, // [70:93] is the sub-list for method input_type
+	70, // [70:70] is the sub-list for extension type_name
+	70, // [70:70] is the sub-list for extension extendee
+	0,  // [0:70] is the sub-list for field type_name
+}
+
+func init() { file_faraday_proto_init() }
+func file_faraday_proto_init() {
+	if File_faraday_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_faraday_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*CloseRecommendationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*OutlierRecommendationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*ThresholdRecommendationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*CloseRecommendationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*OutlierBounds); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*Recommendation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*RevenueReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*RevenueReportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*UnattributedForward); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*RevenueReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*PairReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*ChannelInsightsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*ChannelInsightsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*ChannelInsight); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*ExchangeRateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*ExchangeRateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*BitcoinPrice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*ExchangeRate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*NodeAuditRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*CustomCategory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*ReportEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*NodeAuditResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*CloseReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*CloseReportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*CloseDryRunRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*CloseDryRunResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*CloseEstimate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*LostFlow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*ForwardingFailuresRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*ForwardingFailuresResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2326,8 +7949,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*OutlierRecommendationsRequest); i {
+		file_faraday_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*ChannelFailures); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2338,8 +7961,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*ThresholdRecommendationsRequest); i {
+		file_faraday_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*FailureReason); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2350,8 +7973,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*CloseRecommendationsResponse); i {
+		file_faraday_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*PairFlowsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2362,8 +7985,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*Recommendation); i {
+		file_faraday_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*PairFlowsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2374,8 +7997,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*RevenueReportRequest); i {
+		file_faraday_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*FlowBucket); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2386,8 +8009,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*RevenueReportResponse); i {
+		file_faraday_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*PairFlow); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2398,8 +8021,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*RevenueReport); i {
+		file_faraday_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*ChannelFlow); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2410,8 +8033,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*PairReport); i {
+		file_faraday_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*OpenRecommendationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2422,8 +8045,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*ChannelInsightsRequest); i {
+		file_faraday_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*OpenRecommendationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2434,8 +8057,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*ChannelInsightsResponse); i {
+		file_faraday_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*OpenRecommendation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2446,8 +8069,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*ChannelInsight); i {
+		file_faraday_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*PolicyHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2458,8 +8081,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*ExchangeRateRequest); i {
+		file_faraday_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*PolicyHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2470,8 +8093,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*ExchangeRateResponse); i {
+		file_faraday_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*ChannelPolicyHistory); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2482,8 +8105,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*BitcoinPrice); i {
+		file_faraday_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*PolicyPeriod); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2494,8 +8117,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*ExchangeRate); i {
+		file_faraday_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*BalanceSheetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2506,8 +8129,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*NodeAuditRequest); i {
+		file_faraday_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*BalanceSheetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2518,8 +8141,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*CustomCategory); i {
+		file_faraday_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*Balance); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2530,8 +8153,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*ReportEntry); i {
+		file_faraday_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*AuditSummary); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2542,8 +8165,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*NodeAuditResponse); i {
+		file_faraday_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*SummarySection); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2554,8 +8177,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[20].Exporter = func(v any, i int) any {
-			switch v := v.(*CloseReportRequest); i {
+		file_faraday_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*SummaryLine); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2566,8 +8189,260 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[21].Exporter = func(v any, i int) any {
-			switch v := v.(*CloseReportResponse); i {
+		file_faraday_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*ClosePeriodRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*ClosePeriodResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*ListClosedPeriodsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*ListClosedPeriodsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*ClosedPeriod); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*PeriodDrift); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*EntryDrift); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*ReportSignature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[58].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportAuditDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[59].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportAuditDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[60].Exporter = func(v any, i int) any {
+			switch v := v.(*BakeMacaroonRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[61].Exporter = func(v any, i int) any {
+			switch v := v.(*BakeMacaroonResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[62].Exporter = func(v any, i int) any {
+			switch v := v.(*StartAuditResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[63].Exporter = func(v any, i int) any {
+			switch v := v.(*JobStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[64].Exporter = func(v any, i int) any {
+			switch v := v.(*JobStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[65].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[66].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelJobResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[67].Exporter = func(v any, i int) any {
+			switch v := v.(*JobResultRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[68].Exporter = func(v any, i int) any {
+			switch v := v.(*SubscribeAlertsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[69].Exporter = func(v any, i int) any {
+			switch v := v.(*Alert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[70].Exporter = func(v any, i int) any {
+			switch v := v.(*SubscribeEntriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[71].Exporter = func(v any, i int) any {
+			switch v := v.(*JournalEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2584,8 +8459,8 @@ func file_faraday_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_faraday_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   23,
+			NumEnums:      9,
+			NumMessages:   73,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -62,6 +62,141 @@ type FaradayServerClient interface {
 	// Example request:
 	// http://localhost:8466/v1/faraday/closereport
 	CloseReport(ctx context.Context, in *CloseReportRequest, opts ...grpc.CallOption) (*CloseReportResponse, error)
+	// * frcli: `closedryrun`
+	// Simulate closing a set of channels, estimating the on chain fees we would
+	// pay to close them and the forwarding revenue we would lose.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/closedryrun?chan_points=txid:0
+	CloseDryRun(ctx context.Context, in *CloseDryRunRequest, opts ...grpc.CallOption) (*CloseDryRunResponse, error)
+	// * frcli: `failures`
+	// Get a report of the volume and fees that we missed due to forwards that
+	// failed at our node, grouped by outgoing channel and failure reason. Note
+	// that failures are only recorded while faraday is monitoring lnd's htlc
+	// event stream.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/failures
+	ForwardingFailures(ctx context.Context, in *ForwardingFailuresRequest, opts ...grpc.CallOption) (*ForwardingFailuresResponse, error)
+	// * frcli: `flows`
+	// Get a matrix of the liquidity that has flowed between pairs of channels,
+	// optionally split into time buckets, and a classification of each open
+	// channel as a source, sink, router or dormant channel.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/flows?bucket_seconds=86400
+	PairFlows(ctx context.Context, in *PairFlowsRequest, opts ...grpc.CallOption) (*PairFlowsResponse, error)
+	// * frcli: `openrecommendations`
+	// Get recommendations for peers that we should open additional channels
+	// with, based on the fees they have earned and the outgoing demand that
+	// we could not serve due to insufficient balance. Each recommendation
+	// includes a suggested channel size and the estimated number of days it
+	// would take for the channel to earn back its opening fee.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/openrecommendations
+	OpenRecommendations(ctx context.Context, in *OpenRecommendationsRequest, opts ...grpc.CallOption) (*OpenRecommendationsResponse, error)
+	// * frcli: `policyhistory`
+	// Get the history of the forwarding policies that we have set for our
+	// channels, with the volume and fees that each channel earned under each
+	// policy. Policies are recorded by faraday while it is running, so only
+	// changes that faraday observed are reported. Each channel's report
+	// includes an estimate of its fee elasticity, based on the change in its
+	// daily volume when its fee rate changed.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/policyhistory
+	PolicyHistory(ctx context.Context, in *PolicyHistoryRequest, opts ...grpc.CallOption) (*PolicyHistoryResponse, error)
+	// * frcli: `balancesheet`
+	// Get a balance sheet of our node's holdings at a point in time: our
+	// confirmed on chain balance, local channel balances, funds in pending
+	// closes and unsettled htlcs, in bitcoin and fiat. Since lnd only
+	// provides our current balances, past balances are reconstructed from
+	// our current balances and the audit entries between the time requested
+	// and the present.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/balancesheet?timestamp=1600000000
+	BalanceSheet(ctx context.Context, in *BalanceSheetRequest, opts ...grpc.CallOption) (*BalanceSheetResponse, error)
+	// * frcli: `closeperiod`
+	// Close a fiscal period, persisting a snapshot of its audit entries, the
+	// prices used to value them and the version of faraday that produced them,
+	// signed with our node's key. Audits that overlap with a closed period use
+	// its frozen entries, and report any drift from live data. Closed periods
+	// may not overlap.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/closeperiod
+	ClosePeriod(ctx context.Context, in *ClosePeriodRequest, opts ...grpc.CallOption) (*ClosePeriodResponse, error)
+	// * frcli: `listperiods`
+	// List the fiscal periods that have been closed.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/closedperiods
+	ListClosedPeriods(ctx context.Context, in *ListClosedPeriodsRequest, opts ...grpc.CallOption) (*ListClosedPeriodsResponse, error)
+	// * frcli: `exportaudit`
+	// Export all of the lnd data required to produce node audits for a period,
+	// so that audits can be reproduced offline using frcli offlineaudit without
+	// a connection to lnd.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/exportaudit
+	ExportAuditData(ctx context.Context, in *ExportAuditDataRequest, opts ...grpc.CallOption) (*ExportAuditDataResponse, error)
+	// * frcli: `bakemacaroon`
+	// Bake a macaroon that only grants access to the entities requested, for
+	// example a read only audit macaroon for a bookkeeper. Caveats can be added
+	// to restrict audits to a time range or to strip fiat values from the
+	// responses of the macaroon's requests.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/bakemacaroon
+	BakeMacaroon(ctx context.Context, in *BakeMacaroonRequest, opts ...grpc.CallOption) (*BakeMacaroonResponse, error)
+	// * frcli: `startaudit`
+	// Start producing a node audit in the background, returning a job ID that
+	// can be used to track its progress and fetch its result. Audit jobs keep
+	// running if the client disconnects, and their results are kept for
+	// faraday's job retention period.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/startaudit
+	StartAudit(ctx context.Context, in *NodeAuditRequest, opts ...grpc.CallOption) (*StartAuditResponse, error)
+	// * frcli: `jobstatus`
+	// Get the status and progress of a background job.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/jobstatus/{job_id}
+	JobStatus(ctx context.Context, in *JobStatusRequest, opts ...grpc.CallOption) (*JobStatusResponse, error)
+	// * frcli: `canceljob`
+	// Cancel a running background job.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/canceljob
+	CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*CancelJobResponse, error)
+	// * frcli: `jobresult`
+	// Get the result of an audit job that has completed successfully.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/jobresult/{job_id}
+	JobResult(ctx context.Context, in *JobResultRequest, opts ...grpc.CallOption) (*NodeAuditResponse, error)
+	// * frcli: `subscribealerts`
+	// Subscribe to the alerts raised by faraday's alert rules. Alerts are only
+	// raised once they are enabled with faraday's alerts options, and the stream
+	// only includes alerts raised after the subscription was created.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/alerts
+	SubscribeAlerts(ctx context.Context, in *SubscribeAlertsRequest, opts ...grpc.CallOption) (FaradayServer_SubscribeAlertsClient, error)
+	// * frcli: `subscribeentries`
+	// Subscribe to the accounting entries recorded by faraday's journal as
+	// invoices settle, payments complete, forwards happen and on chain
+	// transactions confirm, with their fiat values. Entries are only recorded
+	// once the journal is enabled with faraday's journal options. Each entry has
+	// a sequence number, so clients can resume the stream after the last entry
+	// they received without missing any entries.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/entries?after_sequence=10
+	SubscribeEntries(ctx context.Context, in *SubscribeEntriesRequest, opts ...grpc.CallOption) (FaradayServer_SubscribeEntriesClient, error)
 }
 
 type faradayServerClient struct {
@@ -135,6 +270,196 @@ func (c *faradayServerClient) CloseReport(ctx context.Context, in *CloseReportRe
 	return out, nil
 }
 
+func (c *faradayServerClient) CloseDryRun(ctx context.Context, in *CloseDryRunRequest, opts ...grpc.CallOption) (*CloseDryRunResponse, error) {
+	out := new(CloseDryRunResponse)
+	err := c.cc.Invoke(ctx, "/frdrpc.FaradayServer/CloseDryRun", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faradayServerClient) ForwardingFailures(ctx context.Context, in *ForwardingFailuresRequest, opts ...grpc.CallOption) (*ForwardingFailuresResponse, error) {
+	out := new(ForwardingFailuresResponse)
+	err := c.cc.Invoke(ctx, "/frdrpc.FaradayServer/ForwardingFailures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faradayServerClient) PairFlows(ctx context.Context, in *PairFlowsRequest, opts ...grpc.CallOption) (*PairFlowsResponse, error) {
+	out := new(PairFlowsResponse)
+	err := c.cc.Invoke(ctx, "/frdrpc.FaradayServer/PairFlows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faradayServerClient) OpenRecommendations(ctx context.Context, in *OpenRecommendationsRequest, opts ...grpc.CallOption) (*OpenRecommendationsResponse, error) {
+	out := new(OpenRecommendationsResponse)
+	err := c.cc.Invoke(ctx, "/frdrpc.FaradayServer/OpenRecommendations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faradayServerClient) PolicyHistory(ctx context.Context, in *PolicyHistoryRequest, opts ...grpc.CallOption) (*PolicyHistoryResponse, error) {
+	out := new(PolicyHistoryResponse)
+	err := c.cc.Invoke(ctx, "/frdrpc.FaradayServer/PolicyHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faradayServerClient) BalanceSheet(ctx context.Context, in *BalanceSheetRequest, opts ...grpc.CallOption) (*BalanceSheetResponse, error) {
+	out := new(BalanceSheetResponse)
+	err := c.cc.Invoke(ctx, "/frdrpc.FaradayServer/BalanceSheet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faradayServerClient) ClosePeriod(ctx context.Context, in *ClosePeriodRequest, opts ...grpc.CallOption) (*ClosePeriodResponse, error) {
+	out := new(ClosePeriodResponse)
+	err := c.cc.Invoke(ctx, "/frdrpc.FaradayServer/ClosePeriod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faradayServerClient) ListClosedPeriods(ctx context.Context, in *ListClosedPeriodsRequest, opts ...grpc.CallOption) (*ListClosedPeriodsResponse, error) {
+	out := new(ListClosedPeriodsResponse)
+	err := c.cc.Invoke(ctx, "/frdrpc.FaradayServer/ListClosedPeriods", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faradayServerClient) ExportAuditData(ctx context.Context, in *ExportAuditDataRequest, opts ...grpc.CallOption) (*ExportAuditDataResponse, error) {
+	out := new(ExportAuditDataResponse)
+	err := c.cc.Invoke(ctx, "/frdrpc.FaradayServer/ExportAuditData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faradayServerClient) BakeMacaroon(ctx context.Context, in *BakeMacaroonRequest, opts ...grpc.CallOption) (*BakeMacaroonResponse, error) {
+	out := new(BakeMacaroonResponse)
+	err := c.cc.Invoke(ctx, "/frdrpc.FaradayServer/BakeMacaroon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faradayServerClient) StartAudit(ctx context.Context, in *NodeAuditRequest, opts ...grpc.CallOption) (*StartAuditResponse, error) {
+	out := new(StartAuditResponse)
+	err := c.cc.Invoke(ctx, "/frdrpc.FaradayServer/StartAudit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faradayServerClient) JobStatus(ctx context.Context, in *JobStatusRequest, opts ...grpc.CallOption) (*JobStatusResponse, error) {
+	out := new(JobStatusResponse)
+	err := c.cc.Invoke(ctx, "/frdrpc.FaradayServer/JobStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faradayServerClient) CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*CancelJobResponse, error) {
+	out := new(CancelJobResponse)
+	err := c.cc.Invoke(ctx, "/frdrpc.FaradayServer/CancelJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faradayServerClient) JobResult(ctx context.Context, in *JobResultRequest, opts ...grpc.CallOption) (*NodeAuditResponse, error) {
+	out := new(NodeAuditResponse)
+	err := c.cc.Invoke(ctx, "/frdrpc.FaradayServer/JobResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faradayServerClient) SubscribeAlerts(ctx context.Context, in *SubscribeAlertsRequest, opts ...grpc.CallOption) (FaradayServer_SubscribeAlertsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &FaradayServer_ServiceDesc.Streams[0], "/frdrpc.FaradayServer/SubscribeAlerts", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &faradayServerSubscribeAlertsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FaradayServer_SubscribeAlertsClient interface {
+	Recv() (*Alert, error)
+	grpc.ClientStream
+}
+
+type faradayServerSubscribeAlertsClient struct {
+	grpc.ClientStream
+}
+
+func (x *faradayServerSubscribeAlertsClient) Recv() (*Alert, error) {
+	m := new(Alert)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *faradayServerClient) SubscribeEntries(ctx context.Context, in *SubscribeEntriesRequest, opts ...grpc.CallOption) (FaradayServer_SubscribeEntriesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &FaradayServer_ServiceDesc.Streams[1], "/frdrpc.FaradayServer/SubscribeEntries", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &faradayServerSubscribeEntriesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FaradayServer_SubscribeEntriesClient interface {
+	Recv() (*JournalEntry, error)
+	grpc.ClientStream
+}
+
+type faradayServerSubscribeEntriesClient struct {
+	grpc.ClientStream
+}
+
+func (x *faradayServerSubscribeEntriesClient) Recv() (*JournalEntry, error) {
+	m := new(JournalEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // FaradayServerServer is the server API for FaradayServer service.
 // All implementations must embed UnimplementedFaradayServerServer
 // for forward compatibility
@@ -183,6 +508,141 @@ type FaradayServerServer interface {
 	// Example request:
 	// http://localhost:8466/v1/faraday/closereport
 	CloseReport(context.Context, *CloseReportRequest) (*CloseReportResponse, error)
+	// * frcli: `closedryrun`
+	// Simulate closing a set of channels, estimating the on chain fees we would
+	// pay to close them and the forwarding revenue we would lose.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/closedryrun?chan_points=txid:0
+	CloseDryRun(context.Context, *CloseDryRunRequest) (*CloseDryRunResponse, error)
+	// * frcli: `failures`
+	// Get a report of the volume and fees that we missed due to forwards that
+	// failed at our node, grouped by outgoing channel and failure reason. Note
+	// that failures are only recorded while faraday is monitoring lnd's htlc
+	// event stream.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/failures
+	ForwardingFailures(context.Context, *ForwardingFailuresRequest) (*ForwardingFailuresResponse, error)
+	// * frcli: `flows`
+	// Get a matrix of the liquidity that has flowed between pairs of channels,
+	// optionally split into time buckets, and a classification of each open
+	// channel as a source, sink, router or dormant channel.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/flows?bucket_seconds=86400
+	PairFlows(context.Context, *PairFlowsRequest) (*PairFlowsResponse, error)
+	// * frcli: `openrecommendations`
+	// Get recommendations for peers that we should open additional channels
+	// with, based on the fees they have earned and the outgoing demand that
+	// we could not serve due to insufficient balance. Each recommendation
+	// includes a suggested channel size and the estimated number of days it
+	// would take for the channel to earn back its opening fee.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/openrecommendations
+	OpenRecommendations(context.Context, *OpenRecommendationsRequest) (*OpenRecommendationsResponse, error)
+	// * frcli: `policyhistory`
+	// Get the history of the forwarding policies that we have set for our
+	// channels, with the volume and fees that each channel earned under each
+	// policy. Policies are recorded by faraday while it is running, so only
+	// changes that faraday observed are reported. Each channel's report
+	// includes an estimate of its fee elasticity, based on the change in its
+	// daily volume when its fee rate changed.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/policyhistory
+	PolicyHistory(context.Context, *PolicyHistoryRequest) (*PolicyHistoryResponse, error)
+	// * frcli: `balancesheet`
+	// Get a balance sheet of our node's holdings at a point in time: our
+	// confirmed on chain balance, local channel balances, funds in pending
+	// closes and unsettled htlcs, in bitcoin and fiat. Since lnd only
+	// provides our current balances, past balances are reconstructed from
+	// our current balances and the audit entries between the time requested
+	// and the present.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/balancesheet?timestamp=1600000000
+	BalanceSheet(context.Context, *BalanceSheetRequest) (*BalanceSheetResponse, error)
+	// * frcli: `closeperiod`
+	// Close a fiscal period, persisting a snapshot of its audit entries, the
+	// prices used to value them and the version of faraday that produced them,
+	// signed with our node's key. Audits that overlap with a closed period use
+	// its frozen entries, and report any drift from live data. Closed periods
+	// may not overlap.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/closeperiod
+	ClosePeriod(context.Context, *ClosePeriodRequest) (*ClosePeriodResponse, error)
+	// * frcli: `listperiods`
+	// List the fiscal periods that have been closed.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/closedperiods
+	ListClosedPeriods(context.Context, *ListClosedPeriodsRequest) (*ListClosedPeriodsResponse, error)
+	// * frcli: `exportaudit`
+	// Export all of the lnd data required to produce node audits for a period,
+	// so that audits can be reproduced offline using frcli offlineaudit without
+	// a connection to lnd.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/exportaudit
+	ExportAuditData(context.Context, *ExportAuditDataRequest) (*ExportAuditDataResponse, error)
+	// * frcli: `bakemacaroon`
+	// Bake a macaroon that only grants access to the entities requested, for
+	// example a read only audit macaroon for a bookkeeper. Caveats can be added
+	// to restrict audits to a time range or to strip fiat values from the
+	// responses of the macaroon's requests.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/bakemacaroon
+	BakeMacaroon(context.Context, *BakeMacaroonRequest) (*BakeMacaroonResponse, error)
+	// * frcli: `startaudit`
+	// Start producing a node audit in the background, returning a job ID that
+	// can be used to track its progress and fetch its result. Audit jobs keep
+	// running if the client disconnects, and their results are kept for
+	// faraday's job retention period.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/startaudit
+	StartAudit(context.Context, *NodeAuditRequest) (*StartAuditResponse, error)
+	// * frcli: `jobstatus`
+	// Get the status and progress of a background job.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/jobstatus/{job_id}
+	JobStatus(context.Context, *JobStatusRequest) (*JobStatusResponse, error)
+	// * frcli: `canceljob`
+	// Cancel a running background job.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/canceljob
+	CancelJob(context.Context, *CancelJobRequest) (*CancelJobResponse, error)
+	// * frcli: `jobresult`
+	// Get the result of an audit job that has completed successfully.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/jobresult/{job_id}
+	JobResult(context.Context, *JobResultRequest) (*NodeAuditResponse, error)
+	// * frcli: `subscribealerts`
+	// Subscribe to the alerts raised by faraday's alert rules. Alerts are only
+	// raised once they are enabled with faraday's alerts options, and the stream
+	// only includes alerts raised after the subscription was created.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/alerts
+	SubscribeAlerts(*SubscribeAlertsRequest, FaradayServer_SubscribeAlertsServer) error
+	// * frcli: `subscribeentries`
+	// Subscribe to the accounting entries recorded by faraday's journal as
+	// invoices settle, payments complete, forwards happen and on chain
+	// transactions confirm, with their fiat values. Entries are only recorded
+	// once the journal is enabled with faraday's journal options. Each entry has
+	// a sequence number, so clients can resume the stream after the last entry
+	// they received without missing any entries.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/entries?after_sequence=10
+	SubscribeEntries(*SubscribeEntriesRequest, FaradayServer_SubscribeEntriesServer) error
 	mustEmbedUnimplementedFaradayServerServer()
 }
 
@@ -211,6 +671,54 @@ func (UnimplementedFaradayServerServer) NodeAudit(context.Context, *NodeAuditReq
 func (UnimplementedFaradayServerServer) CloseReport(context.Context, *CloseReportRequest) (*CloseReportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CloseReport not implemented")
 }
+func (UnimplementedFaradayServerServer) CloseDryRun(context.Context, *CloseDryRunRequest) (*CloseDryRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseDryRun not implemented")
+}
+func (UnimplementedFaradayServerServer) ForwardingFailures(context.Context, *ForwardingFailuresRequest) (*ForwardingFailuresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForwardingFailures not implemented")
+}
+func (UnimplementedFaradayServerServer) PairFlows(context.Context, *PairFlowsRequest) (*PairFlowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PairFlows not implemented")
+}
+func (UnimplementedFaradayServerServer) OpenRecommendations(context.Context, *OpenRecommendationsRequest) (*OpenRecommendationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenRecommendations not implemented")
+}
+func (UnimplementedFaradayServerServer) PolicyHistory(context.Context, *PolicyHistoryRequest) (*PolicyHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PolicyHistory not implemented")
+}
+func (UnimplementedFaradayServerServer) BalanceSheet(context.Context, *BalanceSheetRequest) (*BalanceSheetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BalanceSheet not implemented")
+}
+func (UnimplementedFaradayServerServer) ClosePeriod(context.Context, *ClosePeriodRequest) (*ClosePeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClosePeriod not implemented")
+}
+func (UnimplementedFaradayServerServer) ListClosedPeriods(context.Context, *ListClosedPeriodsRequest) (*ListClosedPeriodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClosedPeriods not implemented")
+}
+func (UnimplementedFaradayServerServer) ExportAuditData(context.Context, *ExportAuditDataRequest) (*ExportAuditDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAuditData not implemented")
+}
+func (UnimplementedFaradayServerServer) BakeMacaroon(context.Context, *BakeMacaroonRequest) (*BakeMacaroonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BakeMacaroon not implemented")
+}
+func (UnimplementedFaradayServerServer) StartAudit(context.Context, *NodeAuditRequest) (*StartAuditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartAudit not implemented")
+}
+func (UnimplementedFaradayServerServer) JobStatus(context.Context, *JobStatusRequest) (*JobStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JobStatus not implemented")
+}
+func (UnimplementedFaradayServerServer) CancelJob(context.Context, *CancelJobRequest) (*CancelJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJob not implemented")
+}
+func (UnimplementedFaradayServerServer) JobResult(context.Context, *JobResultRequest) (*NodeAuditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JobResult not implemented")
+}
+func (UnimplementedFaradayServerServer) SubscribeAlerts(*SubscribeAlertsRequest, FaradayServer_SubscribeAlertsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeAlerts not implemented")
+}
+func (UnimplementedFaradayServerServer) SubscribeEntries(*SubscribeEntriesRequest, FaradayServer_SubscribeEntriesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeEntries not implemented")
+}
 func (UnimplementedFaradayServerServer) mustEmbedUnimplementedFaradayServerServer() {}
 
 // UnsafeFaradayServerServer may be embedded to opt out of forward compatibility for this service.
@@ -350,6 +858,300 @@ func _FaradayServer_CloseReport_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FaradayServer_CloseDryRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseDryRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaradayServerServer).CloseDryRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/frdrpc.FaradayServer/CloseDryRun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaradayServerServer).CloseDryRun(ctx, req.(*CloseDryRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaradayServer_ForwardingFailures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForwardingFailuresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaradayServerServer).ForwardingFailures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/frdrpc.FaradayServer/ForwardingFailures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaradayServerServer).ForwardingFailures(ctx, req.(*ForwardingFailuresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaradayServer_PairFlows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PairFlowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaradayServerServer).PairFlows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/frdrpc.FaradayServer/PairFlows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaradayServerServer).PairFlows(ctx, req.(*PairFlowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaradayServer_OpenRecommendations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenRecommendationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaradayServerServer).OpenRecommendations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/frdrpc.FaradayServer/OpenRecommendations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaradayServerServer).OpenRecommendations(ctx, req.(*OpenRecommendationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaradayServer_PolicyHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaradayServerServer).PolicyHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/frdrpc.FaradayServer/PolicyHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaradayServerServer).PolicyHistory(ctx, req.(*PolicyHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaradayServer_BalanceSheet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BalanceSheetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaradayServerServer).BalanceSheet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/frdrpc.FaradayServer/BalanceSheet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaradayServerServer).BalanceSheet(ctx, req.(*BalanceSheetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaradayServer_ClosePeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClosePeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaradayServerServer).ClosePeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/frdrpc.FaradayServer/ClosePeriod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaradayServerServer).ClosePeriod(ctx, req.(*ClosePeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaradayServer_ListClosedPeriods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClosedPeriodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaradayServerServer).ListClosedPeriods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/frdrpc.FaradayServer/ListClosedPeriods",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaradayServerServer).ListClosedPeriods(ctx, req.(*ListClosedPeriodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaradayServer_ExportAuditData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAuditDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaradayServerServer).ExportAuditData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/frdrpc.FaradayServer/ExportAuditData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaradayServerServer).ExportAuditData(ctx, req.(*ExportAuditDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaradayServer_BakeMacaroon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BakeMacaroonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaradayServerServer).BakeMacaroon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/frdrpc.FaradayServer/BakeMacaroon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaradayServerServer).BakeMacaroon(ctx, req.(*BakeMacaroonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaradayServer_StartAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeAuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaradayServerServer).StartAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/frdrpc.FaradayServer/StartAudit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaradayServerServer).StartAudit(ctx, req.(*NodeAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaradayServer_JobStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaradayServerServer).JobStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/frdrpc.FaradayServer/JobStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaradayServerServer).JobStatus(ctx, req.(*JobStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaradayServer_CancelJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaradayServerServer).CancelJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/frdrpc.FaradayServer/CancelJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaradayServerServer).CancelJob(ctx, req.(*CancelJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaradayServer_JobResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaradayServerServer).JobResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/frdrpc.FaradayServer/JobResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaradayServerServer).JobResult(ctx, req.(*JobResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaradayServer_SubscribeAlerts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeAlertsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FaradayServerServer).SubscribeAlerts(m, &faradayServerSubscribeAlertsServer{stream})
+}
+
+type FaradayServer_SubscribeAlertsServer interface {
+	Send(*Alert) error
+	grpc.ServerStream
+}
+
+type faradayServerSubscribeAlertsServer struct {
+	grpc.ServerStream
+}
+
+func (x *faradayServerSubscribeAlertsServer) Send(m *Alert) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _FaradayServer_SubscribeEntries_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeEntriesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FaradayServerServer).SubscribeEntries(m, &faradayServerSubscribeEntriesServer{stream})
+}
+
+type FaradayServer_SubscribeEntriesServer interface {
+	Send(*JournalEntry) error
+	grpc.ServerStream
+}
+
+type faradayServerSubscribeEntriesServer struct {
+	grpc.ServerStream
+}
+
+func (x *faradayServerSubscribeEntriesServer) Send(m *JournalEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // FaradayServer_ServiceDesc is the grpc.ServiceDesc for FaradayServer service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -385,7 +1187,74 @@ var FaradayServer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CloseReport",
 			Handler:    _FaradayServer_CloseReport_Handler,
 		},
+		{
+			MethodName: "CloseDryRun",
+			Handler:    _FaradayServer_CloseDryRun_Handler,
+		},
+		{
+			MethodName: "ForwardingFailures",
+			Handler:    _FaradayServer_ForwardingFailures_Handler,
+		},
+		{
+			MethodName: "PairFlows",
+			Handler:    _FaradayServer_PairFlows_Handler,
+		},
+		{
+			MethodName: "OpenRecommendations",
+			Handler:    _FaradayServer_OpenRecommendations_Handler,
+		},
+		{
+			MethodName: "PolicyHistory",
+			Handler:    _FaradayServer_PolicyHistory_Handler,
+		},
+		{
+			MethodName: "BalanceSheet",
+			Handler:    _FaradayServer_BalanceSheet_Handler,
+		},
+		{
+			MethodName: "ClosePeriod",
+			Handler:    _FaradayServer_ClosePeriod_Handler,
+		},
+		{
+			MethodName: "ListClosedPeriods",
+			Handler:    _FaradayServer_ListClosedPeriods_Handler,
+		},
+		{
+			MethodName: "ExportAuditData",
+			Handler:    _FaradayServer_ExportAuditData_Handler,
+		},
+		{
+			MethodName: "BakeMacaroon",
+			Handler:    _FaradayServer_BakeMacaroon_Handler,
+		},
+		{
+			MethodName: "StartAudit",
+			Handler:    _FaradayServer_StartAudit_Handler,
+		},
+		{
+			MethodName: "JobStatus",
+			Handler:    _FaradayServer_JobStatus_Handler,
+		},
+		{
+			MethodName: "CancelJob",
+			Handler:    _FaradayServer_CancelJob_Handler,
+		},
+		{
+			MethodName: "JobResult",
+			Handler:    _FaradayServer_JobResult_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeAlerts",
+			Handler:       _FaradayServer_SubscribeAlerts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeEntries",
+			Handler:       _FaradayServer_SubscribeEntries_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "faraday.proto",
 }
@@ -0,0 +1,76 @@
+package frdrpcserver
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/lightninglabs/faraday/alerts"
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/lightninglabs/faraday/insights"
+	"github.com/lightninglabs/faraday/revenue"
+)
+
+var (
+	// errAlertsDisabled is returned if alerts are subscribed to when no
+	// alert rules are enabled.
+	errAlertsDisabled = errors.New("no alert rules enabled, set " +
+		"faraday's alerts options to enable them")
+
+	// errAlertsStopped is returned to alert subscribers when we stop
+	// evaluating our alert rules.
+	errAlertsStopped = errors.New("alerts stopped, faraday is shutting " +
+		"down")
+)
+
+// startAlertManager starts evaluating our alert rules, if any are enabled.
+func (s *RPCServer) startAlertManager() error {
+	if s.cfg.Alerts == nil || !s.cfg.Alerts.Enabled() {
+		return nil
+	}
+
+	ctx := context.Background()
+	manager, err := alerts.NewManager(&alerts.ManagerConfig{
+		Rules: s.cfg.Alerts,
+		ChannelInsights: func() ([]*insights.ChannelInfo, error) {
+			return channelInsights(
+				ctx, s.cfg, revenue.AttributeSplit,
+			)
+		},
+		RevenueReport: func(start, end time.Time) (*revenue.Report,
+			error) {
+
+			revenueCfg := getRevenueConfig(ctx, s.cfg, start, end)
+			revenueCfg.FeeAttribution = revenue.AttributeSplit
+
+			return revenue.GetRevenueReport(revenueCfg)
+		},
+		MinimumMonitored: s.cfg.MinimumMonitored,
+		Notifiers:        alerts.NewNotifiers(s.cfg.Alerts),
+	})
+	if err != nil {
+		return err
+	}
+
+	s.alertManager = manager
+
+	return s.alertManager.Start()
+}
+
+// stopAlertManager stops evaluating our alert rules.
+func (s *RPCServer) stopAlertManager() {
+	if s.alertManager != nil {
+		s.alertManager.Stop()
+		s.alertManager = nil
+	}
+}
+
+// rpcAlert converts an alert to an rpc alert.
+func rpcAlert(alert *alerts.Alert) *frdrpc.Alert {
+	return &frdrpc.Alert{
+		Rule:      alert.Rule,
+		ChanPoint: alert.ChannelPoint,
+		Message:   alert.Message,
+		Timestamp: uint64(alert.Timestamp.Unix()),
+	}
+}
@@ -0,0 +1,132 @@
+package frdrpcserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/lightninglabs/faraday/accounting"
+	"github.com/lightninglabs/faraday/archive"
+	"github.com/lightninglabs/faraday/lndwrap"
+	"github.com/lightninglabs/lndclient"
+)
+
+// startArchiver creates our archive store and starts archiving lnd's
+// invoices, payments and forwards, unless archiving is disabled. Our
+// database must be opened before the archiver is started.
+func (s *RPCServer) startArchiver() error {
+	store, err := archive.NewStore(s.faradayDB)
+	if err != nil {
+		return err
+	}
+	s.archiveStore = store
+
+	if s.cfg.DisableArchive {
+		log.Info("Archive disabled, lnd data will not be archived")
+
+		return nil
+	}
+
+	s.archiver = archive.NewArchiver(&archive.ArchiverConfig{
+		ListInvoices: func(ctx context.Context, offset uint64) (
+			[]lndclient.Invoice, error) {
+
+			return lndwrap.ListInvoices(
+				ctx, offset, uint64(maxInvoiceQueries),
+				s.cfg.Lnd.Client,
+			)
+		},
+		ListPayments: func(ctx context.Context, offset uint64) (
+			[]lndclient.Payment, error) {
+
+			return lndwrap.ListAllPayments(
+				ctx, offset, uint64(maxPaymentQueries),
+				s.cfg.Lnd.Client,
+			)
+		},
+		ListForwards: func(ctx context.Context, startTime time.Time) (
+			[]lndclient.ForwardingEvent, error) {
+
+			return lndwrap.ListForwards(
+				ctx, uint64(maxForwardQueries), startTime,
+				time.Now(), s.cfg.Lnd.Client,
+			)
+		},
+		Store: store,
+	})
+
+	return s.archiver.Start()
+}
+
+// stopArchiver stops archiving lnd's data.
+func (s *RPCServer) stopArchiver() {
+	if s.archiver != nil {
+		s.archiver.Stop()
+		s.archiver = nil
+	}
+}
+
+// mergeArchive wraps the queries of an off chain config so that they include
+// the invoices, payments and forwards in our archive, keeping audits complete
+// after lnd has deleted data. We only read the archived items that settled
+// within the config's range. If we do not have an archive, the config is
+// unchanged.
+func mergeArchive(cfg *accounting.OffChainConfig, store *archive.Store) {
+	if store == nil {
+		return
+	}
+
+	listInvoices := cfg.ListInvoices
+	cfg.ListInvoices = func(ctx context.Context) ([]lndclient.Invoice,
+		error) {
+
+		live, err := listInvoices(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		archived, err := store.ListInvoices(
+			cfg.StartTime, cfg.EndTime,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return archive.MergeInvoices(live, archived), nil
+	}
+
+	listPayments := cfg.ListPayments
+	cfg.ListPayments = func(ctx context.Context) ([]lndclient.Payment,
+		error) {
+
+		live, err := listPayments(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		archived, err := store.ListPayments(
+			cfg.StartTime, cfg.EndTime,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return archive.MergePayments(live, archived), nil
+	}
+
+	listForwards := cfg.ListForwards
+	cfg.ListForwards = func(ctx context.Context) (
+		[]lndclient.ForwardingEvent, error) {
+
+		live, err := listForwards(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		archived, err := store.ListForwards(cfg.StartTime, cfg.EndTime)
+		if err != nil {
+			return nil, err
+		}
+
+		return archive.MergeForwards(live, archived), nil
+	}
+}
@@ -0,0 +1,413 @@
+package frdrpcserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/lightninglabs/faraday/frdrpcserver/perms"
+	"github.com/lightningnetwork/lnd/macaroons"
+	"google.golang.org/grpc/metadata"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+	"gopkg.in/macaroon.v2"
+)
+
+const (
+	// auditRangeCaveat is the name of the custom caveat that restricts
+	// the time range that a macaroon may request audits for. Its condition
+	// is the start and end unix time of the range, separated by a dash. An
+	// end time of zero allows audits until the present.
+	auditRangeCaveat = "faraday-audit-range"
+
+	// stripFiatCaveat is the name of the custom caveat that strips fiat
+	// values from the responses of a macaroon's requests.
+	stripFiatCaveat = "faraday-strip-fiat"
+
+	// readAction is the action of the permissions that only read data.
+	readAction = "read"
+
+	// noFiat is the value of fiat amounts in audits that are produced
+	// without fiat values.
+	noFiat = "0"
+)
+
+var (
+	// errMacaroonServiceUnavailable is returned if we are asked to bake a
+	// macaroon when faraday is not running its own macaroon service.
+	errMacaroonServiceUnavailable = errors.New("macaroon service not " +
+		"available")
+
+	// errNoEntities is returned if a macaroon is requested without any
+	// permission entities.
+	errNoEntities = errors.New("at least one entity required")
+
+	// errAuditRangeDenied is returned if a macaroon requests an audit
+	// outside of the range that it is restricted to.
+	errAuditRangeDenied = errors.New("requested time range is outside " +
+		"of the macaroon's audit range")
+
+	// errEmptyAuditRange is returned if the audit range caveats of a
+	// macaroon do not have any time in common.
+	errEmptyAuditRange = errors.New("macaroon audit ranges do not " +
+		"overlap")
+
+	// errFiatDenied is returned if a macaroon that has its fiat values
+	// stripped requests fiat data.
+	errFiatDenied = errors.New("macaroon does not permit fiat data")
+)
+
+// caveatAcceptor accepts faraday's custom caveats, which are enforced by
+// the rpcs that they apply to.
+type caveatAcceptor struct{}
+
+// CustomCaveatSupported returns nil if we enforce the custom caveat provided.
+func (caveatAcceptor) CustomCaveatSupported(name string) error {
+	switch name {
+	case auditRangeCaveat, stripFiatCaveat:
+		return nil
+
+	default:
+		return fmt.Errorf("unsupported custom caveat: %v", name)
+	}
+}
+
+// bakeMacaroon bakes a macaroon with the permissions and caveats requested.
+func bakeMacaroon(ctx context.Context, service *macaroons.Service,
+	req *frdrpc.BakeMacaroonRequest) (string, error) {
+
+	ops, err := macaroonOps(req.Entities, req.ReadOnly)
+	if err != nil {
+		return "", err
+	}
+
+	var constraints []macaroons.Constraint
+
+	if req.AuditStartTime != 0 || req.AuditEndTime != 0 {
+		if req.AuditEndTime != 0 &&
+			req.AuditEndTime <= req.AuditStartTime {
+
+			return "", errors.New("audit end time must be after " +
+				"start time")
+		}
+
+		constraints = append(constraints, macaroons.CustomConstraint(
+			auditRangeCaveat, fmt.Sprintf("%d-%d",
+				req.AuditStartTime, req.AuditEndTime),
+		))
+	}
+
+	if req.StripFiat {
+		constraints = append(constraints, macaroons.CustomConstraint(
+			stripFiatCaveat, "",
+		))
+	}
+
+	rootKeyID := []byte(strconv.FormatUint(req.RootKeyId, 10))
+	mac, err := service.NewMacaroon(ctx, rootKeyID, ops...)
+	if err != nil {
+		return "", err
+	}
+
+	restricted, err := macaroons.AddConstraints(mac.M(), constraints...)
+	if err != nil {
+		return "", err
+	}
+
+	macBytes, err := restricted.MarshalBinary()
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(macBytes), nil
+}
+
+// macaroonOps returns all of the permissions that faraday requires for the
+// entities provided, optionally limited to read permissions.
+func macaroonOps(entities []string, readOnly bool) ([]bakery.Op, error) {
+	if len(entities) == 0 {
+		return nil, errNoEntities
+	}
+
+	known := make(map[string][]bakery.Op)
+	for _, methodOps := range perms.RequiredPermissions {
+		for _, op := range methodOps {
+			if !containsOp(known[op.Entity], op) {
+				known[op.Entity] = append(known[op.Entity], op)
+			}
+		}
+	}
+
+	var ops []bakery.Op
+	for _, entity := range entities {
+		entityOps, ok := known[entity]
+		if !ok {
+			return nil, fmt.Errorf("unknown entity: %v, expected "+
+				"one of: %v", entity, knownEntities(known))
+		}
+
+		for _, op := range entityOps {
+			if readOnly && op.Action != readAction {
+				continue
+			}
+
+			if !containsOp(ops, op) {
+				ops = append(ops, op)
+			}
+		}
+	}
+
+	if len(ops) == 0 {
+		return nil, fmt.Errorf("entities: %v have no read permissions",
+			entities)
+	}
+
+	// Sort our permissions so that they are baked deterministically.
+	sort.Slice(ops, func(i, j int) bool {
+		if ops[i].Entity != ops[j].Entity {
+			return ops[i].Entity < ops[j].Entity
+		}
+
+		return ops[i].Action < ops[j].Action
+	})
+
+	return ops, nil
+}
+
+// containsOp returns a boolean indicating whether a set of permissions
+// contains the permission provided.
+func containsOp(ops []bakery.Op, op bakery.Op) bool {
+	for _, existing := range ops {
+		if existing == op {
+			return true
+		}
+	}
+
+	return false
+}
+
+// knownEntities returns the sorted names of a set of entities.
+func knownEntities(known map[string][]bakery.Op) string {
+	names := make([]string, 0, len(known))
+	for entity := range known {
+		names = append(names, entity)
+	}
+	sort.Strings(names)
+
+	return strings.Join(names, ", ")
+}
+
+// macaroonRestrictions holds the restrictions that a request's macaroon
+// places on it with faraday's custom caveats.
+type macaroonRestrictions struct {
+	// auditStart is the unix time from which audits may be requested.
+	auditStart uint64
+
+	// auditEnd is the unix time until which audits may be requested, zero
+	// if audits may be requested until the present.
+	auditEnd uint64
+
+	// auditRange is true if audits are restricted to a range.
+	auditRange bool
+
+	// stripFiat is true if fiat values must be stripped from responses.
+	stripFiat bool
+}
+
+// restrictionsFromContext returns the restrictions that the macaroon of a
+// request places on it. The macaroon has already been validated by our
+// interceptor, so we only need to read its caveats. Requests that do not
+// have a macaroon are not restricted.
+func restrictionsFromContext(ctx context.Context) (*macaroonRestrictions,
+	error) {
+
+	restrictions := &macaroonRestrictions{}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return restrictions, nil
+	}
+
+	macHex := md.Get("macaroon")
+	if len(macHex) == 0 {
+		return restrictions, nil
+	}
+
+	macBytes, err := hex.DecodeString(macHex[0])
+	if err != nil {
+		return nil, err
+	}
+
+	mac := &macaroon.Macaroon{}
+	if err := mac.UnmarshalBinary(macBytes); err != nil {
+		return nil, err
+	}
+
+	return parseRestrictions(mac)
+}
+
+// parseRestrictions reads faraday's custom caveats from a macaroon. Caveats
+// can only be added to a macaroon, so a macaroon that has several audit range
+// caveats is restricted to the intersection of their ranges.
+func parseRestrictions(mac *macaroon.Macaroon) (*macaroonRestrictions,
+	error) {
+
+	restrictions := &macaroonRestrictions{
+		stripFiat: macaroons.HasCustomCaveat(mac, stripFiatCaveat),
+	}
+
+	// We can't use macaroons.GetCustomCaveatCondition, because it only
+	// returns the condition of the first caveat with our name.
+	caveatPrefix := []byte(fmt.Sprintf(
+		"%s %s ", macaroons.CondLndCustom, auditRangeCaveat,
+	))
+	for _, caveat := range mac.Caveats() {
+		if !bytes.HasPrefix(caveat.Id, caveatPrefix) {
+			continue
+		}
+
+		condition := string(caveat.Id[len(caveatPrefix):])
+		start, end, err := parseAuditRange(condition)
+		if err != nil {
+			return nil, err
+		}
+
+		if !restrictions.auditRange || start > restrictions.auditStart {
+			restrictions.auditStart = start
+		}
+
+		if !restrictions.auditRange || restrictions.auditEnd == 0 ||
+			(end != 0 && end < restrictions.auditEnd) {
+
+			restrictions.auditEnd = end
+		}
+
+		restrictions.auditRange = true
+	}
+
+	if restrictions.auditEnd != 0 &&
+		restrictions.auditStart >= restrictions.auditEnd {
+
+		return nil, errEmptyAuditRange
+	}
+
+	return restrictions, nil
+}
+
+// parseAuditRange parses the start and end unix times from the condition of
+// an audit range caveat.
+func parseAuditRange(condition string) (uint64, uint64, error) {
+	times := strings.Split(condition, "-")
+	if len(times) != 2 {
+		return 0, 0, fmt.Errorf("invalid audit range: %v", condition)
+	}
+
+	start, err := strconv.ParseUint(times[0], 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	end, err := strconv.ParseUint(times[1], 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return start, end, nil
+}
+
+// checkAuditRange fails if a request for the range provided is not permitted
+// by our restrictions. An end time of zero requests a range until the
+// present.
+func (r *macaroonRestrictions) checkAuditRange(start, end uint64) error {
+	if !r.auditRange {
+		return nil
+	}
+
+	if end == 0 {
+		end = uint64(time.Now().Unix())
+	}
+
+	if start < r.auditStart {
+		return errAuditRangeDenied
+	}
+
+	if r.auditEnd != 0 && end > r.auditEnd {
+		return errAuditRangeDenied
+	}
+
+	return nil
+}
+
+// inAuditRange returns a boolean indicating whether an entry with the
+// timestamp provided falls within our audit range.
+func (r *macaroonRestrictions) inAuditRange(timestamp time.Time) bool {
+	if !r.auditRange {
+		return true
+	}
+
+	if timestamp.Before(time.Unix(int64(r.auditStart), 0)) {
+		return false
+	}
+
+	return r.auditEnd == 0 ||
+		timestamp.Before(time.Unix(int64(r.auditEnd), 0))
+}
+
+// stripAuditFiat removes all fiat values from an audit response, leaving it
+// in the form of an audit that was produced without fiat values. We can't
+// only rely on producing the audit without fiat values, because entries
+// from closed periods are frozen with their fiat values.
+func stripAuditFiat(resp *frdrpc.NodeAuditResponse) {
+	for _, entry := range resp.Reports {
+		stripEntryFiat(entry)
+	}
+
+	if resp.Summary != nil {
+		resp.Summary.NetFiat = noFiat
+		resp.Summary.Currency = ""
+
+		sections := []*frdrpc.SummarySection{
+			resp.Summary.Income, resp.Summary.Expenses,
+			resp.Summary.Transfers,
+		}
+		for _, section := range sections {
+			if section == nil {
+				continue
+			}
+
+			section.Fiat = noFiat
+			for _, line := range section.Lines {
+				line.Fiat = noFiat
+			}
+		}
+	}
+
+	for _, drift := range resp.PeriodDrift {
+		if drift.Period != nil {
+			drift.Period.Prices = nil
+		}
+
+		for _, entryDrift := range drift.Drift {
+			stripEntryFiat(entryDrift.Frozen)
+			stripEntryFiat(entryDrift.Live)
+		}
+	}
+}
+
+// stripEntryFiat removes the fiat values from an entry, if it is set.
+func stripEntryFiat(entry *frdrpc.ReportEntry) {
+	if entry == nil {
+		return
+	}
+
+	entry.Fiat = noFiat
+	entry.BtcPrice = &frdrpc.BitcoinPrice{
+		Price: noFiat,
+	}
+}
@@ -0,0 +1,237 @@
+package frdrpcserver
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/macaroons"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+	"gopkg.in/macaroon.v2"
+)
+
+// TestMacaroonOps tests selection of the permissions that we bake into a
+// macaroon for a set of entities.
+func TestMacaroonOps(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		entities []string
+		readOnly bool
+		ops      []bakery.Op
+		err      bool
+	}{
+		{
+			name: "no entities",
+			err:  true,
+		},
+		{
+			name:     "unknown entity",
+			entities: []string{"audit", "unknown"},
+			err:      true,
+		},
+		{
+			name:     "all audit permissions",
+			entities: []string{"audit"},
+			ops: []bakery.Op{
+				{Entity: "audit", Action: "read"},
+				{Entity: "audit", Action: "write"},
+			},
+		},
+		{
+			name:     "read only",
+			entities: []string{"rates", "audit", "audit"},
+			readOnly: true,
+			ops: []bakery.Op{
+				{Entity: "audit", Action: "read"},
+				{Entity: "rates", Action: "read"},
+			},
+		},
+		{
+			name:     "no read permissions",
+			entities: []string{"macaroon"},
+			readOnly: true,
+			err:      true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ops, err := macaroonOps(test.entities, test.readOnly)
+			if test.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.ops, ops)
+		})
+	}
+}
+
+// restrictedContext returns an incoming request context with a macaroon that
+// is restricted to audits in [100, 200) and strips fiat values.
+func restrictedContext(t *testing.T) context.Context {
+	return macaroonContext(
+		t, macaroons.CustomConstraint(auditRangeCaveat, "100-200"),
+		macaroons.CustomConstraint(stripFiatCaveat, ""),
+	)
+}
+
+// macaroonContext returns an incoming request context with a macaroon that
+// has the constraints provided.
+func macaroonContext(t *testing.T,
+	constraints ...macaroons.Constraint) context.Context {
+
+	mac, err := macaroon.New(
+		[]byte("root key"), []byte("0"), faradayMacaroonLocation,
+		macaroon.LatestVersion,
+	)
+	require.NoError(t, err)
+
+	mac, err = macaroons.AddConstraints(mac, constraints...)
+	require.NoError(t, err)
+
+	macBytes, err := mac.MarshalBinary()
+	require.NoError(t, err)
+
+	return metadata.NewIncomingContext(
+		context.Background(), metadata.Pairs(
+			"macaroon", hex.EncodeToString(macBytes),
+		),
+	)
+}
+
+// TestRestrictions tests reading and enforcing our custom caveats from a
+// request's macaroon.
+func TestRestrictions(t *testing.T) {
+	t.Parallel()
+
+	// A request without a macaroon is not restricted.
+	restrictions, err := restrictionsFromContext(context.Background())
+	require.NoError(t, err)
+	require.False(t, restrictions.stripFiat)
+	require.NoError(t, restrictions.checkAuditRange(0, 0))
+	require.True(t, restrictions.inAuditRange(time.Unix(0, 0)))
+
+	restrictions, err = restrictionsFromContext(restrictedContext(t))
+	require.NoError(t, err)
+	require.True(t, restrictions.stripFiat)
+
+	require.NoError(t, restrictions.checkAuditRange(100, 200))
+	require.NoError(t, restrictions.checkAuditRange(150, 160))
+	require.ErrorIs(
+		t, restrictions.checkAuditRange(99, 200), errAuditRangeDenied,
+	)
+	require.ErrorIs(
+		t, restrictions.checkAuditRange(100, 201), errAuditRangeDenied,
+	)
+
+	// Requests until the present are outside of our range.
+	require.ErrorIs(
+		t, restrictions.checkAuditRange(100, 0), errAuditRangeDenied,
+	)
+
+	// Entries are in our range if they occurred in [100, 200).
+	require.False(t, restrictions.inAuditRange(time.Unix(99, 0)))
+	require.True(t, restrictions.inAuditRange(time.Unix(100, 0)))
+	require.True(t, restrictions.inAuditRange(time.Unix(199, 0)))
+	require.False(t, restrictions.inAuditRange(time.Unix(200, 0)))
+}
+
+// TestRestrictionsMultipleRanges tests that a macaroon with several audit
+// range caveats is restricted to the intersection of their ranges.
+func TestRestrictionsMultipleRanges(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		ranges []string
+		start  uint64
+		end    uint64
+		err    error
+	}{
+		{
+			name:   "narrower range added",
+			ranges: []string{"100-200", "120-150"},
+			start:  120,
+			end:    150,
+		},
+		{
+			name:   "wider range added",
+			ranges: []string{"120-150", "100-200"},
+			start:  120,
+			end:    150,
+		},
+		{
+			name:   "overlapping ranges",
+			ranges: []string{"100-200", "150-0"},
+			start:  150,
+			end:    200,
+		},
+		{
+			name:   "open ended ranges",
+			ranges: []string{"100-0", "150-0"},
+			start:  150,
+			end:    0,
+		},
+		{
+			name:   "disjoint ranges",
+			ranges: []string{"100-200", "300-400"},
+			err:    errEmptyAuditRange,
+		},
+		{
+			name:   "adjacent ranges",
+			ranges: []string{"100-200", "200-300"},
+			err:    errEmptyAuditRange,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var constraints []macaroons.Constraint
+			for _, auditRange := range test.ranges {
+				constraints = append(
+					constraints, macaroons.CustomConstraint(
+						auditRangeCaveat, auditRange,
+					),
+				)
+			}
+
+			restrictions, err := restrictionsFromContext(
+				macaroonContext(t, constraints...),
+			)
+			require.ErrorIs(t, err, test.err)
+			if test.err != nil {
+				return
+			}
+
+			require.True(t, restrictions.auditRange)
+			require.Equal(t, test.start, restrictions.auditStart)
+			require.Equal(t, test.end, restrictions.auditEnd)
+
+			require.ErrorIs(
+				t, restrictions.checkAuditRange(
+					test.start-1, test.end,
+				), errAuditRangeDenied,
+			)
+			require.NoError(
+				t, restrictions.checkAuditRange(
+					test.start, test.end,
+				),
+			)
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package frdrpcserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lightninglabs/faraday/accounting"
+	"github.com/lightninglabs/faraday/archive"
+	"github.com/lightninglabs/faraday/fiat"
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/lightninglabs/faraday/lndwrap"
+	"github.com/lightninglabs/lndclient"
+)
+
+// parseBalanceSheetRequest parses a request for a balance sheet and produces
+// the config required to produce it.
+func parseBalanceSheetRequest(ctx context.Context, cfg *Config,
+	archived *archive.Store, req *frdrpc.BalanceSheetRequest) (
+	*accounting.BalanceSheetConfig, error) {
+
+	// We use the present in seconds so that a balance sheet requested
+	// for the current second does not need to be reconstructed.
+	now := time.Unix(time.Now().Unix(), 0)
+
+	timestamp := now
+	if req.Timestamp != 0 {
+		timestamp = time.Unix(int64(req.Timestamp), 0)
+	}
+
+	if timestamp.After(now) {
+		return nil, fmt.Errorf("timestamp: %v is in the future",
+			timestamp)
+	}
+
+	sheetCfg := &accounting.BalanceSheetConfig{
+		Timestamp: timestamp,
+		Now:       now,
+		WalletBalance: func() (*lndclient.WalletBalance, error) {
+			return cfg.Lnd.Client.WalletBalance(ctx)
+		},
+		OpenChannels: lndwrap.ListChannels(ctx, cfg.Lnd.Client, false),
+		PendingChannels: func() (*lndclient.PendingChannels, error) {
+			return cfg.Lnd.Client.PendingChannels(ctx)
+		},
+		// We do not need fiat values for the entries that we use to
+		// reconstruct our balances.
+		ListEntries: func() (accounting.Report, error) {
+			return nodeAudit(
+				ctx, cfg, archived, &frdrpc.NodeAuditRequest{
+					StartTime:   uint64(timestamp.Unix()),
+					EndTime:     uint64(now.Unix()),
+					DisableFiat: true,
+				},
+			)
+		},
+	}
+
+	if req.DisableFiat {
+		return sheetCfg, nil
+	}
+
+	priceCfg, err := priceCfgFromRPC(
+		req.FiatBackend, req.Granularity, false, timestamp, timestamp,
+		req.CustomPrices,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sheetCfg.GetPrice = func(ts time.Time) (*fiat.Price, error) {
+		prices, err := fiat.GetPrices(ctx, []time.Time{ts}, priceCfg)
+		if err != nil {
+			return nil, err
+		}
+
+		return prices[ts], nil
+	}
+
+	return sheetCfg, nil
+}
+
+// rpcBalanceSheetResponse converts a balance sheet into a rpc response.
+func rpcBalanceSheetResponse(
+	sheet *accounting.BalanceSheet) *frdrpc.BalanceSheetResponse {
+
+	balance := func(amtMsat int64) *frdrpc.Balance {
+		return &frdrpc.Balance{
+			AmountMsat: amtMsat,
+			Btc:        accounting.BTC(amtMsat).String(),
+			Fiat:       sheet.Fiat(amtMsat).String(),
+		}
+	}
+
+	resp := &frdrpc.BalanceSheetResponse{
+		Timestamp:      uint64(sheet.Timestamp.Unix()),
+		OnChain:        balance(sheet.OnChain),
+		Channels:       balance(sheet.Channels),
+		PendingClose:   balance(sheet.PendingClose),
+		UnsettledHtlcs: balance(sheet.UnsettledHtlcs),
+		Total:          balance(sheet.Total()),
+	}
+
+	if sheet.BTCPrice != nil {
+		resp.BtcPrice = &frdrpc.BitcoinPrice{
+			Price:          sheet.BTCPrice.Price.String(),
+			PriceTimestamp: uint64(sheet.BTCPrice.Timestamp.Unix()),
+			Currency:       sheet.BTCPrice.Currency,
+		}
+	}
+
+	return resp
+}
@@ -10,21 +10,31 @@ import (
 	"github.com/lightninglabs/faraday/revenue"
 )
 
-// channelInsights gets the set of channel insights we need.
-func channelInsights(ctx context.Context,
-	cfg *Config) ([]*insights.ChannelInfo, error) {
+// channelInsights gets the set of channel insights we need, attributing fees
+// to channels with the fee attribution provided.
+func channelInsights(ctx context.Context, cfg *Config,
+	attribution revenue.FeeAttribution) ([]*insights.ChannelInfo, error) {
 
 	// Get revenue from a zero start time to the present to cover
 	// revenue over the lifetime of all our channels.
 	revenueCfg := getRevenueConfig(
 		ctx, cfg, time.Unix(0, 0), time.Now(),
 	)
+	revenueCfg.FeeAttribution = attribution
 
 	report, err := revenue.GetRevenueReport(revenueCfg)
 	if err != nil {
 		return nil, err
 	}
 
+	return insightsForReport(ctx, cfg, report)
+}
+
+// insightsForReport gets insights for our currently open channels using the
+// revenue report provided.
+func insightsForReport(ctx context.Context, cfg *Config,
+	report *revenue.Report) ([]*insights.ChannelInfo, error) {
+
 	return insights.GetChannels(&insights.Config{
 		OpenChannels: lndwrap.ListChannels(
 			ctx, cfg.Lnd.Client, false,
@@ -0,0 +1,101 @@
+package frdrpcserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/lightninglabs/faraday/dryrun"
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/lightninglabs/faraday/lndwrap"
+	"github.com/lightninglabs/faraday/revenue"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+const (
+	// defaultDryRunConfTarget is the confirmation target we estimate close
+	// fees for if the request does not specify one.
+	defaultDryRunConfTarget = 6
+
+	// defaultDryRunWindow is the period of forwarding history we use to
+	// project lost revenue if the request does not specify a start time.
+	defaultDryRunWindow = time.Hour * 24 * 30
+)
+
+// parseCloseDryRunRequest parses a close dry run request and produces the
+// config required to simulate the close, including a revenue report for the
+// period requested.
+func parseCloseDryRunRequest(ctx context.Context, cfg *Config,
+	req *frdrpc.CloseDryRunRequest) (*dryrun.Config, error) {
+
+	endTime := time.Unix(int64(req.EndTime), 0)
+	if req.EndTime == 0 {
+		endTime = time.Now()
+	}
+
+	startTime := endTime.Add(-defaultDryRunWindow)
+	if req.StartTime != 0 {
+		startTime = time.Unix(int64(req.StartTime), 0)
+	}
+
+	confTarget := req.ConfTarget
+	if confTarget == 0 {
+		confTarget = defaultDryRunConfTarget
+	}
+
+	report, err := revenue.GetRevenueReport(
+		getRevenueConfig(ctx, cfg, startTime, endTime),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dryrun.Config{
+		OpenChannels: lndwrap.ListChannels(
+			ctx, cfg.Lnd.Client, false,
+		),
+		EstimateFeeRate: func(target int32) (chainfee.SatPerKWeight,
+			error) {
+
+			return cfg.Lnd.WalletKit.EstimateFeeRate(ctx, target)
+		},
+		ConfTarget:    confTarget,
+		RevenueReport: report,
+		RevenueWindow: endTime.Sub(startTime),
+	}, nil
+}
+
+// rpcCloseDryRunResponse converts a close dry run report into a rpc response.
+func rpcCloseDryRunResponse(report *dryrun.Report) *frdrpc.CloseDryRunResponse {
+	resp := &frdrpc.CloseDryRunResponse{
+		FeeRateSatPerKw:    uint64(report.FeeRate),
+		LostFeesMsat:       uint64(report.LostFees),
+		ReroutableFeesMsat: uint64(report.ReroutableFees),
+		LostFeesPerDayMsat: uint64(report.LostFeesPerDay),
+	}
+
+	for _, estimate := range report.Estimates {
+		resp.Estimates = append(resp.Estimates, &frdrpc.CloseEstimate{
+			ChanPoint:         estimate.ChannelPoint,
+			Initiator:         estimate.Initiator,
+			LocalBalanceSat:   int64(estimate.LocalBalance),
+			CooperativeFeeSat: int64(estimate.CooperativeFee),
+			ForceFeeSat:       int64(estimate.ForceFee()),
+			ForceCommitFeeSat: int64(estimate.ForceCommitFee),
+			ForceSweepFeeSat:  int64(estimate.ForceSweepFee),
+			ForceHtlcFeeSat:   int64(estimate.ForceHtlcFee),
+			CsvDelay:          estimate.CSVDelay,
+		})
+	}
+
+	for _, flow := range report.LostFlows {
+		resp.LostFlows = append(resp.LostFlows, &frdrpc.LostFlow{
+			IncomingChanPoint: flow.IncomingChannel,
+			OutgoingChanPoint: flow.OutgoingChannel,
+			AmountMsat:        uint64(flow.Amount),
+			FeesMsat:          uint64(flow.Fees),
+			AlternativeRoute:  flow.AlternativeRoute,
+		})
+	}
+
+	return resp
+}
@@ -2,9 +2,11 @@ package frdrpcserver
 
 import (
 	"context"
+	"fmt"
 	"sort"
 	"time"
 
+	"github.com/lightninglabs/faraday/dataset"
 	"github.com/lightninglabs/faraday/frdrpc"
 	"github.com/lightninglabs/faraday/insights"
 	"github.com/lightninglabs/faraday/recommend"
@@ -13,13 +15,19 @@ import (
 // parseRecommendationRequest parses a close recommendation request and
 // returns the config required to get recommendations.
 func parseRecommendationRequest(ctx context.Context, cfg *Config,
-	req *frdrpc.CloseRecommendationRequest) *recommend.CloseRecommendationConfig {
+	req *frdrpc.CloseRecommendationRequest) (
+	*recommend.CloseRecommendationConfig, error) {
+
+	attribution, err := feeAttributionFromRPC(req.GetFeeAttribution())
+	if err != nil {
+		return nil, err
+	}
 
 	// Create a close recommendations config with the minimum monitored
 	// value provided in the request and the default outlier multiplier.
 	recCfg := &recommend.CloseRecommendationConfig{
 		ChannelInsights: func() ([]*insights.ChannelInfo, error) {
-			return channelInsights(ctx, cfg)
+			return channelInsights(ctx, cfg, attribution)
 		},
 		MinimumMonitored: time.Second *
 			time.Duration(req.MinimumMonitored),
@@ -44,21 +52,67 @@ func parseRecommendationRequest(ctx context.Context, cfg *Config,
 		recCfg.Metric = recommend.Volume
 	}
 
-	return recCfg
+	return recCfg, nil
 }
 
 // parseOutlierRequest parses a rpc outlier recommendation request and returns
-// the close recommendation config and multiplier required.
+// the close recommendation config and outlier config required.
 func parseOutlierRequest(ctx context.Context, cfg *Config,
 	req *frdrpc.OutlierRecommendationsRequest) (
-	*recommend.CloseRecommendationConfig, float64) {
+	*recommend.CloseRecommendationConfig, *dataset.OutlierConfig, error) {
 
-	multiplier := recommend.DefaultOutlierMultiplier
+	outlierCfg := &dataset.OutlierConfig{
+		Multiplier: recommend.DefaultOutlierMultiplier,
+	}
 	if req.OutlierMultiplier != 0 {
-		multiplier = float64(req.OutlierMultiplier)
+		outlierCfg.Multiplier = float64(req.OutlierMultiplier)
 	}
 
-	return parseRecommendationRequest(ctx, cfg, req.RecRequest), multiplier
+	switch req.Method {
+	case frdrpc.OutlierRecommendationsRequest_IQR:
+		outlierCfg.Method = dataset.IQRMethod
+
+	case frdrpc.OutlierRecommendationsRequest_LOG_IQR:
+		outlierCfg.Method = dataset.LogIQRMethod
+
+	case frdrpc.OutlierRecommendationsRequest_MODIFIED_Z_SCORE:
+		outlierCfg.Method = dataset.ModifiedZScoreMethod
+
+		outlierCfg.Multiplier = recommend.DefaultModifiedZScore
+		if req.ModifiedZScore != 0 {
+			outlierCfg.Multiplier = float64(req.ModifiedZScore)
+		}
+
+	case frdrpc.OutlierRecommendationsRequest_PERCENTILE:
+		outlierCfg.Method = dataset.PercentileMethod
+
+		// We only fall back to our default lower percentile if
+		// neither percentile is set, because zero is a valid lower
+		// percentile.
+		outlierCfg.LowerPercentile = float64(req.LowerPercentile)
+		if req.LowerPercentile == 0 && req.UpperPercentile == 0 {
+			outlierCfg.LowerPercentile =
+				recommend.DefaultLowerPercentile
+		}
+
+		outlierCfg.UpperPercentile = recommend.DefaultUpperPercentile
+		if req.UpperPercentile != 0 {
+			outlierCfg.UpperPercentile = float64(
+				req.UpperPercentile,
+			)
+		}
+
+	default:
+		return nil, nil, fmt.Errorf("unknown outlier method: %v",
+			req.Method)
+	}
+
+	recCfg, err := parseRecommendationRequest(ctx, cfg, req.RecRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return recCfg, outlierCfg, nil
 }
 
 // parseThresholdRequest parses a rpc threshold recommendation request and
@@ -67,10 +121,14 @@ func parseOutlierRequest(ctx context.Context, cfg *Config,
 // a default that returns values below a threshold.
 func parseThresholdRequest(ctx context.Context, cfg *Config,
 	req *frdrpc.ThresholdRecommendationsRequest) (
-	*recommend.CloseRecommendationConfig, float64) {
+	*recommend.CloseRecommendationConfig, float64, error) {
+
+	recCfg, err := parseRecommendationRequest(ctx, cfg, req.RecRequest)
+	if err != nil {
+		return nil, 0, err
+	}
 
-	return parseRecommendationRequest(ctx, cfg, req.RecRequest),
-		float64(req.ThresholdValue)
+	return recCfg, float64(req.ThresholdValue), nil
 }
 
 // rpcResponse parses the response obtained getting a close recommendation
@@ -81,6 +139,13 @@ func rpcResponse(report *recommend.Report) *frdrpc.CloseRecommendationsResponse
 		ConsideredChannels: int32(report.ConsideredChannels),
 	}
 
+	if report.OutlierBounds != nil {
+		resp.OutlierBounds = &frdrpc.OutlierBounds{
+			Lower: float32(report.OutlierBounds.Lower),
+			Upper: float32(report.OutlierBounds.Upper),
+		}
+	}
+
 	for chanPoint, rec := range report.Recommendations {
 		resp.Recommendations = append(
 			resp.Recommendations, &frdrpc.Recommendation{
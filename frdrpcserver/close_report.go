@@ -22,7 +22,7 @@ func parseCloseReportRequest(ctx context.Context, cfg *Config) *resolutions.Conf
 		},
 		CalculateFees: func(hash *chainhash.Hash) (btcutil.Amount, error) {
 			return fees.CalculateFee(
-				cfg.BitcoinClient.GetTxDetail, hash,
+				cfg.BitcoinClient.GetTxDetails, hash,
 			)
 		},
 	}
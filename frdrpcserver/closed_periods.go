@@ -0,0 +1,233 @@
+package frdrpcserver
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lightninglabs/faraday/accounting"
+	"github.com/lightninglabs/faraday/archive"
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/lightninglabs/faraday/periods"
+)
+
+// errPeriodStoreUnavailable is returned if closed periods are requested
+// before our period store has been opened.
+var errPeriodStoreUnavailable = errors.New("period store not available")
+
+// closePeriod produces the audit entries for the period requested from live
+// data, signs them with our node's key and persists them.
+func closePeriod(ctx context.Context, cfg *Config, store *periods.Store,
+	archived *archive.Store, req *frdrpc.ClosePeriodRequest) (
+	*periods.ClosedPeriod, error) {
+
+	now := time.Now()
+
+	if req.EndTime == 0 {
+		return nil, errors.New("end time required to close period")
+	}
+
+	end := time.Unix(int64(req.EndTime), 0)
+	if end.After(now) {
+		return nil, fmt.Errorf("end time: %v is in the future", end)
+	}
+
+	report, err := nodeAudit(ctx, cfg, archived, &frdrpc.NodeAuditRequest{
+		StartTime:        req.StartTime,
+		EndTime:          req.EndTime,
+		DisableFiat:      req.DisableFiat,
+		Granularity:      req.Granularity,
+		CustomCategories: req.CustomCategories,
+		FiatBackend:      req.FiatBackend,
+		CustomPrices:     req.CustomPrices,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	period, err := periods.NewClosedPeriod(
+		time.Unix(int64(req.StartTime), 0), end, now, cfg.Version,
+		report,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	digest, err := period.Digest()
+	if err != nil {
+		return nil, err
+	}
+
+	period.Signature, err = cfg.Lnd.Client.SignMessage(ctx, digest[:])
+	if err != nil {
+		return nil, fmt.Errorf("could not sign period: %w", err)
+	}
+
+	if err := store.AddPeriod(period); err != nil {
+		return nil, err
+	}
+
+	return period, nil
+}
+
+// auditWithClosedPeriods produces a node audit, using the frozen entries of
+// any closed periods that overlap with the audit in place of live data, and
+// reporting the drift between the two. If we do not have a period store, or
+// the request ignores closed periods, the audit is produced from live data.
+func auditWithClosedPeriods(ctx context.Context, cfg *Config,
+	store *periods.Store, archived *archive.Store,
+	req *frdrpc.NodeAuditRequest) (accounting.Report,
+	[]*periods.PeriodDrift, error) {
+
+	report, err := nodeAudit(ctx, cfg, archived, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if store == nil || req.IgnoreClosedPeriods {
+		return report, nil, nil
+	}
+
+	closed, err := store.ListPeriods()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	start, end, err := validateTimes(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	report, drift := periods.ApplyClosedPeriods(
+		report, start, end, closed, !req.DisableFiat,
+	)
+
+	return report, drift, nil
+}
+
+// rpcClosedPeriod converts a closed period to a rpc period.
+func rpcClosedPeriod(period *periods.ClosedPeriod) (*frdrpc.ClosedPeriod,
+	error) {
+
+	digest, err := period.Digest()
+	if err != nil {
+		return nil, err
+	}
+
+	rpcPeriod := &frdrpc.ClosedPeriod{
+		StartTime:  uint64(period.Start.Unix()),
+		EndTime:    uint64(period.End.Unix()),
+		ClosedAt:   uint64(period.ClosedAt.Unix()),
+		Version:    period.Version,
+		EntryCount: uint64(len(period.Entries)),
+		Digest:     hex.EncodeToString(digest[:]),
+		Signature:  period.Signature,
+
+		EncodingVersion: period.EncodingVersion,
+	}
+
+	for _, price := range period.Prices {
+		rpcPrice := &frdrpc.BitcoinPrice{
+			Price:          price.Price.String(),
+			PriceTimestamp: uint64(price.Timestamp.Unix()),
+			Currency:       price.Currency,
+		}
+
+		rpcPeriod.Prices = append(rpcPeriod.Prices, rpcPrice)
+	}
+
+	return rpcPeriod, nil
+}
+
+// rpcListClosedPeriodsResponse converts a set of closed periods to a rpc
+// response.
+func rpcListClosedPeriodsResponse(closed []*periods.ClosedPeriod) (
+	*frdrpc.ListClosedPeriodsResponse, error) {
+
+	resp := &frdrpc.ListClosedPeriodsResponse{
+		Periods: make([]*frdrpc.ClosedPeriod, len(closed)),
+	}
+
+	for i, period := range closed {
+		rpcPeriod, err := rpcClosedPeriod(period)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Periods[i] = rpcPeriod
+	}
+
+	return resp, nil
+}
+
+// rpcPeriodDrift converts the drift for a set of closed periods to rpc
+// drift.
+func rpcPeriodDrift(drift []*periods.PeriodDrift) ([]*frdrpc.PeriodDrift,
+	error) {
+
+	rpcDrift := make([]*frdrpc.PeriodDrift, len(drift))
+
+	for i, periodDrift := range drift {
+		rpcPeriod, err := rpcClosedPeriod(periodDrift.Period)
+		if err != nil {
+			return nil, err
+		}
+
+		rpcDrift[i] = &frdrpc.PeriodDrift{
+			Period: rpcPeriod,
+			Drift: make(
+				[]*frdrpc.EntryDrift, len(periodDrift.Drift),
+			),
+		}
+
+		for j, entryDrift := range periodDrift.Drift {
+			rpcEntryDrift, err := rpcDriftEntry(entryDrift)
+			if err != nil {
+				return nil, err
+			}
+
+			rpcDrift[i].Drift[j] = rpcEntryDrift
+		}
+	}
+
+	return rpcDrift, nil
+}
+
+// rpcDriftEntry converts a single difference between frozen and live
+// entries to a rpc entry drift.
+func rpcDriftEntry(drift *periods.Drift) (*frdrpc.EntryDrift, error) {
+	rpcDrift := &frdrpc.EntryDrift{}
+
+	switch drift.Type {
+	case periods.DriftTypeAdded:
+		rpcDrift.Type = frdrpc.DriftType_ADDED
+
+	case periods.DriftTypeRemoved:
+		rpcDrift.Type = frdrpc.DriftType_REMOVED
+
+	case periods.DriftTypeChanged:
+		rpcDrift.Type = frdrpc.DriftType_CHANGED
+
+	default:
+		return nil, fmt.Errorf("unknown drift type: %v", drift.Type)
+	}
+
+	var err error
+	if drift.Frozen != nil {
+		rpcDrift.Frozen, err = rpcReportEntry(drift.Frozen)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if drift.Live != nil {
+		rpcDrift.Live, err = rpcReportEntry(drift.Live)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rpcDrift, nil
+}
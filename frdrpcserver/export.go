@@ -0,0 +1,106 @@
+package frdrpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/lightninglabs/faraday/accounting"
+	"github.com/lightninglabs/faraday/archive"
+	"github.com/lightninglabs/faraday/frdrpc"
+)
+
+// exportAuditData queries all of the data required to produce audits for the
+// period requested and returns it as a json encoded export.
+func exportAuditData(ctx context.Context, cfg *Config,
+	archived *archive.Store, req *frdrpc.ExportAuditDataRequest) ([]byte,
+	error) {
+
+	// Exports do not include fiat prices, because these can be obtained
+	// independently of lnd when the export is used for an audit.
+	onChain, offChain, err := parseNodeAuditRequest(
+		ctx, cfg, archived, &frdrpc.NodeAuditRequest{
+			StartTime:   req.StartTime,
+			EndTime:     req.EndTime,
+			DisableFiat: true,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	export, err := accounting.NewExport(ctx, &accounting.ExportConfig{
+		OnChain:        onChain,
+		OffChain:       offChain,
+		FaradayVersion: cfg.Version,
+		Parallelism:    cfg.AuditParallelism,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(export)
+}
+
+// OfflineAudit produces a node audit from an export rather than from a live
+// connection to lnd. If the request does not set a start or end time, the
+// start or end of the export is used. Closed periods and signatures are not
+// supported for offline audits, because they require our node.
+func OfflineAudit(ctx context.Context, export *accounting.Export,
+	req *frdrpc.NodeAuditRequest) (*frdrpc.NodeAuditResponse, error) {
+
+	start, end := export.StartTime, export.EndTime
+	if req.StartTime != 0 {
+		start = time.Unix(int64(req.StartTime), 0)
+	}
+
+	if req.EndTime != 0 {
+		end = time.Unix(int64(req.EndTime), 0)
+	}
+
+	if start.After(end) {
+		return nil, fmt.Errorf("start time: %v after end: %v", start,
+			end)
+	}
+
+	priceSourceCfg, err := priceCfgFromRPC(
+		req.FiatBackend, req.Granularity, false, start, end,
+		req.CustomPrices,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateCustomCategories(req.CustomCategories); err != nil {
+		return nil, err
+	}
+
+	onChainCategories, offChainCategories, err := getCategories(
+		req.CustomCategories,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	onChain, offChain, err := accounting.NewOfflineConfigs(
+		export, start, end, req.DisableFiat, priceSourceCfg,
+		onChainCategories, offChainCategories,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := accounting.NodeReport(
+		ctx, onChain, offChain, accounting.DefaultFetchParallelism,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Summary {
+		return rpcSummaryResponse(report)
+	}
+
+	return rpcReportResponse(report)
+}
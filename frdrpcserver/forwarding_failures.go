@@ -0,0 +1,124 @@
+package frdrpcserver
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/lightninglabs/faraday/failures"
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/lightninglabs/faraday/lndwrap"
+	"github.com/lightninglabs/lndclient"
+)
+
+// errFailureStoreUnavailable is returned if forwarding failures are requested
+// before our failure store has been opened.
+var errFailureStoreUnavailable = errors.New("forwarding failure store not " +
+	"available")
+
+// startFailureMonitor creates our failure store and starts recording
+// forwarding failures from lnd's htlc event stream, unless monitoring is
+// disabled. Our database must be opened before the monitor is started.
+func (s *RPCServer) startFailureMonitor() error {
+	store, err := failures.NewStore(s.faradayDB)
+	if err != nil {
+		return err
+	}
+	s.failureStore = store
+
+	if s.cfg.DisableHtlcMonitor {
+		log.Info("Htlc monitor disabled, forwarding failures will " +
+			"not be recorded")
+
+		return nil
+	}
+
+	s.failureMonitor = failures.NewMonitor(&failures.MonitorConfig{
+		SubscribeHtlcEvents: s.cfg.Lnd.Router.SubscribeHtlcEvents,
+		AddFailure:          store.AddFailure,
+	})
+
+	return s.failureMonitor.Start()
+}
+
+// stopFailureMonitor stops recording forwarding failures.
+func (s *RPCServer) stopFailureMonitor() {
+	if s.failureMonitor != nil {
+		s.failureMonitor.Stop()
+		s.failureMonitor = nil
+	}
+}
+
+// parseForwardingFailuresRequest parses a request for a forwarding failures
+// report and produces the config required to get the report.
+func parseForwardingFailuresRequest(ctx context.Context, cfg *Config,
+	store *failures.Store,
+	req *frdrpc.ForwardingFailuresRequest) *failures.Config {
+
+	// Progress end time to the present if it is not set.
+	endTime := time.Unix(int64(req.EndTime), 0)
+	if req.EndTime == 0 {
+		endTime = time.Now()
+	}
+
+	return &failures.Config{
+		ListChannels: lndwrap.ListChannels(ctx, cfg.Lnd.Client, false),
+		ClosedChannels: func() ([]lndclient.ClosedChannel, error) {
+			return cfg.Lnd.Client.ClosedChannels(ctx)
+		},
+		ListFailures: store.ListFailures,
+		StartTime:    time.Unix(int64(req.StartTime), 0),
+		EndTime:      endTime,
+	}
+}
+
+// rpcForwardingFailuresResponse converts a failure report into a rpc response.
+func rpcForwardingFailuresResponse(
+	report *failures.Report) *frdrpc.ForwardingFailuresResponse {
+
+	resp := &frdrpc.ForwardingFailuresResponse{
+		FailureCount:     uint64(report.Count),
+		MissedVolumeMsat: uint64(report.Volume),
+		MissedFeesMsat:   uint64(report.Fees),
+	}
+
+	for _, channel := range report.Channels {
+		rpcChannel := &frdrpc.ChannelFailures{
+			ChannelId:        channel.ChannelID,
+			ChanPoint:        channel.ChannelPoint,
+			FailureCount:     uint64(channel.Count),
+			MissedVolumeMsat: uint64(channel.Volume),
+			MissedFeesMsat:   uint64(channel.Fees),
+		}
+
+		for reason, missed := range channel.Reasons {
+			rpcChannel.Reasons = append(
+				rpcChannel.Reasons, &frdrpc.FailureReason{
+					Reason:           reason,
+					FailureCount:     uint64(missed.Count),
+					MissedVolumeMsat: uint64(missed.Volume),
+					MissedFeesMsat:   uint64(missed.Fees),
+				},
+			)
+		}
+
+		// Sort reasons so that the reasons we missed the most fees
+		// for are listed first.
+		sort.SliceStable(rpcChannel.Reasons, func(i, j int) bool {
+			reasonI := rpcChannel.Reasons[i]
+			reasonJ := rpcChannel.Reasons[j]
+
+			if reasonI.MissedFeesMsat != reasonJ.MissedFeesMsat {
+				return reasonI.MissedFeesMsat >
+					reasonJ.MissedFeesMsat
+			}
+
+			return reasonI.Reason < reasonJ.Reason
+		})
+
+		resp.Channels = append(resp.Channels, rpcChannel)
+	}
+
+	return resp
+}
@@ -0,0 +1,217 @@
+package frdrpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/lightninglabs/faraday/jobs"
+	"google.golang.org/protobuf/proto"
+)
+
+// errJobsUnavailable is returned if background jobs are requested before our
+// job manager has started.
+var errJobsUnavailable = errors.New("background jobs not available")
+
+// startJobManager creates our job store and starts running background jobs.
+// Our database must be opened before the job manager is started.
+func (s *RPCServer) startJobManager() error {
+	store, err := jobs.NewStore(s.faradayDB)
+	if err != nil {
+		return err
+	}
+
+	s.jobManager = jobs.NewManager(&jobs.Config{
+		Store:     store,
+		Retention: s.cfg.JobRetention,
+	})
+
+	return s.jobManager.Start()
+}
+
+// stopJobManager cancels all of our running jobs and waits for them to exit.
+func (s *RPCServer) stopJobManager() {
+	if s.jobManager != nil {
+		s.jobManager.Stop()
+		s.jobManager = nil
+	}
+}
+
+// jobAccess is persisted with each audit job, so that the macaroon
+// restrictions of the request that started the job can be enforced when
+// the job is accessed by later requests.
+type jobAccess struct {
+	// StartTime is the unix start time of the audit.
+	StartTime uint64 `json:"start_time"`
+
+	// EndTime is the unix end time of the audit. If the audit was
+	// requested until the present, this is the time the job started.
+	EndTime uint64 `json:"end_time"`
+
+	// StripFiat is true if the macaroon that started the job required
+	// fiat values to be stripped.
+	StripFiat bool `json:"strip_fiat"`
+}
+
+// startAuditJob starts producing an audit in the background. The macaroon
+// restrictions of the request that started the job are applied to its
+// result, because the job does not run with the request's context, and are
+// persisted with the job.
+func (s *RPCServer) startAuditJob(req *frdrpc.NodeAuditRequest,
+	restrictions *macaroonRestrictions) (string, error) {
+
+	if s.jobManager == nil {
+		return "", errJobsUnavailable
+	}
+
+	start, end, err := validateTimes(req.StartTime, req.EndTime)
+	if err != nil {
+		return "", err
+	}
+
+	access, err := json.Marshal(&jobAccess{
+		StartTime: uint64(start.Unix()),
+		EndTime:   uint64(end.Unix()),
+		StripFiat: restrictions.stripFiat,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return s.jobManager.StartJob(func(ctx context.Context) ([]byte,
+		error) {
+
+		resp, err := s.nodeAudit(ctx, req, restrictions)
+		if err != nil {
+			return nil, err
+		}
+
+		return proto.Marshal(resp)
+	}, access)
+}
+
+// jobStatus returns the status of a job, failing if the job may not be
+// accessed with the restrictions provided.
+func (s *RPCServer) jobStatus(id string,
+	restrictions *macaroonRestrictions) (*jobs.Job, *jobAccess, error) {
+
+	if s.jobManager == nil {
+		return nil, nil, errJobsUnavailable
+	}
+
+	job, err := s.jobManager.JobStatus(id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	access, err := checkJobAccess(job, restrictions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return job, access, nil
+}
+
+// checkJobAccess fails if a job's audit range is not permitted by the
+// restrictions provided, returning the restrictions persisted with the job.
+// Jobs that were not persisted with an audit range may only be accessed
+// without an audit range restriction.
+func checkJobAccess(job *jobs.Job,
+	restrictions *macaroonRestrictions) (*jobAccess, error) {
+
+	access := &jobAccess{}
+	if len(job.Metadata) == 0 {
+		if restrictions.auditRange {
+			return nil, errAuditRangeDenied
+		}
+
+		return access, nil
+	}
+
+	if err := json.Unmarshal(job.Metadata, access); err != nil {
+		return nil, err
+	}
+
+	err := restrictions.checkAuditRange(access.StartTime, access.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return access, nil
+}
+
+// auditJobResult returns the audit produced by a job, failing if the job may
+// not be accessed with the restrictions provided. Fiat values are stripped
+// from the audit if the restrictions provided, or the restrictions that the
+// job was started with, require it.
+func (s *RPCServer) auditJobResult(id string,
+	restrictions *macaroonRestrictions) (*frdrpc.NodeAuditResponse, error) {
+
+	_, access, err := s.jobStatus(id, restrictions)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.jobManager.JobResult(id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &frdrpc.NodeAuditResponse{}
+	if err := proto.Unmarshal(result, resp); err != nil {
+		return nil, err
+	}
+
+	// Jobs may have been started with a different macaroon, so we strip
+	// fiat values from the result if our caller's macaroon requires it.
+	// If this changes the audit, its signature is no longer valid.
+	if restrictions.stripFiat || access.StripFiat {
+		stripped := proto.Clone(resp).(*frdrpc.NodeAuditResponse)
+		stripAuditFiat(stripped)
+
+		if !proto.Equal(resp, stripped) {
+			stripped.Signature = nil
+		}
+
+		resp = stripped
+	}
+
+	return resp, nil
+}
+
+// rpcJobStatus converts a job to a rpc job status response.
+func rpcJobStatus(job *jobs.Job) (*frdrpc.JobStatusResponse, error) {
+	resp := &frdrpc.JobStatusResponse{
+		JobId:         job.ID,
+		CreatedAt:     uint64(job.Created.Unix()),
+		Error:         job.Error,
+		PagesFetched:  job.Progress.Pages,
+		PricesFetched: job.Progress.Prices,
+		EntriesBuilt:  job.Progress.Entries,
+	}
+
+	if !job.Finished.IsZero() {
+		resp.FinishedAt = uint64(job.Finished.Unix())
+	}
+
+	switch job.Status {
+	case jobs.StatusRunning:
+		resp.State = frdrpc.JobState_JOB_RUNNING
+
+	case jobs.StatusSucceeded:
+		resp.State = frdrpc.JobState_JOB_SUCCEEDED
+
+	case jobs.StatusFailed:
+		resp.State = frdrpc.JobState_JOB_FAILED
+
+	case jobs.StatusCancelled:
+		resp.State = frdrpc.JobState_JOB_CANCELLED
+
+	default:
+		return nil, fmt.Errorf("unknown job status: %v", job.Status)
+	}
+
+	return resp, nil
+}
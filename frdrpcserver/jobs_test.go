@@ -0,0 +1,83 @@
+package frdrpcserver
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/lightninglabs/faraday/jobs"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCheckJobAccess tests that the macaroon restrictions of requests for a
+// job are enforced against the audit range persisted with the job.
+func TestCheckJobAccess(t *testing.T) {
+	t.Parallel()
+
+	metadata, err := json.Marshal(&jobAccess{
+		StartTime: 100,
+		EndTime:   200,
+		StripFiat: true,
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		metadata     []byte
+		restrictions *macaroonRestrictions
+		err          error
+	}{
+		{
+			name:         "unrestricted",
+			metadata:     metadata,
+			restrictions: &macaroonRestrictions{},
+		},
+		{
+			name:     "within range",
+			metadata: metadata,
+			restrictions: &macaroonRestrictions{
+				auditStart: 50,
+				auditEnd:   200,
+				auditRange: true,
+			},
+		},
+		{
+			name:     "outside of range",
+			metadata: metadata,
+			restrictions: &macaroonRestrictions{
+				auditStart: 150,
+				auditEnd:   200,
+				auditRange: true,
+			},
+			err: errAuditRangeDenied,
+		},
+		{
+			name:         "no metadata, unrestricted",
+			restrictions: &macaroonRestrictions{},
+		},
+		{
+			name: "no metadata, restricted",
+			restrictions: &macaroonRestrictions{
+				auditRange: true,
+			},
+			err: errAuditRangeDenied,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			access, err := checkJobAccess(&jobs.Job{
+				Metadata: test.metadata,
+			}, test.restrictions)
+			require.ErrorIs(t, err, test.err)
+			if test.err != nil {
+				return
+			}
+
+			require.Equal(t, test.metadata != nil, access.StripFiat)
+		})
+	}
+}
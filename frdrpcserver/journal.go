@@ -0,0 +1,149 @@
+package frdrpcserver
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/lightninglabs/faraday/accounting"
+	"github.com/lightninglabs/faraday/archive"
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/lightninglabs/faraday/journal"
+	"github.com/lightninglabs/lndclient"
+)
+
+// replayBatchSize is the number of recorded entries that we read from our
+// journal at a time when we replay them to a subscriber.
+const replayBatchSize = 1000
+
+var (
+	// errJournalDisabled is returned if entries are subscribed to when our
+	// journal is not enabled.
+	errJournalDisabled = errors.New("journal not enabled, set " +
+		"journal.enable to record entries")
+
+	// errJournalStopped is returned to entry subscribers when we stop
+	// recording entries.
+	errJournalStopped = errors.New("journal stopped, faraday is shutting " +
+		"down")
+)
+
+// startJournal creates our journal store and starts recording entries, if our
+// journal is enabled. Our database must be opened before the journal is
+// started.
+func (s *RPCServer) startJournal() error {
+	if s.cfg.Journal == nil || !s.cfg.Journal.Enable {
+		return nil
+	}
+
+	store, err := journal.NewStore(s.faradayDB)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	s.journalRecorder = journal.NewRecorder(&journal.RecorderConfig{
+		Store: store,
+		NodeAudit: func(start, end time.Time) (accounting.Report,
+			error) {
+
+			return journalAudit(
+				ctx, s.cfg, s.archiveStore, start, end,
+			)
+		},
+		Interval: s.cfg.Journal.Interval,
+		Lookback: s.cfg.Journal.Lookback,
+	})
+
+	return s.journalRecorder.Start()
+}
+
+// stopJournal stops recording entries.
+func (s *RPCServer) stopJournal() {
+	if s.journalRecorder != nil {
+		s.journalRecorder.Stop()
+		s.journalRecorder = nil
+	}
+}
+
+// journalAudit produces the entries for our node over [start, end) with fiat
+// values from our default price source. Unconfirmed transactions are given
+// the current time as their timestamp by our audit, so we exclude them so
+// that they are only recorded once they confirm, with their block timestamp.
+func journalAudit(ctx context.Context, cfg *Config,
+	archived *archive.Store, start, end time.Time) (accounting.Report,
+	error) {
+
+	onChain, offChain, err := parseNodeAuditRequest(
+		ctx, cfg, archived, &frdrpc.NodeAuditRequest{
+			StartTime: uint64(start.Unix()),
+			EndTime:   uint64(end.Unix()),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	listTransactions := onChain.OnChainTransactions
+	onChain.OnChainTransactions = func(ctx context.Context) (
+		[]lndclient.Transaction, error) {
+
+		txns, err := listTransactions(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		confirmed := make([]lndclient.Transaction, 0, len(txns))
+		for _, tx := range txns {
+			if tx.Confirmations > 0 {
+				confirmed = append(confirmed, tx)
+			}
+		}
+
+		return confirmed, nil
+	}
+
+	return accounting.NodeReport(
+		ctx, onChain, offChain, cfg.AuditParallelism,
+	)
+}
+
+// checkEntryCursor fails if the entry that a subscription resumes after is
+// outside of the audit range of the restrictions provided, so that callers
+// can't learn about entries outside of their range from their sequence
+// numbers. Cursors that do not refer to a recorded entry are permitted.
+func checkEntryCursor(recorder *journal.Recorder, afterSequence uint64,
+	restrictions *macaroonRestrictions) error {
+
+	if afterSequence == 0 || !restrictions.auditRange {
+		return nil
+	}
+
+	entries, err := recorder.ListEntries(afterSequence-1, 1)
+	if err != nil {
+		return err
+	}
+
+	if len(entries) == 0 || entries[0].Sequence != afterSequence {
+		return nil
+	}
+
+	if !restrictions.inAuditRange(entries[0].Timestamp) {
+		return errAuditRangeDenied
+	}
+
+	return nil
+}
+
+// rpcJournalEntry converts a recorded entry to an rpc journal entry.
+func rpcJournalEntry(entry *journal.Entry) (*frdrpc.JournalEntry, error) {
+	rpcEntry, err := rpcReportEntry(entry.HarmonyEntry)
+	if err != nil {
+		return nil, err
+	}
+
+	return &frdrpc.JournalEntry{
+		Sequence: entry.Sequence,
+		Entry:    rpcEntry,
+	}, nil
+}
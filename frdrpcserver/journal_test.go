@@ -0,0 +1,182 @@
+package frdrpcserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/faraday/accounting"
+	"github.com/lightninglabs/faraday/fiat"
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/lightninglabs/faraday/journal"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// mockEntryStream mocks the server side of an entry subscription.
+type mockEntryStream struct {
+	grpc.ServerStream
+
+	ctx     context.Context
+	entries chan *frdrpc.JournalEntry
+}
+
+// Send delivers an entry to our stream's entries channel.
+func (m *mockEntryStream) Send(entry *frdrpc.JournalEntry) error {
+	m.entries <- entry
+	return nil
+}
+
+// Context returns the context of our stream.
+func (m *mockEntryStream) Context() context.Context {
+	return m.ctx
+}
+
+// newTestRecorder starts a journal recorder that has recorded an entry at
+// each of the unix timestamps provided, in order.
+func newTestRecorder(t *testing.T, timestamps ...int64) *journal.Recorder {
+	db, err := kvdb.GetBoltBackend(&kvdb.BoltBackendConfig{
+		DBPath:     t.TempDir(),
+		DBFileName: "journal.db",
+		DBTimeout:  time.Second,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	store, err := journal.NewStore(db)
+	require.NoError(t, err)
+
+	var entries []*accounting.HarmonyEntry
+	for _, timestamp := range timestamps {
+		entries = append(entries, &accounting.HarmonyEntry{
+			Timestamp: time.Unix(timestamp, 0),
+			Amount:    1000,
+			FiatValue: decimal.NewFromInt(2),
+			Type:      accounting.EntryTypeReceipt,
+			Credit:    true,
+			BTCPrice: &fiat.Price{
+				Price:    decimal.NewFromInt(20_000),
+				Currency: "USD",
+			},
+		})
+	}
+
+	// Record our entries with a high water mark of the present, so that
+	// our recorder does not audit our node for entries that occurred
+	// before it was started.
+	_, err = store.AddEntries(entries, time.Now().Truncate(time.Second))
+	require.NoError(t, err)
+
+	recorder := journal.NewRecorder(&journal.RecorderConfig{
+		Store: store,
+		NodeAudit: func(_, _ time.Time) (accounting.Report, error) {
+			return nil, nil
+		},
+		Interval: time.Hour,
+	})
+	require.NoError(t, recorder.Start())
+	t.Cleanup(recorder.Stop)
+
+	return recorder
+}
+
+// receiveEntry waits for an entry to be sent on our stream, failing if our
+// subscription exits first.
+func receiveEntry(t *testing.T, stream *mockEntryStream,
+	errChan chan error) *frdrpc.JournalEntry {
+
+	select {
+	case entry := <-stream.entries:
+		return entry
+
+	case err := <-errChan:
+		t.Fatalf("subscription exited: %v", err)
+
+	case <-time.After(time.Second * 5):
+		t.Fatal("entry not received")
+	}
+
+	return nil
+}
+
+// TestSubscribeEntriesRestricted tests that entry subscriptions with a
+// restricted macaroon only receive the entries within the macaroon's audit
+// range, without their fiat values, and can't resume from entries outside of
+// the range.
+func TestSubscribeEntriesRestricted(t *testing.T) {
+	t.Parallel()
+
+	// Our macaroon permits entries in [100, 200), so only our second and
+	// fourth entries are in range.
+	server := &RPCServer{
+		journalRecorder: newTestRecorder(t, 50, 150, 250, 160),
+	}
+
+	tests := []struct {
+		name          string
+		afterSequence uint64
+		expected      []uint64
+		err           error
+	}{
+		{
+			name:     "all entries",
+			expected: []uint64{2, 4},
+		},
+		{
+			name:          "resume in range",
+			afterSequence: 2,
+			expected:      []uint64{4},
+		},
+		{
+			name:          "resume out of range",
+			afterSequence: 3,
+			err:           errAuditRangeDenied,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithCancel(restrictedContext(t))
+			defer cancel()
+
+			stream := &mockEntryStream{
+				ctx:     ctx,
+				entries: make(chan *frdrpc.JournalEntry),
+			}
+
+			req := &frdrpc.SubscribeEntriesRequest{
+				AfterSequence: test.afterSequence,
+			}
+
+			errChan := make(chan error, 1)
+			go func() {
+				errChan <- server.SubscribeEntries(req, stream)
+			}()
+
+			if test.err != nil {
+				require.ErrorIs(t, <-errChan, test.err)
+				return
+			}
+
+			for _, sequence := range test.expected {
+				entry := receiveEntry(t, stream, errChan)
+				require.Equal(t, sequence, entry.Sequence)
+				require.Equal(t, noFiat, entry.Entry.Fiat)
+
+				price := entry.Entry.BtcPrice.Price
+				require.Equal(t, noFiat, price)
+			}
+
+			cancel()
+			require.ErrorIs(t, <-errChan, context.Canceled)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package frdrpcserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/lightninglabs/faraday/insights"
+	"github.com/lightninglabs/faraday/metrics"
+	"github.com/lightninglabs/faraday/revenue"
+	"github.com/lightninglabs/lndclient"
+)
+
+// startMetrics starts exporting prometheus metrics for our channels, revenue
+// and fiat prices, if a metrics listen address is set. Our rpc metrics are
+// recorded by the exporter's interceptors, which are added to our gRPC server
+// when it is created.
+func (s *RPCServer) startMetrics() error {
+	if s.cfg.MetricsListen == "" {
+		return nil
+	}
+
+	ctx := context.Background()
+	s.metricsExporter = metrics.NewExporter(&metrics.ExporterConfig{
+		Listen:   s.cfg.MetricsListen,
+		Interval: s.cfg.MetricsInterval,
+		RevenueReport: func(start, end time.Time) (*revenue.Report,
+			error) {
+
+			// We split fees between channels so that our per
+			// channel fees sum to our total fees.
+			revenueCfg := getRevenueConfig(ctx, s.cfg, start, end)
+			revenueCfg.FeeAttribution = revenue.AttributeSplit
+
+			// lnd includes forwards at our end time, so we drop
+			// them here because they will be included in the
+			// report that starts at our end time.
+			listForwards := revenueCfg.ForwardingHistory
+			revenueCfg.ForwardingHistory = func() (
+				[]lndclient.ForwardingEvent, error) {
+
+				forwards, err := listForwards()
+				if err != nil {
+					return nil, err
+				}
+
+				var inRange []lndclient.ForwardingEvent
+				for _, forward := range forwards {
+					if forward.Timestamp.Before(end) {
+						inRange = append(
+							inRange, forward,
+						)
+					}
+				}
+
+				return inRange, nil
+			}
+
+			return revenue.GetRevenueReport(revenueCfg)
+		},
+		ChannelInsights: func(report *revenue.Report) (
+			[]*insights.ChannelInfo, error) {
+
+			return insightsForReport(ctx, s.cfg, report)
+		},
+	})
+
+	return s.metricsExporter.Start()
+}
+
+// stopMetrics stops exporting prometheus metrics.
+func (s *RPCServer) stopMetrics() {
+	if s.metricsExporter != nil {
+		s.metricsExporter.Stop()
+		s.metricsExporter = nil
+	}
+}
@@ -0,0 +1,101 @@
+package frdrpcserver
+
+import (
+	"time"
+
+	"github.com/lightninglabs/faraday/periods"
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+const (
+	// faradayDBName is the name of the database that faraday persists its
+	// own data in.
+	faradayDBName = "faraday.db"
+
+	// faradayDBOpenTimeout is how long we wait for acquiring the lock on
+	// the faraday database before we give up with an error.
+	faradayDBOpenTimeout = time.Second * 5
+)
+
+// startMonitors opens our database and starts the monitors that record data
+// which lnd does not persist for us: forwarding failures and our channel
+// policies. It also opens the store for our closed fiscal periods, and starts
+// archiving lnd's invoices, payments and forwards, and exports metrics,
+// evaluates alert rules and records new accounting entries if they are
+// enabled.
+func (s *RPCServer) startMonitors() error {
+	db, err := kvdb.GetBoltBackend(&kvdb.BoltBackendConfig{
+		DBPath:     s.cfg.FaradayDir,
+		DBFileName: faradayDBName,
+		DBTimeout:  faradayDBOpenTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	s.faradayDB = db
+
+	if err := s.startFailureMonitor(); err != nil {
+		_ = s.stopMonitors()
+		return err
+	}
+
+	if err := s.startPolicyMonitor(); err != nil {
+		_ = s.stopMonitors()
+		return err
+	}
+
+	s.periodStore, err = periods.NewStore(db)
+	if err != nil {
+		_ = s.stopMonitors()
+		return err
+	}
+
+	if err := s.startArchiver(); err != nil {
+		_ = s.stopMonitors()
+		return err
+	}
+
+	if err := s.startJobManager(); err != nil {
+		_ = s.stopMonitors()
+		return err
+	}
+
+	if err := s.startMetrics(); err != nil {
+		_ = s.stopMonitors()
+		return err
+	}
+
+	if err := s.startAlertManager(); err != nil {
+		_ = s.stopMonitors()
+		return err
+	}
+
+	if err := s.startJournal(); err != nil {
+		_ = s.stopMonitors()
+		return err
+	}
+
+	return nil
+}
+
+// stopMonitors stops all of our monitors and closes our database.
+func (s *RPCServer) stopMonitors() error {
+	s.stopFailureMonitor()
+	s.stopPolicyMonitor()
+	s.stopArchiver()
+	s.stopJobManager()
+	s.stopMetrics()
+	s.stopAlertManager()
+	s.stopJournal()
+	s.periodStore = nil
+	s.archiveStore = nil
+
+	if s.faradayDB == nil {
+		return nil
+	}
+
+	err := s.faradayDB.Close()
+	s.faradayDB = nil
+
+	return err
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/lightninglabs/faraday/accounting"
+	"github.com/lightninglabs/faraday/archive"
 	"github.com/lightninglabs/faraday/fees"
 	"github.com/lightninglabs/faraday/fiat"
 	"github.com/lightninglabs/faraday/frdrpc"
@@ -35,10 +36,11 @@ var (
 )
 
 // parseNodeAuditRequest parses a report request and returns the config
-// required to produce a report containing on chain and off chain.
+// required to produce a report containing on chain and off chain. If an
+// archive is provided, its contents are merged with live off chain data.
 func parseNodeAuditRequest(ctx context.Context, cfg *Config,
-	req *frdrpc.NodeAuditRequest) (*accounting.OnChainConfig,
-	*accounting.OffChainConfig, error) {
+	archived *archive.Store, req *frdrpc.NodeAuditRequest) (
+	*accounting.OnChainConfig, *accounting.OffChainConfig, error) {
 
 	start, end, err := validateTimes(req.StartTime, req.EndTime)
 	if err != nil {
@@ -80,14 +82,15 @@ func parseNodeAuditRequest(ctx context.Context, cfg *Config,
 		ctx, cfg.Lnd, uint64(maxInvoiceQueries),
 		uint64(maxPaymentQueries), uint64(maxForwardQueries),
 		pubkey, start, end, req.DisableFiat, priceSourceCfg,
-		offChainCategories,
+		offChainCategories, cfg.InvoiceLookback,
 	)
+	mergeArchive(offChain, archived)
 
 	// If we have a chain connection, set our tx lookup function. Otherwise
 	// log a warning.
 	var feeLookup fees.GetDetailsFunc
 	if cfg.BitcoinClient != nil {
-		feeLookup = cfg.BitcoinClient.GetTxDetail
+		feeLookup = cfg.BitcoinClient.GetTxDetails
 	} else {
 		log.Warn("creating accounting report without bitcoin " +
 			"backend, some fee entries will be missing (see logs)")
@@ -107,13 +110,30 @@ func parseNodeAuditRequest(ctx context.Context, cfg *Config,
 	}
 
 	onChain := accounting.NewOnChainConfig(
-		ctx, cfg.Lnd, start, end, blockRangeLookup, req.DisableFiat,
+		cfg.Lnd, start, end, blockRangeLookup, req.DisableFiat,
 		feeLookup, priceSourceCfg, onChainCategories,
 	)
 
 	return onChain, offChain, nil
 }
 
+// nodeAudit produces the on chain and off chain entries for a node audit
+// request.
+func nodeAudit(ctx context.Context, cfg *Config, archived *archive.Store,
+	req *frdrpc.NodeAuditRequest) (accounting.Report, error) {
+
+	onChain, offChain, err := parseNodeAuditRequest(
+		ctx, cfg, archived, req,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return accounting.NodeReport(
+		ctx, onChain, offChain, cfg.AuditParallelism,
+	)
+}
+
 // validateCustomCategories validates a set of custom categories. It checks that
 // each has a name, and at least one bool indicating which transactions to
 // classify, as well as checking that each regex provided is unique.
@@ -209,34 +229,10 @@ func rpcReportResponse(report accounting.Report) (*frdrpc.NodeAuditResponse,
 	entries := make([]*frdrpc.ReportEntry, len(report))
 
 	for i, entry := range report {
-		rpcEntry := &frdrpc.ReportEntry{
-			Timestamp:      uint64(entry.Timestamp.Unix()),
-			OnChain:        entry.OnChain,
-			CustomCategory: entry.Category,
-			Amount:         uint64(entry.Amount),
-			Credit:         entry.Credit,
-			Asset:          "BTC",
-			Txid:           entry.TxID,
-			Fiat:           entry.FiatValue.String(),
-			Reference:      entry.Reference,
-			Note:           entry.Note,
-			BtcPrice: &frdrpc.BitcoinPrice{
-				Price:    entry.BTCPrice.Price.String(),
-				Currency: entry.BTCPrice.Currency,
-			},
-		}
-
-		if !entry.BTCPrice.Timestamp.IsZero() {
-			rpcEntry.BtcPrice.PriceTimestamp = uint64(
-				entry.BTCPrice.Timestamp.Unix(),
-			)
-		}
-
-		rpcType, err := rpcEntryType(entry.Type)
+		rpcEntry, err := rpcReportEntry(entry)
 		if err != nil {
 			return nil, err
 		}
-		rpcEntry.Type = rpcType
 
 		entries[i] = rpcEntry
 	}
@@ -249,6 +245,106 @@ func rpcReportResponse(report accounting.Report) (*frdrpc.NodeAuditResponse,
 	return &frdrpc.NodeAuditResponse{Reports: entries}, nil
 }
 
+// rpcSummaryResponse converts a report into an income statement rpc response.
+func rpcSummaryResponse(report accounting.Report) (*frdrpc.NodeAuditResponse,
+	error) {
+
+	summary := accounting.Summarize(report)
+
+	income, err := rpcSummarySection(&summary.Income)
+	if err != nil {
+		return nil, err
+	}
+
+	expenses, err := rpcSummarySection(&summary.Expenses)
+	if err != nil {
+		return nil, err
+	}
+
+	transfers, err := rpcSummarySection(&summary.Transfers)
+	if err != nil {
+		return nil, err
+	}
+
+	return &frdrpc.NodeAuditResponse{
+		Summary: &frdrpc.AuditSummary{
+			Income:        income,
+			Expenses:      expenses,
+			Transfers:     transfers,
+			NetAmountMsat: summary.NetAmount(),
+			NetFiat:       summary.NetFiat().String(),
+			Currency:      summary.Currency,
+		},
+	}, nil
+}
+
+// rpcSummarySection converts a section of an income statement to a rpc
+// section.
+func rpcSummarySection(section *accounting.SummarySection) (
+	*frdrpc.SummarySection, error) {
+
+	rpcSection := &frdrpc.SummarySection{
+		Lines:      make([]*frdrpc.SummaryLine, len(section.Lines)),
+		Count:      uint64(section.Count),
+		AmountMsat: section.Amount,
+		Fiat:       section.FiatValue.String(),
+	}
+
+	for i, line := range section.Lines {
+		rpcType, err := rpcEntryType(line.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		rpcSection.Lines[i] = &frdrpc.SummaryLine{
+			Type:           rpcType,
+			CustomCategory: line.Category,
+			OnChain:        line.OnChain,
+			Count:          uint64(line.Count),
+			AmountMsat:     line.Amount,
+			Fiat:           line.FiatValue.String(),
+		}
+	}
+
+	return rpcSection, nil
+}
+
+// rpcReportEntry converts a harmony entry to a rpc entry.
+func rpcReportEntry(entry *accounting.HarmonyEntry) (*frdrpc.ReportEntry,
+	error) {
+
+	rpcEntry := &frdrpc.ReportEntry{
+		Timestamp:      uint64(entry.Timestamp.Unix()),
+		OnChain:        entry.OnChain,
+		CustomCategory: entry.Category,
+		Amount:         uint64(entry.Amount),
+		Credit:         entry.Credit,
+		Asset:          "BTC",
+		Txid:           entry.TxID,
+		Fiat:           entry.FiatValue.String(),
+		Reference:      entry.Reference,
+		Note:           entry.Note,
+		BtcPrice: &frdrpc.BitcoinPrice{
+			Price:    entry.BTCPrice.Price.String(),
+			Currency: entry.BTCPrice.Currency,
+		},
+	}
+
+	if !entry.BTCPrice.Timestamp.IsZero() {
+		rpcEntry.BtcPrice.PriceTimestamp = uint64(
+			entry.BTCPrice.Timestamp.Unix(),
+		)
+	}
+
+	rpcType, err := rpcEntryType(entry.Type)
+	if err != nil {
+		return nil, err
+	}
+	rpcEntry.Type = rpcType
+
+	return rpcEntry, nil
+}
+
 func rpcEntryType(t accounting.EntryType) (frdrpc.EntryType, error) {
 	switch t {
 	case accounting.EntryTypeLocalChannelOpen:
@@ -0,0 +1,188 @@
+package frdrpcserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/faraday/failures"
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/lightninglabs/faraday/insights"
+	"github.com/lightninglabs/faraday/lndwrap"
+	"github.com/lightninglabs/faraday/recommend"
+	"github.com/lightninglabs/faraday/revenue"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+const (
+	// defaultOpenConfTarget is the confirmation target we estimate opening
+	// fees for if the request does not specify one.
+	defaultOpenConfTarget = 6
+
+	// defaultOpenWindow is the period of forwarding history we base open
+	// recommendations on if the request does not specify a start time.
+	defaultOpenWindow = time.Hour * 24 * 30
+)
+
+// parseOpenRecommendationsRequest parses a request for open recommendations
+// and wraps calls to lnd and our failure store to produce the config required
+// to get recommendations. If our failure store is not available, we base our
+// recommendations on forwarding history alone.
+func parseOpenRecommendationsRequest(ctx context.Context, cfg *Config,
+	store *failures.Store, req *frdrpc.OpenRecommendationsRequest) (
+	*recommend.OpenRecommendationConfig, error) {
+
+	// Progress end time to the present if it is not set.
+	endTime := time.Unix(int64(req.EndTime), 0)
+	if req.EndTime == 0 {
+		endTime = time.Now()
+	}
+
+	startTime := endTime.Add(-defaultOpenWindow)
+	if req.StartTime != 0 {
+		startTime = time.Unix(int64(req.StartTime), 0)
+	}
+
+	if startTime.After(endTime) {
+		return nil, fmt.Errorf("start time: %v after end: %v",
+			startTime, endTime)
+	}
+
+	confTarget := req.ConfTarget
+	if confTarget == 0 {
+		confTarget = defaultOpenConfTarget
+	}
+
+	attribution, err := feeAttributionFromRPC(req.FeeAttribution)
+	if err != nil {
+		return nil, err
+	}
+
+	openCfg := &recommend.OpenRecommendationConfig{
+		OpenChannels: lndwrap.ListChannels(
+			ctx, cfg.Lnd.Client, false,
+		),
+		ChannelInsights: func() ([]*insights.ChannelInfo, error) {
+			return windowInsights(
+				ctx, cfg, startTime, endTime, attribution,
+			)
+		},
+		FailureReport: func() (*failures.Report, error) {
+			return windowFailures(
+				ctx, cfg, store, startTime, endTime,
+			)
+		},
+		EstimateFeeRate: func(target int32) (chainfee.SatPerKWeight,
+			error) {
+
+			return cfg.Lnd.WalletKit.EstimateFeeRate(ctx, target)
+		},
+		ConfTarget:     confTarget,
+		RevenueWindow:  endTime.Sub(startTime),
+		LiquidityDays:  recommend.DefaultLiquidityDays,
+		MinChannelSize: recommend.DefaultMinChannelSize,
+		MaxChannelSize: recommend.DefaultMaxChannelSize,
+	}
+
+	if req.LiquidityDays != 0 {
+		openCfg.LiquidityDays = float64(req.LiquidityDays)
+	}
+
+	if req.MinChannelSizeSat != 0 {
+		openCfg.MinChannelSize = btcutil.Amount(
+			req.MinChannelSizeSat,
+		)
+	}
+
+	if req.MaxChannelSizeSat != 0 {
+		openCfg.MaxChannelSize = btcutil.Amount(
+			req.MaxChannelSizeSat,
+		)
+	}
+
+	return openCfg, nil
+}
+
+// windowInsights gets insights for our open channels based on the revenue
+// they earned over the period provided.
+func windowInsights(ctx context.Context, cfg *Config, startTime,
+	endTime time.Time, attribution revenue.FeeAttribution) (
+	[]*insights.ChannelInfo, error) {
+
+	revenueCfg := getRevenueConfig(ctx, cfg, startTime, endTime)
+	revenueCfg.FeeAttribution = attribution
+
+	report, err := revenue.GetRevenueReport(revenueCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return insights.GetChannels(&insights.Config{
+		OpenChannels: lndwrap.ListChannels(
+			ctx, cfg.Lnd.Client, false,
+		),
+		CurrentHeight: func() (uint32, error) {
+			info, err := cfg.Lnd.Client.GetInfo(ctx)
+			if err != nil {
+				return 0, err
+			}
+
+			return info.BlockHeight, nil
+		},
+		RevenueReport: report,
+	})
+}
+
+// windowFailures gets a report of the forwards that failed at our node over
+// the period provided. If our failure store is not available, a nil report is
+// returned.
+func windowFailures(ctx context.Context, cfg *Config, store *failures.Store,
+	startTime, endTime time.Time) (*failures.Report, error) {
+
+	if store == nil {
+		return nil, nil
+	}
+
+	return failures.GetReport(&failures.Config{
+		ListChannels: lndwrap.ListChannels(ctx, cfg.Lnd.Client, false),
+		ClosedChannels: func() ([]lndclient.ClosedChannel, error) {
+			return cfg.Lnd.Client.ClosedChannels(ctx)
+		},
+		ListFailures: store.ListFailures,
+		StartTime:    startTime,
+		EndTime:      endTime,
+	})
+}
+
+// rpcOpenRecommendationsResponse converts an open report into a rpc response.
+func rpcOpenRecommendationsResponse(
+	report *recommend.OpenReport) *frdrpc.OpenRecommendationsResponse {
+
+	resp := &frdrpc.OpenRecommendationsResponse{
+		FeeRateSatPerKw: uint64(report.FeeRate),
+	}
+
+	for _, rec := range report.Recommendations {
+		rpcRec := &frdrpc.OpenRecommendation{
+			Pubkey:                rec.Peer.String(),
+			Channels:              uint32(rec.Channels),
+			CapacitySat:           int64(rec.Capacity),
+			LocalBalanceSat:       int64(rec.LocalBalance),
+			FeesEarnedMsat:        uint64(rec.FeesEarned),
+			OutgoingVolumeMsat:    uint64(rec.OutgoingVolume),
+			MissedVolumeMsat:      uint64(rec.MissedVolume),
+			MissedFeesMsat:        uint64(rec.MissedFees),
+			DailyYield:            rec.DailyYield,
+			SuggestedSizeSat:      int64(rec.SuggestedSize),
+			ExpectedDailyFeesMsat: uint64(rec.ExpectedDailyFees),
+			OpenCostSat:           int64(rec.OpenCost),
+			PaybackDays:           rec.PaybackDays,
+		}
+
+		resp.Recommendations = append(resp.Recommendations, rpcRec)
+	}
+
+	return resp
+}
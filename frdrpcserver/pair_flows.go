@@ -0,0 +1,123 @@
+package frdrpcserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/lightninglabs/faraday/flows"
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/lightninglabs/faraday/lndwrap"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+// defaultFlowWindow is the period of forwarding history that we produce a
+// flow report for if the request does not specify a start time.
+const defaultFlowWindow = time.Hour * 24 * 30
+
+// parsePairFlowsRequest parses a request for a pair flow report and wraps
+// calls to lnd to produce the config required to get the report.
+func parsePairFlowsRequest(ctx context.Context, cfg *Config,
+	req *frdrpc.PairFlowsRequest) *flows.Config {
+
+	// Progress end time to the present if it is not set.
+	endTime := time.Unix(int64(req.EndTime), 0)
+	if req.EndTime == 0 {
+		endTime = time.Now()
+	}
+
+	startTime := endTime.Add(-defaultFlowWindow)
+	if req.StartTime != 0 {
+		startTime = time.Unix(int64(req.StartTime), 0)
+	}
+
+	bucketSize := time.Second * time.Duration(req.BucketSeconds)
+
+	flowCfg := &flows.Config{
+		ListChannels: lndwrap.ListChannels(ctx, cfg.Lnd.Client, false),
+		ClosedChannels: func() ([]lndclient.ClosedChannel, error) {
+			return cfg.Lnd.Client.ClosedChannels(ctx)
+		},
+		ForwardingHistory: func() ([]lndclient.ForwardingEvent, error) {
+			return lndwrap.ListForwards(
+				ctx, uint64(maxForwardQueries), startTime,
+				endTime, cfg.Lnd.Client,
+			)
+		},
+		ListAliases: func() ([]*lnrpc.AliasMap, error) {
+			return cfg.Lnd.Client.ListAliases(ctx)
+		},
+		StartTime:          startTime,
+		EndTime:            endTime,
+		BucketSize:         bucketSize,
+		DormantTurnover:    flows.DefaultDormantTurnover,
+		DirectionThreshold: flows.DefaultDirectionThreshold,
+	}
+
+	if req.DormantTurnover != 0 {
+		flowCfg.DormantTurnover = float64(req.DormantTurnover)
+	}
+
+	if req.DirectionThreshold != 0 {
+		flowCfg.DirectionThreshold = float64(req.DirectionThreshold)
+	}
+
+	return flowCfg
+}
+
+// rpcPairFlowsResponse converts a flow report into a rpc response.
+func rpcPairFlowsResponse(report *flows.Report) *frdrpc.PairFlowsResponse {
+	resp := &frdrpc.PairFlowsResponse{}
+
+	for _, bucket := range report.Buckets {
+		rpcBucket := &frdrpc.FlowBucket{
+			StartTime: uint64(bucket.Start.Unix()),
+			EndTime:   uint64(bucket.End.Unix()),
+		}
+
+		for _, flow := range bucket.Flows {
+			rpcFlow := &frdrpc.PairFlow{
+				IncomingChanPoint: flow.IncomingChannel,
+				OutgoingChanPoint: flow.OutgoingChannel,
+				AmountMsat:        uint64(flow.Amount),
+				FeesMsat:          uint64(flow.Fees),
+				Normalized:        flow.Normalized,
+			}
+
+			rpcBucket.Flows = append(rpcBucket.Flows, rpcFlow)
+		}
+
+		resp.Buckets = append(resp.Buckets, rpcBucket)
+	}
+
+	for _, channel := range report.Channels {
+		resp.Channels = append(resp.Channels, &frdrpc.ChannelFlow{
+			ChanPoint:          channel.ChannelPoint,
+			CapacitySat:        int64(channel.Capacity),
+			AmountIncomingMsat: uint64(channel.AmountIncoming),
+			AmountOutgoingMsat: uint64(channel.AmountOutgoing),
+			Turnover:           channel.Turnover,
+			Direction:          channel.Direction,
+			Role:               rpcFlowRole(channel.Role),
+		})
+	}
+
+	return resp
+}
+
+// rpcFlowRole converts a channel flow role into its rpc equivalent.
+func rpcFlowRole(role flows.Role) frdrpc.ChannelFlow_Role {
+	switch role {
+	case flows.RoleSource:
+		return frdrpc.ChannelFlow_SOURCE
+
+	case flows.RoleSink:
+		return frdrpc.ChannelFlow_SINK
+
+	case flows.RoleRouter:
+		return frdrpc.ChannelFlow_ROUTER
+
+	default:
+		return frdrpc.ChannelFlow_DORMANT
+	}
+}
@@ -33,4 +33,68 @@ var RequiredPermissions = map[string][]bakery.Op{
 		Entity: "report",
 		Action: "read",
 	}},
+	"/frdrpc.FaradayServer/CloseDryRun": {{
+		Entity: "recommendation",
+		Action: "read",
+	}},
+	"/frdrpc.FaradayServer/ForwardingFailures": {{
+		Entity: "report",
+		Action: "read",
+	}},
+	"/frdrpc.FaradayServer/PairFlows": {{
+		Entity: "report",
+		Action: "read",
+	}},
+	"/frdrpc.FaradayServer/OpenRecommendations": {{
+		Entity: "recommendation",
+		Action: "read",
+	}},
+	"/frdrpc.FaradayServer/PolicyHistory": {{
+		Entity: "report",
+		Action: "read",
+	}},
+	"/frdrpc.FaradayServer/BalanceSheet": {{
+		Entity: "audit",
+		Action: "read",
+	}},
+	"/frdrpc.FaradayServer/ClosePeriod": {{
+		Entity: "audit",
+		Action: "write",
+	}},
+	"/frdrpc.FaradayServer/ListClosedPeriods": {{
+		Entity: "audit",
+		Action: "read",
+	}},
+	"/frdrpc.FaradayServer/ExportAuditData": {{
+		Entity: "audit",
+		Action: "read",
+	}},
+	"/frdrpc.FaradayServer/BakeMacaroon": {{
+		Entity: "macaroon",
+		Action: "generate",
+	}},
+	"/frdrpc.FaradayServer/StartAudit": {{
+		Entity: "audit",
+		Action: "read",
+	}},
+	"/frdrpc.FaradayServer/JobStatus": {{
+		Entity: "audit",
+		Action: "read",
+	}},
+	"/frdrpc.FaradayServer/CancelJob": {{
+		Entity: "audit",
+		Action: "read",
+	}},
+	"/frdrpc.FaradayServer/JobResult": {{
+		Entity: "audit",
+		Action: "read",
+	}},
+	"/frdrpc.FaradayServer/SubscribeAlerts": {{
+		Entity: "insights",
+		Action: "read",
+	}},
+	"/frdrpc.FaradayServer/SubscribeEntries": {{
+		Entity: "audit",
+		Action: "read",
+	}},
 }
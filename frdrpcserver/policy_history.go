@@ -0,0 +1,197 @@
+package frdrpcserver
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/lightninglabs/faraday/lndwrap"
+	"github.com/lightninglabs/faraday/policies"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// errPolicyStoreUnavailable is returned if policy history is requested before
+// our policy store has been opened.
+var errPolicyStoreUnavailable = errors.New("policy store not available")
+
+// startPolicyMonitor creates our policy store and starts recording changes to
+// our channel policies, unless monitoring is disabled. Our database must be
+// opened before the monitor is started.
+func (s *RPCServer) startPolicyMonitor() error {
+	store, err := policies.NewStore(s.faradayDB)
+	if err != nil {
+		return err
+	}
+	s.policyStore = store
+
+	if s.cfg.DisablePolicyMonitor {
+		log.Info("Policy monitor disabled, channel policies will not " +
+			"be recorded")
+
+		return nil
+	}
+
+	s.policyMonitor = policies.NewMonitor(&policies.MonitorConfig{
+		ListPolicies: func() ([]*policies.Snapshot, error) {
+			return listPolicies(context.Background(), s.cfg)
+		},
+		AddSnapshot: store.AddSnapshot,
+	})
+
+	return s.policyMonitor.Start()
+}
+
+// stopPolicyMonitor stops recording changes to our channel policies.
+func (s *RPCServer) stopPolicyMonitor() {
+	if s.policyMonitor != nil {
+		s.policyMonitor.Stop()
+		s.policyMonitor = nil
+	}
+}
+
+// listPolicies looks up our current policy for each of our open channels.
+// Channels that lnd cannot provide a policy for are omitted. Each policy is
+// timestamped with the time that we last updated it, falling back to the
+// present if lnd does not provide an update time.
+func listPolicies(ctx context.Context, cfg *Config) ([]*policies.Snapshot,
+	error) {
+
+	info, err := cfg.Lnd.Client.GetInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ourNode := route.Vertex(info.IdentityPubkey)
+
+	channels, err := lndwrap.ListChannels(ctx, cfg.Lnd.Client, false)()
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]*policies.Snapshot, 0, len(channels))
+	for _, channel := range channels {
+		edge, err := cfg.Lnd.Client.GetChanInfo(ctx, channel.ChannelID)
+		if err != nil {
+			log.Debugf("Could not get policy for channel %v: %v",
+				channel.ChannelPoint, err)
+
+			continue
+		}
+
+		policy := edge.Node2Policy
+		if edge.Node1 == ourNode {
+			policy = edge.Node1Policy
+		}
+
+		if policy == nil {
+			continue
+		}
+
+		timestamp := policy.LastUpdate
+		if timestamp.IsZero() {
+			timestamp = time.Now()
+		}
+
+		snapshots = append(snapshots, &policies.Snapshot{
+			ChannelPoint:   channel.ChannelPoint,
+			ChannelID:      channel.ChannelID,
+			Timestamp:      timestamp,
+			FeeBase:        lnwire.MilliSatoshi(policy.FeeBaseMsat),
+			FeeRate:        policy.FeeRateMilliMsat,
+			InboundFeeBase: int64(policy.InboundBaseFeeMsat),
+			InboundFeeRate: int64(policy.InboundFeeRatePPM),
+			TimeLockDelta:  policy.TimeLockDelta,
+			MinHtlc:        lnwire.MilliSatoshi(policy.MinHtlcMsat),
+			MaxHtlc:        lnwire.MilliSatoshi(policy.MaxHtlcMsat),
+			Disabled:       policy.Disabled,
+		})
+	}
+
+	return snapshots, nil
+}
+
+// parsePolicyHistoryRequest parses a request for our policy history and
+// produces the config required to get the report.
+func parsePolicyHistoryRequest(ctx context.Context, cfg *Config,
+	store *policies.Store,
+	req *frdrpc.PolicyHistoryRequest) *policies.Config {
+
+	// Progress end time to the present if it is not set.
+	endTime := time.Unix(int64(req.EndTime), 0)
+	if req.EndTime == 0 {
+		endTime = time.Now()
+	}
+
+	start := time.Unix(int64(req.StartTime), 0)
+
+	return &policies.Config{
+		ListChannels: lndwrap.ListChannels(ctx, cfg.Lnd.Client, false),
+		ClosedChannels: func() ([]lndclient.ClosedChannel, error) {
+			return cfg.Lnd.Client.ClosedChannels(ctx)
+		},
+		ListSnapshots: store.ListSnapshots,
+		ForwardingHistory: func() ([]lndclient.ForwardingEvent, error) {
+			return lndwrap.ListForwards(
+				ctx, uint64(maxForwardQueries), start, endTime,
+				cfg.Lnd.Client,
+			)
+		},
+		StartTime: start,
+		EndTime:   endTime,
+	}
+}
+
+// rpcPolicyHistoryResponse converts a policy history report into a rpc
+// response, including only the channels requested if any are specified.
+func rpcPolicyHistoryResponse(chanPoints []string,
+	report *policies.Report) *frdrpc.PolicyHistoryResponse {
+
+	targets := make(map[string]bool, len(chanPoints))
+	for _, chanPoint := range chanPoints {
+		targets[chanPoint] = true
+	}
+
+	resp := &frdrpc.PolicyHistoryResponse{}
+	for _, channel := range report.Channels {
+		if len(targets) > 0 && !targets[channel.ChannelPoint] {
+			continue
+		}
+
+		rpcChannel := &frdrpc.ChannelPolicyHistory{
+			ChanPoint:        channel.ChannelPoint,
+			Elasticity:       channel.Elasticity,
+			ElasticityPoints: uint32(channel.ElasticityPoints),
+		}
+
+		for _, period := range channel.Periods {
+			policy := period.Policy
+
+			rpcPeriod := &frdrpc.PolicyPeriod{
+				StartTime:          uint64(period.Start.Unix()),
+				EndTime:            uint64(period.End.Unix()),
+				FeeBaseMsat:        uint64(policy.FeeBase),
+				FeeRatePpm:         policy.FeeRate,
+				InboundFeeBaseMsat: policy.InboundFeeBase,
+				InboundFeeRatePpm:  policy.InboundFeeRate,
+				TimeLockDelta:      policy.TimeLockDelta,
+				MinHtlcMsat:        uint64(policy.MinHtlc),
+				MaxHtlcMsat:        uint64(policy.MaxHtlc),
+				Disabled:           policy.Disabled,
+				ForwardCount:       uint64(period.Forwards),
+				VolumeMsat:         uint64(period.Volume),
+				FeesMsat:           uint64(period.Fees),
+				VolumePerDayMsat:   period.VolumePerDay(),
+			}
+
+			rpcChannel.Periods = append(
+				rpcChannel.Periods, rpcPeriod,
+			)
+		}
+
+		resp.Channels = append(resp.Channels, rpcChannel)
+	}
+
+	return resp
+}
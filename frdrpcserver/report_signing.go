@@ -0,0 +1,35 @@
+package frdrpcserver
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/lightninglabs/faraday/attestation"
+	"github.com/lightninglabs/faraday/frdrpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// signReport signs the digest of a report with our node's key. The report
+// must not yet contain a signature, since its digest is calculated with the
+// signature unset.
+func signReport(ctx context.Context, cfg *Config,
+	report proto.Message) (*frdrpc.ReportSignature, error) {
+
+	digest, err := attestation.Digest(report)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := cfg.Lnd.Client.SignMessage(ctx, digest)
+	if err != nil {
+		return nil, fmt.Errorf("could not sign report: %w", err)
+	}
+
+	return &frdrpc.ReportSignature{
+		Digest:          hex.EncodeToString(digest),
+		Signature:       signature,
+		Pubkey:          hex.EncodeToString(cfg.Lnd.NodePubkey[:]),
+		EncodingVersion: attestation.EncodingVersion,
+	}, nil
+}
@@ -2,19 +2,28 @@ package frdrpcserver
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/lightninglabs/faraday/frdrpc"
 	"github.com/lightninglabs/faraday/lndwrap"
+	"github.com/lightninglabs/faraday/policies"
 	"github.com/lightninglabs/faraday/revenue"
 	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnrpc"
 )
 
 // parseRevenueRequest parses a request for a revenue report and wraps
 // calls to lnd client to produce the config required to get a revenue
 // report.
 func parseRevenueRequest(ctx context.Context, cfg *Config,
-	req *frdrpc.RevenueReportRequest) *revenue.Config {
+	store *policies.Store,
+	req *frdrpc.RevenueReportRequest) (*revenue.Config, error) {
+
+	attribution, err := feeAttributionFromRPC(req.FeeAttribution)
+	if err != nil {
+		return nil, err
+	}
 
 	// Progress end time to the present if it is not set.
 	// We allow start time to be zero so that revenue can
@@ -26,7 +35,76 @@ func parseRevenueRequest(ctx context.Context, cfg *Config,
 	}
 
 	start := time.Unix(int64(req.StartTime), 0)
-	return getRevenueConfig(ctx, cfg, start, endTime)
+
+	revenueCfg := getRevenueConfig(ctx, cfg, start, endTime)
+	revenueCfg.FeeAttribution = attribution
+	revenueCfg.ChannelPolicy = policyLookup(store)
+
+	return revenueCfg, nil
+}
+
+// policyLookup returns a policy lookup which provides the policy that we
+// recorded for a channel at the time requested. If we did not record a policy
+// for the channel at that time, no policy is returned. We do not fall back to
+// the channel's current policy, because it may have changed since the
+// forward, so forwards without recorded policies are reported as
+// undecomposed. Policies are only looked up once, the first time that they
+// are required.
+func policyLookup(store *policies.Store) revenue.PolicyLookup {
+	var recorded map[string][]*policies.Snapshot
+
+	return func(channel string, at time.Time) (*revenue.Policy, error) {
+		if store == nil {
+			return nil, nil
+		}
+
+		if recorded == nil {
+			var err error
+			recorded, err = store.ListSnapshots()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		snapshot := policies.PolicyAt(recorded[channel], at)
+		if snapshot == nil {
+			return nil, nil
+		}
+
+		return &revenue.Policy{
+			FeeBase:        snapshot.FeeBase,
+			FeeRate:        snapshot.FeeRate,
+			InboundFeeBase: snapshot.InboundFeeBase,
+			InboundFeeRate: snapshot.InboundFeeRate,
+		}, nil
+	}
+}
+
+// feeAttributionFromRPC converts a rpc fee attribution into the attribution
+// used by our revenue reports.
+func feeAttributionFromRPC(
+	attribution frdrpc.FeeAttribution) (revenue.FeeAttribution, error) {
+
+	switch attribution {
+	case frdrpc.FeeAttribution_FULL_FEE:
+		return revenue.AttributeFull, nil
+
+	case frdrpc.FeeAttribution_SPLIT:
+		return revenue.AttributeSplit, nil
+
+	case frdrpc.FeeAttribution_ALL_OUTGOING:
+		return revenue.AttributeOutgoing, nil
+
+	case frdrpc.FeeAttribution_ALL_INCOMING:
+		return revenue.AttributeIncoming, nil
+
+	case frdrpc.FeeAttribution_CAPACITY_WEIGHTED:
+		return revenue.AttributeCapacity, nil
+
+	default:
+		return 0, fmt.Errorf("unknown fee attribution: %v",
+			attribution)
+	}
 }
 
 func getRevenueConfig(ctx context.Context, cfg *Config,
@@ -43,6 +121,9 @@ func getRevenueConfig(ctx context.Context, cfg *Config,
 				cfg.Lnd.Client,
 			)
 		},
+		ListAliases: func() ([]*lnrpc.AliasMap, error) {
+			return cfg.Lnd.Client.ListAliases(ctx)
+		},
 	}
 }
 
@@ -82,18 +163,53 @@ func rpcRevenueResponse(targetChannels []string,
 			continue
 		}
 
-		// Add revenue reports for each of our peers to the response.
+		// Add revenue reports for each of our peers to the response,
+		// totaling the inbound fees that the channel charged.
 		for peer, rp := range report {
 			rpcReport.PairReports[peer] = &frdrpc.PairReport{
 				AmountOutgoingMsat: int64(rp.AmountOutgoing),
 				FeesOutgoingMsat:   int64(rp.FeesOutgoing),
 				AmountIncomingMsat: int64(rp.AmountIncoming),
 				FeesIncomingMsat:   int64(rp.FeesIncoming),
+				InboundSurchargesMsat: uint64(
+					rp.InboundSurcharges,
+				),
+				InboundDiscountsMsat: uint64(
+					rp.InboundDiscounts,
+				),
+				UndecomposedForwards: rp.UndecomposedForwards,
 			}
+
+			rpcReport.InboundSurchargesMsat += uint64(
+				rp.InboundSurcharges,
+			)
+			rpcReport.InboundDiscountsMsat += uint64(
+				rp.InboundDiscounts,
+			)
+			rpcReport.UndecomposedForwards +=
+				rp.UndecomposedForwards
 		}
 
 		resp.Reports = append(resp.Reports, rpcReport)
 	}
 
+	// Include all of the forwards that we could not attribute to a pair
+	// of channels, because we cannot tell whether they belong to the
+	// channels requested.
+	for _, fwd := range revenueReport.Unattributed {
+		resp.UnattributedForwards = append(
+			resp.UnattributedForwards, &frdrpc.UnattributedForward{
+				Timestamp:         uint64(fwd.Timestamp.Unix()),
+				ChanIdIn:          fwd.ChannelIn.ToUint64(),
+				ChanIdOut:         fwd.ChannelOut.ToUint64(),
+				IncomingChanPoint: fwd.IncomingChannel,
+				OutgoingChanPoint: fwd.OutgoingChannel,
+				AmountInMsat:      uint64(fwd.AmountIn),
+				AmountOutMsat:     uint64(fwd.AmountOut),
+				FeeMsat:           uint64(fwd.Fee()),
+			},
+		)
+	}
+
 	return resp, nil
 }
@@ -23,10 +23,20 @@ import (
 
 	proxy "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/lightninglabs/faraday/accounting"
+	"github.com/lightninglabs/faraday/alerts"
+	"github.com/lightninglabs/faraday/archive"
 	"github.com/lightninglabs/faraday/chain"
+	"github.com/lightninglabs/faraday/dryrun"
+	"github.com/lightninglabs/faraday/failures"
 	"github.com/lightninglabs/faraday/fiat"
+	"github.com/lightninglabs/faraday/flows"
 	"github.com/lightninglabs/faraday/frdrpc"
 	"github.com/lightninglabs/faraday/frdrpcserver/perms"
+	"github.com/lightninglabs/faraday/jobs"
+	"github.com/lightninglabs/faraday/journal"
+	"github.com/lightninglabs/faraday/metrics"
+	"github.com/lightninglabs/faraday/periods"
+	"github.com/lightninglabs/faraday/policies"
 	"github.com/lightninglabs/faraday/recommend"
 	"github.com/lightninglabs/faraday/resolutions"
 	"github.com/lightninglabs/faraday/revenue"
@@ -112,6 +122,49 @@ type RPCServer struct {
 	macaroonService *lndclient.MacaroonService
 	macaroonDB      kvdb.Backend
 
+	// faradayDB is the database that faraday persists its own data in.
+	faradayDB kvdb.Backend
+
+	// failureStore persists forwards that failed at our node.
+	failureStore *failures.Store
+
+	// failureMonitor records forwarding failures from lnd's htlc event
+	// stream. It is nil if monitoring is disabled.
+	failureMonitor *failures.Monitor
+
+	// policyStore persists snapshots of our channel policies.
+	policyStore *policies.Store
+
+	// periodStore persists the fiscal periods that have been closed.
+	periodStore *periods.Store
+
+	// policyMonitor records changes to our channel policies. It is nil if
+	// monitoring is disabled.
+	policyMonitor *policies.Monitor
+
+	// archiveStore persists the invoices, payments and forwards that we
+	// have archived from lnd.
+	archiveStore *archive.Store
+
+	// archiver periodically archives lnd's invoices, payments and
+	// forwards. It is nil if archiving is disabled.
+	archiver *archive.Archiver
+
+	// jobManager runs audits in the background.
+	jobManager *jobs.Manager
+
+	// metricsExporter serves prometheus metrics. It is nil if metrics
+	// are disabled.
+	metricsExporter *metrics.Exporter
+
+	// alertManager evaluates our alert rules. It is nil if no rules are
+	// enabled.
+	alertManager *alerts.Manager
+
+	// journalRecorder records new accounting entries. It is nil if our
+	// journal is not enabled.
+	journalRecorder *journal.Recorder
+
 	restCancel func()
 	wg         sync.WaitGroup
 }
@@ -153,6 +206,57 @@ type Config struct {
 	// that is created automatically. This path normally is within
 	// FaradayDir unless otherwise specified by the user.
 	MacaroonPath string
+
+	// DisableHtlcMonitor disables recording of forwarding failures from
+	// lnd's htlc event stream.
+	DisableHtlcMonitor bool
+
+	// DisablePolicyMonitor disables recording of changes to our channel
+	// policies.
+	DisablePolicyMonitor bool
+
+	// DisableArchive disables archiving of lnd's invoices, payments and
+	// forwards.
+	DisableArchive bool
+
+	// JobRetention is the amount of time that we keep the results of
+	// background jobs for.
+	JobRetention time.Duration
+
+	// AuditParallelism is the number of queries to lnd and our price
+	// source that we run concurrently when producing audits. If this
+	// value is not set, accounting.DefaultFetchParallelism is used.
+	AuditParallelism int
+
+	// InvoiceLookback is the amount of time before the start of an audit
+	// that we query invoices from. If this value is zero, all invoices are
+	// queried.
+	InvoiceLookback time.Duration
+
+	// MetricsListen is the address that prometheus metrics are served on.
+	// If this value is empty, no metrics are exported.
+	MetricsListen string
+
+	// MetricsInterval is the interval at which our channel and revenue
+	// metrics are updated. If this value is not set,
+	// metrics.DefaultInterval is used.
+	MetricsInterval time.Duration
+
+	// Alerts is the config which sets the alert rules that we evaluate
+	// and the channels that alerts are delivered through.
+	Alerts *alerts.Config
+
+	// MinimumMonitored is the minimum amount of time that a channel must
+	// be monitored for before our outlier alert rule considers it.
+	MinimumMonitored time.Duration
+
+	// Journal is the config which sets whether and how often we record new
+	// accounting entries.
+	Journal *journal.Config
+
+	// Version is the version of faraday that is running, which is
+	// recorded when we close fiscal periods.
+	Version string
 }
 
 // NewRPCServer returns a server which will listen for rpc requests on the
@@ -200,6 +304,7 @@ func (s *RPCServer) Start() error {
 			MacaroonPath:     s.cfg.MacaroonPath,
 			Checkers: []macaroons.Checker{
 				macaroons.IPLockChecker,
+				macaroons.CustomChecker(caveatAcceptor{}),
 			},
 			RequiredPerms: perms.RequiredPermissions,
 			DBPassword:    macDbDefaultPw,
@@ -219,12 +324,36 @@ func (s *RPCServer) Start() error {
 	}
 	shutdownFuncs["macaroon"] = s.macaroonService.Stop
 
+	// Start recording forwarding failures and policy changes.
+	if err := s.startMonitors(); err != nil {
+		return fmt.Errorf("error starting monitors: %v", err)
+	}
+	shutdownFuncs["monitors"] = s.stopMonitors
+
 	// First we add the security interceptor to our gRPC server options that
 	// checks the macaroons for validity.
 	unaryInterceptor, streamInterceptor, err := s.macaroonService.Interceptors()
 	if err != nil {
 		return fmt.Errorf("error with macaroon interceptor: %v", err)
 	}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{unaryInterceptor}
+	streamInterceptors := []grpc.StreamServerInterceptor{streamInterceptor}
+
+	// If we are exporting metrics, we record the latency and status of
+	// every rpc before it reaches our macaroon interceptor, so that
+	// permission errors are included.
+	if s.metricsExporter != nil {
+		unaryInterceptors = append(
+			[]grpc.UnaryServerInterceptor{
+				s.metricsExporter.UnaryServerInterceptor(),
+			}, unaryInterceptors...,
+		)
+		streamInterceptors = append(
+			[]grpc.StreamServerInterceptor{
+				s.metricsExporter.StreamServerInterceptor(),
+			}, streamInterceptors...,
+		)
+	}
 
 	// Add our TLS configuration and then create our server instance. It's
 	// important that we let gRPC create the TLS listener and we don't just
@@ -232,8 +361,8 @@ func (s *RPCServer) Start() error {
 	// golang clients.
 	tlsCredentials := credentials.NewTLS(s.cfg.TLSServerConfig)
 	s.grpcServer = grpc.NewServer(
-		grpc.UnaryInterceptor(unaryInterceptor),
-		grpc.StreamInterceptor(streamInterceptor),
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
 		grpc.Creds(tlsCredentials),
 	)
 
@@ -362,6 +491,9 @@ func (s *RPCServer) StartAsSubserver(lndClient lndclient.LndServices,
 				MacaroonPath:     s.cfg.MacaroonPath,
 				Checkers: []macaroons.Checker{
 					macaroons.IPLockChecker,
+					macaroons.CustomChecker(
+						caveatAcceptor{},
+					),
 				},
 				RequiredPerms: perms.RequiredPermissions,
 				DBPassword:    macDbDefaultPw,
@@ -384,6 +516,13 @@ func (s *RPCServer) StartAsSubserver(lndClient lndclient.LndServices,
 	}
 
 	s.cfg.Lnd = lndClient
+
+	// Start recording forwarding failures and policy changes now that we
+	// have our lnd client.
+	if err := s.startMonitors(); err != nil {
+		return fmt.Errorf("error starting monitors: %v", err)
+	}
+
 	return nil
 }
 
@@ -430,6 +569,9 @@ func (s *RPCServer) Stop() error {
 			log.Errorf("Error closing macaroon DB: %v", err)
 		}
 	}
+	if err := s.stopMonitors(); err != nil {
+		log.Errorf("Error stopping monitors: %v", err)
+	}
 
 	// Stop the grpc server and wait for all go routines to terminate.
 	if s.grpcServer != nil {
@@ -450,12 +592,16 @@ func (s *RPCServer) OutlierRecommendations(ctx context.Context,
 		return nil, errors.New("recommendation request field required")
 	}
 
-	log.Debugf("[OutlierRecommendations]: metric: %v, multiplier: %v",
-		req.RecRequest.Metric, req.OutlierMultiplier)
+	log.Debugf("[OutlierRecommendations]: metric: %v, method: %v, "+
+		"multiplier: %v", req.RecRequest.Metric, req.Method,
+		req.OutlierMultiplier)
 
-	cfg, multiplier := parseOutlierRequest(ctx, s.cfg, req)
+	cfg, outlierCfg, err := parseOutlierRequest(ctx, s.cfg, req)
+	if err != nil {
+		return nil, err
+	}
 
-	report, err := recommend.OutlierRecommendations(cfg, multiplier)
+	report, err := recommend.OutlierRecommendations(cfg, outlierCfg)
 	if err != nil {
 		return nil, err
 	}
@@ -477,7 +623,10 @@ func (s *RPCServer) ThresholdRecommendations(ctx context.Context,
 	log.Debugf("[ThresholdRecommendations]: metric: %v, threshold: %v",
 		req.RecRequest.Metric, req.ThresholdValue)
 
-	cfg, threshold := parseThresholdRequest(ctx, s.cfg, req)
+	cfg, threshold, err := parseThresholdRequest(ctx, s.cfg, req)
+	if err != nil {
+		return nil, err
+	}
 
 	report, err := recommend.ThresholdRecommendations(cfg, threshold)
 	if err != nil {
@@ -492,10 +641,16 @@ func (s *RPCServer) ThresholdRecommendations(ctx context.Context,
 func (s *RPCServer) RevenueReport(ctx context.Context,
 	req *frdrpc.RevenueReportRequest) (*frdrpc.RevenueReportResponse, error) {
 
-	log.Debugf("[RevenueReport]: range: %v-%v, channels: %v", req.StartTime,
-		req.EndTime, req.ChanPoints)
+	log.Debugf("[RevenueReport]: range: %v-%v, channels: %v, "+
+		"attribution: %v", req.StartTime, req.EndTime, req.ChanPoints,
+		req.FeeAttribution)
 
-	revenueConfig := parseRevenueRequest(ctx, s.cfg, req)
+	revenueConfig, err := parseRevenueRequest(
+		ctx, s.cfg, s.policyStore, req,
+	)
+	if err != nil {
+		return nil, err
+	}
 
 	report, err := revenue.GetRevenueReport(revenueConfig)
 	if err != nil {
@@ -508,11 +663,17 @@ func (s *RPCServer) RevenueReport(ctx context.Context,
 // ChannelInsights returns the channel insights for our currently open set
 // of channels.
 func (s *RPCServer) ChannelInsights(ctx context.Context,
-	_ *frdrpc.ChannelInsightsRequest) (*frdrpc.ChannelInsightsResponse, error) {
+	req *frdrpc.ChannelInsightsRequest) (*frdrpc.ChannelInsightsResponse,
+	error) {
 
-	log.Debugf("[ChannelInsights]")
+	log.Debugf("[ChannelInsights]: attribution: %v", req.FeeAttribution)
+
+	attribution, err := feeAttributionFromRPC(req.FeeAttribution)
+	if err != nil {
+		return nil, err
+	}
 
-	insights, err := channelInsights(ctx, s.cfg)
+	insights, err := channelInsights(ctx, s.cfg, attribution)
 	if err != nil {
 		return nil, err
 	}
@@ -527,6 +688,15 @@ func (s *RPCServer) ExchangeRate(ctx context.Context,
 
 	log.Debugf("[FiatEstimate]: %v requests", len(req.Timestamps))
 
+	restrictions, err := restrictionsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if restrictions.stripFiat {
+		return nil, errFiatDenied
+	}
+
 	timestamps, priceCfg, err := parseExchangeRateRequest(req)
 	if err != nil {
 		return nil, err
@@ -544,25 +714,67 @@ func (s *RPCServer) ExchangeRate(ctx context.Context,
 func (s *RPCServer) NodeAudit(ctx context.Context,
 	req *frdrpc.NodeAuditRequest) (*frdrpc.NodeAuditResponse, error) {
 
-	log.Debugf("[NodeAudit]: range: %v-%v, fiat: %v", req.StartTime,
-		req.EndTime, req.DisableFiat)
+	log.Debugf("[NodeAudit]: range: %v-%v, fiat: %v, summary: %v, "+
+		"sign: %v", req.StartTime, req.EndTime, req.DisableFiat,
+		req.Summary, req.Sign)
+
+	restrictions, err := restrictionsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	onChain, offChain, err := parseNodeAuditRequest(ctx, s.cfg, req)
+	err = restrictions.checkAuditRange(req.StartTime, req.EndTime)
 	if err != nil {
 		return nil, err
 	}
 
-	onChainReport, err := accounting.OnChainReport(ctx, onChain)
+	return s.nodeAudit(ctx, req, restrictions)
+}
+
+// nodeAudit produces an audit for the period requested, applying the
+// restrictions of the request's macaroon.
+func (s *RPCServer) nodeAudit(ctx context.Context,
+	req *frdrpc.NodeAuditRequest,
+	restrictions *macaroonRestrictions) (*frdrpc.NodeAuditResponse, error) {
+
+	if restrictions.stripFiat {
+		req.DisableFiat = true
+	}
+
+	report, drift, err := auditWithClosedPeriods(
+		ctx, s.cfg, s.periodStore, s.archiveStore, req,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *frdrpc.NodeAuditResponse
+	if req.Summary {
+		resp, err = rpcSummaryResponse(report)
+	} else {
+		resp, err = rpcReportResponse(report)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	offChainReport, err := accounting.OffChainReport(ctx, offChain)
+	resp.PeriodDrift, err = rpcPeriodDrift(drift)
 	if err != nil {
 		return nil, err
 	}
 
-	return rpcReportResponse(append(onChainReport, offChainReport...))
+	if restrictions.stripFiat {
+		stripAuditFiat(resp)
+	}
+
+	if req.Sign {
+		resp.Signature, err = signReport(ctx, s.cfg, resp)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
 }
 
 // CloseReport returns a close report for the channel provided. Note that this
@@ -570,7 +782,7 @@ func (s *RPCServer) NodeAudit(ctx context.Context,
 func (s *RPCServer) CloseReport(ctx context.Context,
 	req *frdrpc.CloseReportRequest) (*frdrpc.CloseReportResponse, error) {
 
-	log.Debugf("[CloseReport]: %v", req.ChannelPoint)
+	log.Debugf("[CloseReport]: %v, sign: %v", req.ChannelPoint, req.Sign)
 
 	if err := s.requireNode(); err != nil {
 		return nil, err
@@ -583,7 +795,537 @@ func (s *RPCServer) CloseReport(ctx context.Context,
 		return nil, err
 	}
 
-	return rpcCloseReportResponse(report), nil
+	resp := rpcCloseReportResponse(report)
+
+	if req.Sign {
+		resp.Signature, err = signReport(ctx, s.cfg, resp)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
+// CloseDryRun simulates closing a set of channels, estimating the fees we
+// would pay to close them and the forwarding revenue that we would lose.
+func (s *RPCServer) CloseDryRun(ctx context.Context,
+	req *frdrpc.CloseDryRunRequest) (*frdrpc.CloseDryRunResponse, error) {
+
+	log.Debugf("[CloseDryRun]: channels: %v, conf target: %v",
+		req.ChanPoints, req.ConfTarget)
+
+	cfg, err := parseCloseDryRunRequest(ctx, s.cfg, req)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := dryrun.CloseDryRun(cfg, req.ChanPoints)
+	if err != nil {
+		return nil, err
+	}
+
+	return rpcCloseDryRunResponse(report), nil
+}
+
+// ForwardingFailures returns a report of the forwards that failed at our node
+// over the period requested.
+func (s *RPCServer) ForwardingFailures(ctx context.Context,
+	req *frdrpc.ForwardingFailuresRequest) (
+	*frdrpc.ForwardingFailuresResponse, error) {
+
+	log.Debugf("[ForwardingFailures]: range: %v-%v", req.StartTime,
+		req.EndTime)
+
+	if s.failureStore == nil {
+		return nil, errFailureStoreUnavailable
+	}
+
+	cfg := parseForwardingFailuresRequest(ctx, s.cfg, s.failureStore, req)
+
+	report, err := failures.GetReport(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return rpcForwardingFailuresResponse(report), nil
+}
+
+// PairFlows returns a matrix of the flows between pairs of channels over the
+// period requested, and classifies our open channels by their flows.
+func (s *RPCServer) PairFlows(ctx context.Context,
+	req *frdrpc.PairFlowsRequest) (*frdrpc.PairFlowsResponse, error) {
+
+	log.Debugf("[PairFlows]: range: %v-%v, bucket: %vs", req.StartTime,
+		req.EndTime, req.BucketSeconds)
+
+	report, err := flows.GetReport(parsePairFlowsRequest(ctx, s.cfg, req))
+	if err != nil {
+		return nil, err
+	}
+
+	return rpcPairFlowsResponse(report), nil
+}
+
+// OpenRecommendations returns suggestions for peers that we should open more
+// capacity with, including a suggested size and payback period.
+func (s *RPCServer) OpenRecommendations(ctx context.Context,
+	req *frdrpc.OpenRecommendationsRequest) (
+	*frdrpc.OpenRecommendationsResponse, error) {
+
+	log.Debugf("[OpenRecommendations]: range: %v-%v, conf target: %v",
+		req.StartTime, req.EndTime, req.ConfTarget)
+
+	cfg, err := parseOpenRecommendationsRequest(
+		ctx, s.cfg, s.failureStore, req,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := recommend.OpenRecommendations(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return rpcOpenRecommendationsResponse(report), nil
+}
+
+// PolicyHistory returns the history of our channel policies, with the volume
+// and fees that each channel earned under each policy.
+func (s *RPCServer) PolicyHistory(ctx context.Context,
+	req *frdrpc.PolicyHistoryRequest) (*frdrpc.PolicyHistoryResponse,
+	error) {
+
+	log.Debugf("[PolicyHistory]: range: %v-%v, channels: %v",
+		req.StartTime, req.EndTime, req.ChanPoints)
+
+	if s.policyStore == nil {
+		return nil, errPolicyStoreUnavailable
+	}
+
+	cfg := parsePolicyHistoryRequest(ctx, s.cfg, s.policyStore, req)
+
+	report, err := policies.GetReport(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return rpcPolicyHistoryResponse(req.ChanPoints, report), nil
+}
+
+// BalanceSheet returns our node's balances at the time requested.
+func (s *RPCServer) BalanceSheet(ctx context.Context,
+	req *frdrpc.BalanceSheetRequest) (*frdrpc.BalanceSheetResponse, error) {
+
+	log.Debugf("[BalanceSheet]: timestamp: %v, fiat: %v", req.Timestamp,
+		!req.DisableFiat)
+
+	restrictions, err := restrictionsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = restrictions.checkAuditRange(req.Timestamp, req.Timestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	if restrictions.stripFiat {
+		req.DisableFiat = true
+	}
+
+	cfg, err := parseBalanceSheetRequest(ctx, s.cfg, s.archiveStore, req)
+	if err != nil {
+		return nil, err
+	}
+
+	sheet, err := accounting.GetBalanceSheet(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return rpcBalanceSheetResponse(sheet), nil
+}
+
+// ClosePeriod closes a fiscal period, persisting a signed snapshot of its
+// audit entries.
+func (s *RPCServer) ClosePeriod(ctx context.Context,
+	req *frdrpc.ClosePeriodRequest) (*frdrpc.ClosePeriodResponse, error) {
+
+	log.Debugf("[ClosePeriod]: range: %v-%v, fiat: %v", req.StartTime,
+		req.EndTime, !req.DisableFiat)
+
+	if s.periodStore == nil {
+		return nil, errPeriodStoreUnavailable
+	}
+
+	restrictions, err := restrictionsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = restrictions.checkAuditRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	// We do not strip fiat values from closed periods, since they are
+	// persisted, so we require that they are closed without fiat values.
+	if restrictions.stripFiat && !req.DisableFiat {
+		return nil, errFiatDenied
+	}
+
+	period, err := closePeriod(
+		ctx, s.cfg, s.periodStore, s.archiveStore, req,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcPeriod, err := rpcClosedPeriod(period)
+	if err != nil {
+		return nil, err
+	}
+
+	return &frdrpc.ClosePeriodResponse{Period: rpcPeriod}, nil
+}
+
+// ListClosedPeriods lists the fiscal periods that have been closed.
+func (s *RPCServer) ListClosedPeriods(ctx context.Context,
+	_ *frdrpc.ListClosedPeriodsRequest) (*frdrpc.ListClosedPeriodsResponse,
+	error) {
+
+	log.Debugf("[ListClosedPeriods]")
+
+	if s.periodStore == nil {
+		return nil, errPeriodStoreUnavailable
+	}
+
+	restrictions, err := restrictionsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	closed, err := s.periodStore.ListPeriods()
+	if err != nil {
+		return nil, err
+	}
+
+	// Only list the periods that our macaroon would be permitted to
+	// audit.
+	permitted := make([]*periods.ClosedPeriod, 0, len(closed))
+	for _, period := range closed {
+		err := restrictions.checkAuditRange(
+			uint64(period.Start.Unix()), uint64(period.End.Unix()),
+		)
+		if err != nil {
+			continue
+		}
+
+		permitted = append(permitted, period)
+	}
+
+	resp, err := rpcListClosedPeriodsResponse(permitted)
+	if err != nil {
+		return nil, err
+	}
+
+	if restrictions.stripFiat {
+		for _, period := range resp.Periods {
+			period.Prices = nil
+		}
+	}
+
+	return resp, nil
+}
+
+// ExportAuditData exports the lnd data required to produce audits for the
+// period requested offline.
+func (s *RPCServer) ExportAuditData(ctx context.Context,
+	req *frdrpc.ExportAuditDataRequest) (*frdrpc.ExportAuditDataResponse,
+	error) {
+
+	log.Debugf("[ExportAuditData]: range: %v-%v", req.StartTime,
+		req.EndTime)
+
+	restrictions, err := restrictionsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = restrictions.checkAuditRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	export, err := exportAuditData(ctx, s.cfg, s.archiveStore, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &frdrpc.ExportAuditDataResponse{
+		Export: export,
+	}, nil
+}
+
+// BakeMacaroon bakes a macaroon that only grants access to the entities
+// requested, with optional caveats that restrict its audits.
+func (s *RPCServer) BakeMacaroon(ctx context.Context,
+	req *frdrpc.BakeMacaroonRequest) (*frdrpc.BakeMacaroonResponse,
+	error) {
+
+	log.Debugf("[BakeMacaroon]: entities: %v, read only: %v, audit "+
+		"range: %v-%v, strip fiat: %v", req.Entities, req.ReadOnly,
+		req.AuditStartTime, req.AuditEndTime, req.StripFiat)
+
+	if s.macaroonService == nil {
+		return nil, errMacaroonServiceUnavailable
+	}
+
+	mac, err := bakeMacaroon(ctx, s.macaroonService.Service, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &frdrpc.BakeMacaroonResponse{
+		Macaroon: mac,
+	}, nil
+}
+
+// StartAudit starts producing an audit in the background, returning the id
+// of the job that can be used to fetch its result.
+func (s *RPCServer) StartAudit(ctx context.Context,
+	req *frdrpc.NodeAuditRequest) (*frdrpc.StartAuditResponse, error) {
+
+	log.Debugf("[StartAudit]: range: %v-%v, fiat: %v, summary: %v, "+
+		"sign: %v", req.StartTime, req.EndTime, !req.DisableFiat,
+		req.Summary, req.Sign)
+
+	restrictions, err := restrictionsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = restrictions.checkAuditRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate our request before we start the job so that obviously
+	// invalid requests fail immediately.
+	if _, _, err := validateTimes(req.StartTime, req.EndTime); err != nil {
+		return nil, err
+	}
+
+	id, err := s.startAuditJob(req, restrictions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &frdrpc.StartAuditResponse{
+		JobId: id,
+	}, nil
+}
+
+// JobStatus returns the status and progress of a background job.
+func (s *RPCServer) JobStatus(ctx context.Context,
+	req *frdrpc.JobStatusRequest) (*frdrpc.JobStatusResponse, error) {
+
+	log.Debugf("[JobStatus]: job: %v", req.JobId)
+
+	restrictions, err := restrictionsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	job, _, err := s.jobStatus(req.JobId, restrictions)
+	if err != nil {
+		return nil, err
+	}
+
+	return rpcJobStatus(job)
+}
+
+// CancelJob cancels a running background job.
+func (s *RPCServer) CancelJob(ctx context.Context,
+	req *frdrpc.CancelJobRequest) (*frdrpc.CancelJobResponse, error) {
+
+	log.Debugf("[CancelJob]: job: %v", req.JobId)
+
+	restrictions, err := restrictionsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that our caller may access the job before we cancel it.
+	_, _, err = s.jobStatus(req.JobId, restrictions)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.jobManager.CancelJob(req.JobId); err != nil {
+		return nil, err
+	}
+
+	return &frdrpc.CancelJobResponse{}, nil
+}
+
+// JobResult returns the audit produced by a job that completed successfully.
+func (s *RPCServer) JobResult(ctx context.Context,
+	req *frdrpc.JobResultRequest) (*frdrpc.NodeAuditResponse, error) {
+
+	log.Debugf("[JobResult]: job: %v", req.JobId)
+
+	restrictions, err := restrictionsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.auditJobResult(req.JobId, restrictions)
+}
+
+// SubscribeAlerts streams the alerts raised by our alert rules until the
+// client cancels the stream or faraday shuts down.
+func (s *RPCServer) SubscribeAlerts(_ *frdrpc.SubscribeAlertsRequest,
+	stream frdrpc.FaradayServer_SubscribeAlertsServer) error {
+
+	log.Debugf("[SubscribeAlerts]")
+
+	if s.alertManager == nil {
+		return errAlertsDisabled
+	}
+
+	subscription, err := s.alertManager.SubscribeAlerts()
+	if err != nil {
+		return err
+	}
+	defer subscription.Cancel()
+
+	for {
+		select {
+		case update := <-subscription.Updates():
+			alert, ok := update.(*alerts.Alert)
+			if !ok {
+				continue
+			}
+
+			if err := stream.Send(rpcAlert(alert)); err != nil {
+				return err
+			}
+
+		case <-subscription.Quit():
+			return errAlertsStopped
+
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+// SubscribeEntries streams the entries recorded by our journal. All recorded
+// entries after the sequence number provided are sent first, followed by new
+// entries as they are recorded, until the client cancels the stream or
+// faraday shuts down. Only the entries within the audit range of the
+// caller's macaroon are sent.
+func (s *RPCServer) SubscribeEntries(req *frdrpc.SubscribeEntriesRequest,
+	stream frdrpc.FaradayServer_SubscribeEntriesServer) error {
+
+	log.Debugf("[SubscribeEntries]: after sequence: %v",
+		req.AfterSequence)
+
+	if s.journalRecorder == nil {
+		return errJournalDisabled
+	}
+
+	restrictions, err := restrictionsFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	err = checkEntryCursor(
+		s.journalRecorder, req.AfterSequence, restrictions,
+	)
+	if err != nil {
+		return err
+	}
+
+	// We subscribe before we replay our recorded entries, so that entries
+	// that are recorded while we replay are not missed. Any entries that
+	// we receive from both are skipped by sequence number.
+	subscription, err := s.journalRecorder.SubscribeEntries()
+	if err != nil {
+		return err
+	}
+	defer subscription.Cancel()
+
+	lastSent := req.AfterSequence
+	send := func(entry *journal.Entry) error {
+		if entry.Sequence <= lastSent {
+			return nil
+		}
+
+		// Entries outside of our caller's audit range are skipped.
+		if !restrictions.inAuditRange(entry.Timestamp) {
+			lastSent = entry.Sequence
+			return nil
+		}
+
+		rpcEntry, err := rpcJournalEntry(entry)
+		if err != nil {
+			return err
+		}
+
+		if restrictions.stripFiat {
+			stripEntryFiat(rpcEntry.Entry)
+		}
+
+		if err := stream.Send(rpcEntry); err != nil {
+			return err
+		}
+		lastSent = entry.Sequence
+
+		return nil
+	}
+
+	for {
+		entries, err := s.journalRecorder.ListEntries(
+			lastSent, replayBatchSize,
+		)
+		if err != nil {
+			return err
+		}
+
+		if len(entries) == 0 {
+			break
+		}
+
+		for _, entry := range entries {
+			if err := send(entry); err != nil {
+				return err
+			}
+		}
+	}
+
+	for {
+		select {
+		case update := <-subscription.Updates():
+			entry, ok := update.(*journal.Entry)
+			if !ok {
+				continue
+			}
+
+			if err := send(entry); err != nil {
+				return err
+			}
+
+		case <-subscription.Quit():
+			return errJournalStopped
+
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
 }
 
 // requireNode fails if we do not have a connection to a backing bitcoin node.
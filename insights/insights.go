@@ -30,8 +30,10 @@ type ChannelInfo struct {
 	VolumeOutgoing lnwire.MilliSatoshi
 
 	// FeesEarned is the total fees earned by the channel while routing.
-	// Note that fees are split evenly between incoming and outgoing
-	// channels.
+	// Note that fees are attributed to incoming and outgoing channels
+	// according to the fee attribution of our revenue report, and are
+	// split evenly between them if the report attributes the full fee to
+	// both channels.
 	FeesEarned lnwire.MilliSatoshi
 
 	// Confirmations is the number of confirmations the funding transction
@@ -71,6 +73,7 @@ func GetChannels(cfg *Config) ([]*ChannelInfo, error) {
 	}
 
 	insights := make([]*ChannelInfo, 0, len(channels))
+	attribution := cfg.RevenueReport.Attribution
 	for _, channel := range channels {
 		// Get the short channel ID so we can calculate the number of
 		// blocks the channel has been open for.
@@ -107,10 +110,17 @@ func GetChannels(cfg *Config) ([]*ChannelInfo, error) {
 			channelInsight.VolumeIncoming += rev.AmountIncoming
 			channelInsight.VolumeOutgoing += rev.AmountOutgoing
 
-			// We spilt fees evenly between the channels, so that
-			// we do not double count fees.
-			channelInsight.FeesEarned +=
-				(rev.FeesOutgoing + rev.FeesIncoming) / 2
+			// If our revenue report attributes the full fee of
+			// each forward to both of its channels, we split fees
+			// evenly between the channels so that we do not double
+			// count fees. Otherwise, the report has already split
+			// each fee between its channels.
+			fees := rev.FeesOutgoing + rev.FeesIncoming
+			if attribution == revenue.AttributeFull {
+				fees /= 2
+			}
+
+			channelInsight.FeesEarned += fees
 		}
 
 		insights = append(insights, channelInsight)
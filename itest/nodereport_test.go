@@ -223,7 +223,7 @@ func TestNodeAudit(t *testing.T) {
 
 	// Get our fee for our sweep tx.
 	sweepFee, err := fees.CalculateFee(
-		c.bitcoindClient.GetRawTransactionVerbose, sweepHash,
+		c.getTxDetails, sweepHash,
 	)
 	require.NoError(c.t, err, "could get sweep fee")
 
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
@@ -161,6 +162,23 @@ func (c *testContext) mempoolTxCount() int {
 	return len(txes)
 }
 
+// getTxDetails looks up a set of transactions from bitcoind.
+func (c *testContext) getTxDetails(txHashes []*chainhash.Hash) (
+	[]*btcjson.TxRawResult, error) {
+
+	txs := make([]*btcjson.TxRawResult, len(txHashes))
+	for i, txHash := range txHashes {
+		tx, err := c.bitcoindClient.GetRawTransactionVerbose(txHash)
+		if err != nil {
+			return nil, err
+		}
+
+		txs[i] = tx
+	}
+
+	return txs, nil
+}
+
 // balances stores the wallet and channel balances for alice and bob.
 type balances struct {
 	aliceWallet, aliceChannel btcutil.Amount
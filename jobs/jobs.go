@@ -0,0 +1,407 @@
+// Package jobs runs long running reports in the background, so that their
+// results can be fetched after the request that started them has ended.
+package jobs
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lightninglabs/faraday/progress"
+)
+
+var (
+	// ErrJobNotFound is returned if a job is not known, or its result has
+	// been pruned.
+	ErrJobNotFound = errors.New("job not found")
+
+	// ErrJobRunning is returned if the result of a job that has not yet
+	// finished is requested.
+	ErrJobRunning = errors.New("job still running")
+
+	// ErrJobFinished is returned if we are asked to cancel a job that has
+	// already finished.
+	ErrJobFinished = errors.New("job already finished")
+
+	// ErrJobCancelled is returned if the result of a cancelled job is
+	// requested.
+	ErrJobCancelled = errors.New("job cancelled")
+
+	// errManagerAlreadyStarted is returned if the manager is started more
+	// than once.
+	errManagerAlreadyStarted = errors.New("job manager already started")
+
+	// errManagerStopped is returned if a job is started after the manager
+	// has been stopped.
+	errManagerStopped = errors.New("job manager stopped")
+)
+
+const (
+	// DefaultRetention is the default amount of time that we keep the
+	// results of finished jobs for.
+	DefaultRetention = time.Hour * 24
+
+	// defaultPruneInterval is the default interval at which we prune the
+	// results of expired jobs.
+	defaultPruneInterval = time.Minute * 10
+
+	// jobIDLength is the number of random bytes in a job id.
+	jobIDLength = 16
+)
+
+// Status is the status of a job.
+type Status uint8
+
+const (
+	// StatusRunning indicates that a job is still running.
+	StatusRunning Status = iota
+
+	// StatusSucceeded indicates that a job completed successfully, and
+	// its result is available.
+	StatusSucceeded
+
+	// StatusFailed indicates that a job failed.
+	StatusFailed
+
+	// StatusCancelled indicates that a job was cancelled before it
+	// completed.
+	StatusCancelled
+)
+
+// String returns the string representation of a job status.
+func (s Status) String() string {
+	switch s {
+	case StatusRunning:
+		return "running"
+
+	case StatusSucceeded:
+		return "succeeded"
+
+	case StatusFailed:
+		return "failed"
+
+	case StatusCancelled:
+		return "cancelled"
+
+	default:
+		return "unknown"
+	}
+}
+
+// Job describes a job and the work that it has done.
+type Job struct {
+	// ID is the unique identifier of the job.
+	ID string
+
+	// Status is the current status of the job.
+	Status Status
+
+	// Created is the time that the job was started.
+	Created time.Time
+
+	// Finished is the time that the job finished, zero if it is still
+	// running.
+	Finished time.Time
+
+	// Error is the error that a failed job returned.
+	Error string
+
+	// Progress is the work that the job has done.
+	Progress progress.Progress
+
+	// Metadata is opaque data provided when the job was started, which is
+	// persisted with the job. It can be used to restrict access to the
+	// job.
+	Metadata []byte
+}
+
+// RunFunc is the work that a job performs. It should exit early if its
+// context is cancelled. Work that is recorded in the context's progress
+// tracker is reported in the job's progress.
+type RunFunc func(ctx context.Context) ([]byte, error)
+
+// Config provides the manager with its dependencies.
+type Config struct {
+	// Store persists our finished jobs.
+	Store *Store
+
+	// Retention is the amount of time that we keep the results of
+	// finished jobs for. If this value is not set, a default of 24 hours
+	// is used.
+	Retention time.Duration
+
+	// PruneInterval is the interval at which we prune the results of
+	// expired jobs. If this value is not set, a default of 10 minutes is
+	// used.
+	PruneInterval time.Duration
+}
+
+// runningJob is a job that has not yet been persisted.
+type runningJob struct {
+	job     Job
+	tracker *progress.Tracker
+	cancel  func()
+
+	// cancelled is true if the job was cancelled by a request to cancel
+	// it, or because we are shutting down.
+	cancelled bool
+}
+
+// Manager runs jobs in the background and holds on to their results for our
+// retention period.
+type Manager struct {
+	started int32 // To be used atomically.
+	stopped int32 // To be used atomically.
+
+	cfg *Config
+
+	// running holds the jobs that have not yet finished.
+	running map[string]*runningJob
+	mtx     sync.Mutex
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewManager returns a job manager. Note that the manager is not running,
+// and should be started using Start().
+func NewManager(cfg *Config) *Manager {
+	if cfg.Retention == 0 {
+		cfg.Retention = DefaultRetention
+	}
+
+	if cfg.PruneInterval == 0 {
+		cfg.PruneInterval = defaultPruneInterval
+	}
+
+	return &Manager{
+		cfg:     cfg,
+		running: make(map[string]*runningJob),
+		quit:    make(chan struct{}),
+	}
+}
+
+// Start starts pruning the results of expired jobs.
+func (m *Manager) Start() error {
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return errManagerAlreadyStarted
+	}
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		m.run()
+	}()
+
+	return nil
+}
+
+// Stop cancels all running jobs and waits for them to exit.
+func (m *Manager) Stop() {
+	if atomic.LoadInt32(&m.started) == 0 {
+		return
+	}
+
+	if !atomic.CompareAndSwapInt32(&m.stopped, 0, 1) {
+		return
+	}
+
+	m.mtx.Lock()
+	close(m.quit)
+	for _, job := range m.running {
+		job.cancelled = true
+		job.cancel()
+	}
+	m.mtx.Unlock()
+
+	m.wg.Wait()
+}
+
+// run prunes expired jobs immediately, and then on each tick of our interval
+// until we are stopped.
+func (m *Manager) run() {
+	ticker := time.NewTicker(m.cfg.PruneInterval)
+	defer ticker.Stop()
+
+	for {
+		pruned, err := m.cfg.Store.pruneJobs(
+			time.Now().Add(-m.cfg.Retention),
+		)
+		if err != nil {
+			log.Errorf("Could not prune jobs: %v", err)
+		} else if pruned > 0 {
+			log.Debugf("Pruned %v expired jobs", pruned)
+		}
+
+		select {
+		case <-ticker.C:
+
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// StartJob starts running a job in the background, returning its id. The
+// metadata provided is persisted with the job.
+func (m *Manager) StartJob(run RunFunc, metadata []byte) (string, error) {
+	idBytes := make([]byte, jobIDLength)
+	if _, err := rand.Read(idBytes); err != nil {
+		return "", err
+	}
+
+	tracker := &progress.Tracker{}
+	ctx, cancel := context.WithCancel(
+		progress.NewContext(context.Background(), tracker),
+	)
+
+	job := &runningJob{
+		job: Job{
+			ID:       hex.EncodeToString(idBytes),
+			Status:   StatusRunning,
+			Created:  time.Now(),
+			Metadata: metadata,
+		},
+		tracker: tracker,
+		cancel:  cancel,
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	// Check that we are not shutting down under our mutex, so that all
+	// of the jobs we start are cancelled on shutdown.
+	select {
+	case <-m.quit:
+		cancel()
+		return "", errManagerStopped
+
+	default:
+	}
+
+	m.running[job.job.ID] = job
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		defer cancel()
+
+		result, err := run(ctx)
+		m.finishJob(job, result, err)
+	}()
+
+	log.Debugf("Started job: %v", job.job.ID)
+
+	return job.job.ID, nil
+}
+
+// finishJob records the outcome of a job and persists it. The job is no
+// longer running once it has finished, so it is removed from our set of
+// running jobs even if it can't be persisted, in which case it is no longer
+// found.
+func (m *Manager) finishJob(job *runningJob, result []byte, err error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	delete(m.running, job.job.ID)
+
+	job.job.Finished = time.Now()
+	job.job.Progress = job.tracker.Progress()
+
+	switch {
+	case err == nil:
+		job.job.Status = StatusSucceeded
+
+	case job.cancelled:
+		job.job.Status = StatusCancelled
+		result = nil
+
+	default:
+		job.job.Status = StatusFailed
+		job.job.Error = err.Error()
+		result = nil
+	}
+
+	log.Debugf("Job: %v %v after %v", job.job.ID, job.job.Status,
+		job.job.Finished.Sub(job.job.Created))
+
+	if err := m.cfg.Store.addJob(&job.job, result); err != nil {
+		log.Errorf("Could not persist job %v: %v", job.job.ID, err)
+	}
+}
+
+// JobStatus returns the current state of a job.
+func (m *Manager) JobStatus(id string) (*Job, error) {
+	m.mtx.Lock()
+	running, ok := m.running[id]
+	if ok {
+		job := running.job
+		job.Progress = running.tracker.Progress()
+		m.mtx.Unlock()
+
+		return &job, nil
+	}
+	m.mtx.Unlock()
+
+	stored, err := m.cfg.Store.getJob(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stored.Job, nil
+}
+
+// CancelJob cancels a running job.
+func (m *Manager) CancelJob(id string) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	running, ok := m.running[id]
+	if !ok {
+		// If the job is not running, check whether it has finished
+		// so that we can return a more specific error.
+		if _, err := m.cfg.Store.getJob(id); err != nil {
+			return err
+		}
+
+		return ErrJobFinished
+	}
+
+	running.cancelled = true
+	running.cancel()
+
+	return nil
+}
+
+// JobResult returns the result of a job that succeeded. An error is returned
+// if the job is still running, or did not succeed.
+func (m *Manager) JobResult(id string) ([]byte, error) {
+	m.mtx.Lock()
+	_, ok := m.running[id]
+	m.mtx.Unlock()
+
+	if ok {
+		return nil, ErrJobRunning
+	}
+
+	stored, err := m.cfg.Store.getJob(id)
+	if err != nil {
+		return nil, err
+	}
+
+	switch stored.Status {
+	case StatusSucceeded:
+		return stored.Result, nil
+
+	case StatusCancelled:
+		return nil, ErrJobCancelled
+
+	default:
+		return nil, fmt.Errorf("job failed: %v", stored.Error)
+	}
+}
@@ -0,0 +1,234 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/faraday/progress"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestStore creates a job store backed by a temporary database.
+func newTestStore(t *testing.T) *Store {
+	db, err := kvdb.GetBoltBackend(&kvdb.BoltBackendConfig{
+		DBPath:     t.TempDir(),
+		DBFileName: "jobs.db",
+		DBTimeout:  time.Second,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	store, err := NewStore(db)
+	require.NoError(t, err)
+
+	return store
+}
+
+// newTestManager starts a job manager backed by the store provided.
+func newTestManager(t *testing.T, store *Store) *Manager {
+	manager := NewManager(&Config{
+		Store: store,
+	})
+	require.NoError(t, manager.Start())
+	t.Cleanup(manager.Stop)
+
+	return manager
+}
+
+// waitForStatus waits for a job to reach the status provided.
+func waitForStatus(t *testing.T, manager *Manager, id string,
+	status Status) *Job {
+
+	var job *Job
+	require.Eventually(t, func() bool {
+		var err error
+		job, err = manager.JobStatus(id)
+		require.NoError(t, err)
+
+		return job.Status == status
+	}, time.Second*5, time.Millisecond*10)
+
+	return job
+}
+
+// TestJobSucceeded tests tracking the progress of a job and fetching its
+// result once it completes.
+func TestJobSucceeded(t *testing.T) {
+	store := newTestStore(t)
+	manager := newTestManager(t, store)
+
+	var (
+		proceed = make(chan struct{})
+		tracked = make(chan struct{})
+	)
+
+	id, err := manager.StartJob(func(ctx context.Context) ([]byte, error) {
+		tracker := progress.FromContext(ctx)
+		tracker.AddPages(2)
+		tracker.AddPrices(3)
+		close(tracked)
+
+		<-proceed
+		tracker.AddEntries(4)
+
+		return []byte("result"), nil
+	}, []byte("metadata"))
+	require.NoError(t, err)
+
+	<-tracked
+
+	job, err := manager.JobStatus(id)
+	require.NoError(t, err)
+	require.Equal(t, StatusRunning, job.Status)
+	require.Equal(t, progress.Progress{Pages: 2, Prices: 3}, job.Progress)
+	require.Equal(t, []byte("metadata"), job.Metadata)
+
+	_, err = manager.JobResult(id)
+	require.ErrorIs(t, err, ErrJobRunning)
+
+	close(proceed)
+	job = waitForStatus(t, manager, id, StatusSucceeded)
+	require.Equal(t, progress.Progress{
+		Pages:   2,
+		Prices:  3,
+		Entries: 4,
+	}, job.Progress)
+	require.False(t, job.Finished.IsZero())
+
+	result, err := manager.JobResult(id)
+	require.NoError(t, err)
+	require.Equal(t, []byte("result"), result)
+
+	require.ErrorIs(t, manager.CancelJob(id), ErrJobFinished)
+
+	// Our result should be available to a new manager, since it is
+	// persisted.
+	manager.Stop()
+	manager = newTestManager(t, store)
+
+	result, err = manager.JobResult(id)
+	require.NoError(t, err)
+	require.Equal(t, []byte("result"), result)
+
+	job, err = manager.JobStatus(id)
+	require.NoError(t, err)
+	require.Equal(t, []byte("metadata"), job.Metadata)
+
+	_, err = manager.JobStatus("unknown")
+	require.ErrorIs(t, err, ErrJobNotFound)
+	require.ErrorIs(t, manager.CancelJob("unknown"), ErrJobNotFound)
+}
+
+// TestJobFailed tests that the error of a failed job is reported.
+func TestJobFailed(t *testing.T) {
+	manager := newTestManager(t, newTestStore(t))
+
+	id, err := manager.StartJob(func(context.Context) ([]byte, error) {
+		return nil, errors.New("mock failure")
+	}, nil)
+	require.NoError(t, err)
+
+	job := waitForStatus(t, manager, id, StatusFailed)
+	require.Equal(t, "mock failure", job.Error)
+
+	_, err = manager.JobResult(id)
+	require.ErrorContains(t, err, "mock failure")
+}
+
+// TestJobCancelled tests cancellation of running jobs, both by request and
+// on shutdown.
+func TestJobCancelled(t *testing.T) {
+	store := newTestStore(t)
+	manager := newTestManager(t, store)
+
+	blockingJob := func(ctx context.Context) ([]byte, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+
+	id, err := manager.StartJob(blockingJob, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, manager.CancelJob(id))
+	waitForStatus(t, manager, id, StatusCancelled)
+
+	_, err = manager.JobResult(id)
+	require.ErrorIs(t, err, ErrJobCancelled)
+
+	// Jobs that are running when we shut down should be cancelled.
+	id, err = manager.StartJob(blockingJob, nil)
+	require.NoError(t, err)
+
+	manager.Stop()
+
+	_, err = manager.StartJob(blockingJob, nil)
+	require.ErrorIs(t, err, errManagerStopped)
+
+	job, err := newTestManager(t, store).JobStatus(id)
+	require.NoError(t, err)
+	require.Equal(t, StatusCancelled, job.Status)
+}
+
+// TestJobNotPersisted tests that jobs that can't be persisted are no longer
+// tracked as running jobs.
+func TestJobNotPersisted(t *testing.T) {
+	store := newTestStore(t)
+	manager := newTestManager(t, store)
+
+	// Delete our bucket so that our job can't be persisted.
+	err := kvdb.Update(store.db, func(tx kvdb.RwTx) error {
+		return tx.DeleteTopLevelBucket(jobsBucket)
+	}, func() {})
+	require.NoError(t, err)
+
+	id, err := manager.StartJob(func(context.Context) ([]byte, error) {
+		return []byte("result"), nil
+	}, nil)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		manager.mtx.Lock()
+		defer manager.mtx.Unlock()
+
+		return len(manager.running) == 0
+	}, time.Second*5, time.Millisecond*10)
+
+	_, err = manager.JobResult(id)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrJobRunning)
+}
+
+// TestPruneJobs tests that jobs are pruned once they are older than our
+// retention period.
+func TestPruneJobs(t *testing.T) {
+	store := newTestStore(t)
+
+	now := time.Now()
+	for id, finished := range map[string]time.Time{
+		"old":    now.Add(time.Hour * -2),
+		"recent": now,
+	} {
+		err := store.addJob(&Job{
+			ID:       id,
+			Status:   StatusSucceeded,
+			Finished: finished,
+		}, nil)
+		require.NoError(t, err)
+	}
+
+	pruned, err := store.pruneJobs(now.Add(-time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 1, pruned)
+
+	_, err = store.getJob("old")
+	require.ErrorIs(t, err, ErrJobNotFound)
+
+	job, err := store.getJob("recent")
+	require.NoError(t, err)
+	require.Equal(t, StatusSucceeded, job.Status)
+}
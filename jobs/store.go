@@ -0,0 +1,146 @@
+package jobs
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+var (
+	// jobsBucket is the top level bucket that finished jobs are stored in,
+	// keyed by job id.
+	jobsBucket = []byte("jobs")
+
+	// errBucketNotFound is returned if our jobs bucket has not been
+	// created.
+	errBucketNotFound = errors.New("jobs bucket not found")
+)
+
+// storedJob is the persisted form of a finished job.
+type storedJob struct {
+	Job
+
+	// Result is the output of a successful job.
+	Result []byte
+}
+
+// Store persists finished jobs so that their results are available after
+// the request that started them has ended, and across restarts.
+type Store struct {
+	db kvdb.Backend
+}
+
+// NewStore creates a job store backed by the database provided, creating our
+// bucket if it does not yet exist.
+func NewStore(db kvdb.Backend) (*Store, error) {
+	err := kvdb.Update(db, func(tx kvdb.RwTx) error {
+		_, err := tx.CreateTopLevelBucket(jobsBucket)
+		return err
+	}, func() {})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Store{
+		db: db,
+	}, nil
+}
+
+// addJob persists a finished job and its result.
+func (s *Store) addJob(job *Job, result []byte) error {
+	value, err := json.Marshal(&storedJob{
+		Job:    *job,
+		Result: result,
+	})
+	if err != nil {
+		return err
+	}
+
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(jobsBucket)
+		if bucket == nil {
+			return errBucketNotFound
+		}
+
+		return bucket.Put([]byte(job.ID), value)
+	}, func() {})
+}
+
+// getJob looks up a finished job, returning ErrJobNotFound if it is not
+// stored.
+func (s *Store) getJob(id string) (*storedJob, error) {
+	var job *storedJob
+
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(jobsBucket)
+		if bucket == nil {
+			return errBucketNotFound
+		}
+
+		value := bucket.Get([]byte(id))
+		if value == nil {
+			return ErrJobNotFound
+		}
+
+		job = &storedJob{}
+
+		return json.Unmarshal(value, job)
+	}, func() {
+		job = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// pruneJobs deletes all jobs that finished before the time provided,
+// returning the number of jobs deleted.
+func (s *Store) pruneJobs(before time.Time) (int, error) {
+	var pruned int
+
+	err := kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(jobsBucket)
+		if bucket == nil {
+			return errBucketNotFound
+		}
+
+		var expired [][]byte
+		err := bucket.ForEach(func(k, v []byte) error {
+			var job storedJob
+			if err := json.Unmarshal(v, &job); err != nil {
+				return err
+			}
+
+			if job.Finished.Before(before) {
+				expired = append(
+					expired, append([]byte(nil), k...),
+				)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, key := range expired {
+			if err := bucket.Delete(key); err != nil {
+				return err
+			}
+		}
+		pruned = len(expired)
+
+		return nil
+	}, func() {
+		pruned = 0
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return pruned, nil
+}
@@ -0,0 +1,49 @@
+package journal
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultInterval is the default interval at which we audit our node
+	// for new entries.
+	DefaultInterval = time.Minute * 10
+
+	// DefaultLookback is the default amount of time before our last audit
+	// that we audit again, so that we record entries that are reported
+	// after the time at which they occurred.
+	DefaultLookback = time.Hour * 24
+)
+
+// Config defines exported config options for our journal.
+type Config struct {
+	Enable   bool          `long:"enable" description:"Record new accounting entries with their fiat values so that they can be streamed with SubscribeEntries. This periodically audits the node and queries the fiat price source."`
+	Interval time.Duration `long:"interval" description:"The interval at which the node is audited for new entries. Valid time units are {s, m, h}."`
+	Lookback time.Duration `long:"lookback" description:"The amount of time before the previous audit that each audit covers, so that entries which are reported after the time they occurred, such as transactions with earlier block timestamps, are recorded. Valid time units are {s, m, h}."`
+}
+
+// DefaultConfig is the default config for our journal, which is disabled.
+var DefaultConfig = &Config{
+	Interval: DefaultInterval,
+	Lookback: DefaultLookback,
+}
+
+// Validate checks that the values set in our config are sane.
+func (c *Config) Validate() error {
+	if !c.Enable {
+		return nil
+	}
+
+	if c.Interval <= 0 {
+		return fmt.Errorf("journal.interval must be positive, got: %v",
+			c.Interval)
+	}
+
+	if c.Lookback < 0 {
+		return fmt.Errorf("journal.lookback must not be negative, "+
+			"got: %v", c.Lookback)
+	}
+
+	return nil
+}
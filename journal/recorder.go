@@ -0,0 +1,185 @@
+package journal
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lightninglabs/faraday/accounting"
+	"github.com/lightningnetwork/lnd/subscribe"
+)
+
+// errRecorderAlreadyStarted is returned if the recorder is started more than
+// once.
+var errRecorderAlreadyStarted = errors.New("journal recorder already " +
+	"started")
+
+// RecorderConfig provides the recorder with the store that it records entries
+// in and the audit that it records them from.
+type RecorderConfig struct {
+	// Store is the journal that we record entries in.
+	Store *Store
+
+	// NodeAudit returns the entries for our node over [start, end), with
+	// their fiat values. On chain entries should only be included once
+	// their transaction has confirmed.
+	NodeAudit func(start, end time.Time) (accounting.Report, error)
+
+	// Interval is the interval at which we audit our node. If this value
+	// is not set, DefaultInterval is used.
+	Interval time.Duration
+
+	// Lookback is the amount of time before our last audit that each
+	// audit starts at.
+	Lookback time.Duration
+}
+
+// Recorder periodically audits our node and records entries that we have not
+// seen before in our journal, delivering them to our subscribers. Since we
+// persist the time up until which we have audited our node, we record the
+// entries that occurred while faraday was not running once it restarts.
+type Recorder struct {
+	started int32 // To be used atomically.
+
+	cfg *RecorderConfig
+
+	subscribers *subscribe.Server
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewRecorder returns a recorder. Note that the recorder is not running, and
+// should be started using Start().
+func NewRecorder(cfg *RecorderConfig) *Recorder {
+	if cfg.Interval == 0 {
+		cfg.Interval = DefaultInterval
+	}
+
+	return &Recorder{
+		cfg:         cfg,
+		subscribers: subscribe.NewServer(),
+		quit:        make(chan struct{}),
+	}
+}
+
+// Start starts recording entries.
+func (r *Recorder) Start() error {
+	if !atomic.CompareAndSwapInt32(&r.started, 0, 1) {
+		return errRecorderAlreadyStarted
+	}
+
+	if err := r.subscribers.Start(); err != nil {
+		return err
+	}
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		r.run()
+	}()
+
+	return nil
+}
+
+// Stop stops recording entries and waits for the recorder to exit. Our
+// subscribers are notified that we have shut down.
+func (r *Recorder) Stop() {
+	if atomic.LoadInt32(&r.started) == 0 {
+		return
+	}
+
+	close(r.quit)
+	r.wg.Wait()
+
+	if err := r.subscribers.Stop(); err != nil {
+		log.Errorf("Could not stop journal subscriptions: %v", err)
+	}
+}
+
+// SubscribeEntries returns a subscription that delivers each entry that we
+// record from now on as an *Entry. Callers that need entries that were
+// recorded before they subscribed should subscribe before listing them with
+// ListEntries, and skip the entries that they receive twice by sequence
+// number, so that no entries are missed. The caller must cancel the
+// subscription once it is no longer required.
+func (r *Recorder) SubscribeEntries() (*subscribe.Client, error) {
+	return r.subscribers.Subscribe()
+}
+
+// ListEntries returns up to limit recorded entries with sequence numbers after
+// the sequence provided.
+func (r *Recorder) ListEntries(after uint64, limit int) ([]*Entry, error) {
+	return r.cfg.Store.ListEntries(after, limit)
+}
+
+// run records entries immediately, and then on each tick of our interval
+// until we are stopped.
+func (r *Recorder) run() {
+	ticker := time.NewTicker(r.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		// Audits are requested with second precision, so we truncate
+		// our end time to ensure that our high water mark matches the
+		// end of the audit that we ran.
+		entries, err := r.record(time.Now().Truncate(time.Second))
+		if err != nil {
+			log.Errorf("Could not record journal entries: %v", err)
+		}
+
+		for _, entry := range entries {
+			err := r.subscribers.SendUpdate(entry)
+			if err != nil {
+				log.Errorf("Could not send entry to "+
+					"subscribers: %v", err)
+			}
+		}
+
+		select {
+		case <-ticker.C:
+
+		case <-r.quit:
+			return
+		}
+	}
+}
+
+// record audits our node from our lookback before our last audit until the
+// time provided, and records the entries that we have not seen before. If we
+// have not audited our node before, we start our lookback before now. If the
+// audit fails, our high water mark is not advanced so that the next audit
+// covers the same period.
+func (r *Recorder) record(now time.Time) ([]*Entry, error) {
+	start, err := r.cfg.Store.HighWater()
+	if err != nil {
+		return nil, err
+	}
+
+	if start.IsZero() {
+		start = now
+	}
+	start = start.Add(-r.cfg.Lookback)
+
+	report, err := r.cfg.NodeAudit(start, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// We assign sequence numbers in the order that our entries occurred.
+	sort.SliceStable(report, func(i, j int) bool {
+		return report[i].Timestamp.Before(report[j].Timestamp)
+	})
+
+	entries, err := r.cfg.Store.AddEntries(report, now)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("Recorded %v new journal entries from %v audit entries "+
+		"over [%v, %v)", len(entries), len(report), start, now)
+
+	return entries, nil
+}
@@ -0,0 +1,113 @@
+package journal
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/faraday/accounting"
+	"github.com/stretchr/testify/require"
+)
+
+// defaultTimeout is the amount of time we wait for entries to be delivered to
+// subscribers.
+const defaultTimeout = time.Second * 5
+
+// TestRecord tests the ranges that we audit our node over, and that we do not
+// advance our high water mark when an audit fails.
+func TestRecord(t *testing.T) {
+	start := time.Unix(1_600_000_000, 0)
+	lookback := time.Hour
+	errAudit := errors.New("audit failed")
+
+	var (
+		auditStart, auditEnd time.Time
+		report               accounting.Report
+		auditErr             error
+	)
+
+	recorder := NewRecorder(&RecorderConfig{
+		Store: newTestStore(t),
+		NodeAudit: func(start, end time.Time) (accounting.Report,
+			error) {
+
+			auditStart, auditEnd = start, end
+			return report, auditErr
+		},
+		Lookback: lookback,
+	})
+
+	// Our first audit covers our lookback before now. Our entries should
+	// be recorded in the order that they occurred.
+	report = accounting.Report{
+		testEntry("b", start.Add(-time.Minute)),
+		testEntry("a", start.Add(-time.Minute*2)),
+	}
+	entries, err := recorder.record(start)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, references(entries))
+	require.True(t, auditStart.Equal(start.Add(-lookback)))
+	require.True(t, auditEnd.Equal(start))
+
+	// If our next audit fails, we do not record anything.
+	auditErr = errAudit
+	_, err = recorder.record(start.Add(time.Hour * 5))
+	require.ErrorIs(t, err, errAudit)
+
+	// Our next successful audit starts our lookback before our last
+	// successful audit, so that we do not miss the entries that occurred
+	// while our audits were failing.
+	auditErr = nil
+	report = append(report, testEntry("c", start.Add(time.Hour)))
+	entries, err = recorder.record(start.Add(time.Hour * 10))
+	require.NoError(t, err)
+	require.Equal(t, []string{"c"}, references(entries))
+	require.True(t, auditStart.Equal(start.Add(-lookback)))
+	require.True(t, auditEnd.Equal(start.Add(time.Hour*10)))
+}
+
+// TestRecorderSubscribe tests delivery of recorded entries to subscribers.
+func TestRecorderSubscribe(t *testing.T) {
+	var (
+		audits  int32
+		release = make(chan struct{})
+	)
+
+	recorder := NewRecorder(&RecorderConfig{
+		Store: newTestStore(t),
+		NodeAudit: func(_, end time.Time) (accounting.Report,
+			error) {
+
+			// We block our first audit until we have subscribed,
+			// and only return our entry from it.
+			if atomic.AddInt32(&audits, 1) > 1 {
+				return nil, nil
+			}
+			<-release
+
+			return accounting.Report{
+				testEntry("a", end.Add(-time.Minute)),
+			}, nil
+		},
+	})
+	require.NoError(t, recorder.Start())
+	defer recorder.Stop()
+
+	subscription, err := recorder.SubscribeEntries()
+	require.NoError(t, err)
+	defer subscription.Cancel()
+
+	close(release)
+
+	select {
+	case update := <-subscription.Updates():
+		entry, ok := update.(*Entry)
+		require.True(t, ok)
+		require.Equal(t, "a", entry.Reference)
+		require.EqualValues(t, 1, entry.Sequence)
+
+	case <-time.After(defaultTimeout):
+		t.Fatal("timeout waiting for entry")
+	}
+}
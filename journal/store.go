@@ -0,0 +1,228 @@
+package journal
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lightninglabs/faraday/accounting"
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+var (
+	// entriesBucket is the top level bucket that our entries are stored
+	// in, keyed by sequence number so that they are sorted in the order
+	// that we recorded them.
+	entriesBucket = []byte("journal-entries")
+
+	// indexBucket is the top level bucket that maps the key of each entry
+	// that we have recorded to its sequence number, so that we do not
+	// record entries more than once.
+	indexBucket = []byte("journal-index")
+
+	// metaBucket is the top level bucket that holds the state of our
+	// journal.
+	metaBucket = []byte("journal-meta")
+
+	// highWaterKey is the key in our meta bucket that holds the end time
+	// of our last successful audit.
+	highWaterKey = []byte("high-water")
+
+	// errBucketNotFound is returned if one of our journal buckets has not
+	// been created.
+	errBucketNotFound = errors.New("journal bucket not found")
+)
+
+// Entry is an accounting entry that has been recorded in our journal.
+type Entry struct {
+	// Sequence is the sequence number of the entry. Sequence numbers
+	// start at one, and increase by one for each entry that we record.
+	Sequence uint64
+
+	*accounting.HarmonyEntry
+}
+
+// Store persists the entries that we have recorded, and the time up until
+// which we have audited our node.
+type Store struct {
+	db kvdb.Backend
+}
+
+// NewStore creates a journal backed by the database provided, creating our
+// buckets if they do not yet exist.
+func NewStore(db kvdb.Backend) (*Store, error) {
+	err := kvdb.Update(db, func(tx kvdb.RwTx) error {
+		for _, bucket := range [][]byte{
+			entriesBucket, indexBucket, metaBucket,
+		} {
+			_, err := tx.CreateTopLevelBucket(bucket)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}, func() {})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Store{
+		db: db,
+	}, nil
+}
+
+// AddEntries records the entries provided that we have not yet recorded,
+// assigning them sequence numbers in the order provided, and sets our high
+// water mark to the end time of the audit that they were produced by. This
+// is done in a single transaction so that we never advance our high water
+// mark without recording its entries. The newly recorded entries are
+// returned.
+func (s *Store) AddEntries(entries []*accounting.HarmonyEntry,
+	highWater time.Time) ([]*Entry, error) {
+
+	var added []*Entry
+
+	err := kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		entryBucket := tx.ReadWriteBucket(entriesBucket)
+		index := tx.ReadWriteBucket(indexBucket)
+		meta := tx.ReadWriteBucket(metaBucket)
+		if entryBucket == nil || index == nil || meta == nil {
+			return errBucketNotFound
+		}
+
+		for _, entry := range entries {
+			key := entryKey(entry)
+			if index.Get(key) != nil {
+				continue
+			}
+
+			sequence, err := entryBucket.NextSequence()
+			if err != nil {
+				return err
+			}
+
+			value, err := json.Marshal(entry)
+			if err != nil {
+				return err
+			}
+
+			err = entryBucket.Put(sequenceKey(sequence), value)
+			if err != nil {
+				return err
+			}
+
+			err = index.Put(key, sequenceKey(sequence))
+			if err != nil {
+				return err
+			}
+
+			added = append(added, &Entry{
+				Sequence:     sequence,
+				HarmonyEntry: entry,
+			})
+		}
+
+		return meta.Put(highWaterKey, timestampValue(highWater))
+	}, func() {
+		added = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return added, nil
+}
+
+// ListEntries returns up to limit entries with sequence numbers after the
+// sequence provided, in sequence order.
+func (s *Store) ListEntries(after uint64, limit int) ([]*Entry, error) {
+	var entries []*Entry
+
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(entriesBucket)
+		if bucket == nil {
+			return errBucketNotFound
+		}
+
+		cursor := bucket.ReadCursor()
+		k, v := cursor.Seek(sequenceKey(after + 1))
+		for ; k != nil && len(entries) < limit; k, v = cursor.Next() {
+			var entry accounting.HarmonyEntry
+			if err := json.Unmarshal(v, &entry); err != nil {
+				return err
+			}
+
+			entries = append(entries, &Entry{
+				Sequence:     binary.BigEndian.Uint64(k),
+				HarmonyEntry: &entry,
+			})
+		}
+
+		return nil
+	}, func() {
+		entries = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// HighWater returns the end time of our last successful audit, or the zero
+// time if we have not audited our node yet.
+func (s *Store) HighWater() (time.Time, error) {
+	var highWater time.Time
+
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(metaBucket)
+		if bucket == nil {
+			return errBucketNotFound
+		}
+
+		value := bucket.Get(highWaterKey)
+		if len(value) != 8 {
+			return nil
+		}
+
+		highWater = time.Unix(0, int64(binary.BigEndian.Uint64(value)))
+
+		return nil
+	}, func() {
+		highWater = time.Time{}
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return highWater, nil
+}
+
+// sequenceKey returns the key that we store an entry with the sequence
+// number provided under.
+func sequenceKey(sequence uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, sequence)
+
+	return key
+}
+
+// timestampValue encodes a timestamp with nanosecond precision.
+func timestampValue(timestamp time.Time) []byte {
+	value := make([]byte, 8)
+	binary.BigEndian.PutUint64(value, uint64(timestamp.UnixNano()))
+
+	return value
+}
+
+// entryKey returns the key that identifies an entry across audits. Not all
+// entries have a reference or txid, so we include the entry's type,
+// timestamp and amount to distinguish between them.
+func entryKey(entry *accounting.HarmonyEntry) []byte {
+	return []byte(fmt.Sprintf("%d/%v/%v/%v/%d/%d/%v", entry.Type,
+		entry.OnChain, entry.TxID, entry.Reference,
+		entry.Timestamp.UnixNano(), entry.Amount, entry.Credit))
+}
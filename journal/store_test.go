@@ -0,0 +1,112 @@
+package journal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/faraday/accounting"
+	"github.com/lightninglabs/faraday/fiat"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestStore creates a journal backed by a temporary database.
+func newTestStore(t *testing.T) *Store {
+	db, err := kvdb.GetBoltBackend(&kvdb.BoltBackendConfig{
+		DBPath:     t.TempDir(),
+		DBFileName: "test.db",
+		DBTimeout:  time.Second,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	store, err := NewStore(db)
+	require.NoError(t, err)
+
+	return store
+}
+
+// testEntry returns an entry with the reference and timestamp provided.
+func testEntry(reference string, timestamp time.Time) *accounting.HarmonyEntry {
+	return &accounting.HarmonyEntry{
+		Timestamp: timestamp,
+		Amount:    1000,
+		FiatValue: decimal.NewFromInt(2),
+		Reference: reference,
+		Type:      accounting.EntryTypeReceipt,
+		Credit:    true,
+		BTCPrice: &fiat.Price{
+			Timestamp: timestamp.Add(-time.Minute),
+			Price:     decimal.NewFromInt(20_000),
+			Currency:  "USD",
+		},
+	}
+}
+
+// references returns the references of the entries provided.
+func references(entries []*Entry) []string {
+	var refs []string
+	for _, entry := range entries {
+		refs = append(refs, entry.Reference)
+	}
+
+	return refs
+}
+
+// TestStore tests recording entries, deduplicating them across audits and
+// listing them from a sequence number.
+func TestStore(t *testing.T) {
+	store := newTestStore(t)
+
+	start := time.Unix(1_600_000_000, 0)
+
+	// We have not audited our node yet, so we have no high water mark.
+	highWater, err := store.HighWater()
+	require.NoError(t, err)
+	require.True(t, highWater.IsZero())
+
+	added, err := store.AddEntries([]*accounting.HarmonyEntry{
+		testEntry("a", start),
+		testEntry("b", start.Add(time.Minute)),
+	}, start.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, references(added))
+	require.EqualValues(t, 1, added[0].Sequence)
+	require.EqualValues(t, 2, added[1].Sequence)
+
+	highWater, err = store.HighWater()
+	require.NoError(t, err)
+	require.True(t, highWater.Equal(start.Add(time.Hour)))
+
+	// When our audits overlap, we only record entries that we have not
+	// seen before.
+	added, err = store.AddEntries([]*accounting.HarmonyEntry{
+		testEntry("b", start.Add(time.Minute)),
+		testEntry("c", start.Add(time.Hour)),
+	}, start.Add(time.Hour*2))
+	require.NoError(t, err)
+	require.Equal(t, []string{"c"}, references(added))
+	require.EqualValues(t, 3, added[0].Sequence)
+
+	// Our entries should be listed in sequence order, with their fiat
+	// values intact.
+	entries, err := store.ListEntries(0, 10)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b", "c"}, references(entries))
+	require.True(t, entries[0].FiatValue.Equal(decimal.NewFromInt(2)))
+	require.True(t, entries[0].BTCPrice.Price.Equal(
+		decimal.NewFromInt(20_000),
+	))
+	require.True(t, entries[0].Timestamp.Equal(start))
+
+	entries, err = store.ListEntries(1, 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"b"}, references(entries))
+
+	entries, err = store.ListEntries(3, 10)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
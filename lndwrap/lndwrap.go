@@ -51,13 +51,31 @@ func ListInvoices(ctx context.Context, startOffset, maxInvoices uint64,
 func ListPayments(ctx context.Context, startOffset, maxPayments uint64,
 	lnd lndclient.LightningClient) ([]lndclient.Payment, error) {
 
+	return listPayments(ctx, startOffset, maxPayments, false, lnd)
+}
+
+// ListAllPayments makes a set of paginated calls to lnd to get our full set
+// of payments, including payments that are in flight or have failed.
+func ListAllPayments(ctx context.Context, startOffset, maxPayments uint64,
+	lnd lndclient.LightningClient) ([]lndclient.Payment, error) {
+
+	return listPayments(ctx, startOffset, maxPayments, true, lnd)
+}
+
+// listPayments makes a set of paginated calls to lnd to get our payments,
+// optionally including incomplete payments.
+func listPayments(ctx context.Context, startOffset, maxPayments uint64,
+	includeIncomplete bool, lnd lndclient.LightningClient) (
+	[]lndclient.Payment, error) {
+
 	var payments []lndclient.Payment
 
 	query := func(offset, maxEvents uint64) (uint64, uint64, error) {
 		resp, err := lnd.ListPayments(
 			ctx, lndclient.ListPaymentsRequest{
-				Offset:      offset,
-				MaxPayments: maxEvents,
+				Offset:            offset,
+				MaxPayments:       maxEvents,
+				IncludeIncomplete: includeIncomplete,
 			},
 		)
 		if err != nil {
@@ -0,0 +1,340 @@
+package lndwrap
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lightninglabs/faraday/paginater"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+// indexedItem is the index offset and creation time of an invoice or payment.
+type indexedItem struct {
+	index   uint64
+	created time.Time
+}
+
+// indexQueries provides the single item queries that we use to locate the
+// index offsets of items created at a given time. Each query returns nil if
+// there is no item that matches it.
+type indexQueries struct {
+	// filtered returns the first item created at or after the time
+	// provided, using lnd's creation date filters. Versions of lnd that
+	// do not support these filters return their first item instead.
+	filtered func(created time.Time) (*indexedItem, error)
+
+	// after returns the first item after the index offset provided.
+	after func(offset uint64) (*indexedItem, error)
+
+	// last returns the item with the highest index offset.
+	last func() (*indexedItem, error)
+}
+
+// locateOffset returns the index offset of the last item created before the
+// time provided, so that paginating from this offset returns the items
+// created from that time onwards. If lnd supports creation date filters, we
+// use them to locate the offset with a single query, otherwise we binary
+// search over index offsets. Note that this relies on items being created in
+// the order of their index offset.
+//
+// The boolean returned is false if no items were created at or after the time
+// provided.
+func locateOffset(queries *indexQueries, created time.Time) (uint64, bool,
+	error) {
+
+	first, err := queries.filtered(created)
+	if err != nil {
+		return 0, false, err
+	}
+
+	// If lnd has no items at or after our time, we don't need to query
+	// any further. Versions of lnd without filters only return no items
+	// if they have no items at all, so this is true for them as well.
+	if first == nil {
+		return 0, false, nil
+	}
+
+	// If our filter was applied, the item returned is the first one at or
+	// after our time.
+	if !first.created.Before(created) {
+		return first.index - 1, true, nil
+	}
+
+	log.Debugf("Creation date filters not supported, searching index "+
+		"offsets for items created at: %v", created)
+
+	last, err := queries.last()
+	if err != nil {
+		return 0, false, err
+	}
+
+	if last == nil || last.created.Before(created) {
+		return 0, false, nil
+	}
+
+	// We search for the offset of the last item that was created before
+	// our time. All items at or below low were created before our time,
+	// and the last item created before our time is at or below high.
+	low, high := first.index, last.index
+	for low < high {
+		mid := low + (high-low)/2
+
+		item, err := queries.after(mid)
+		if err != nil {
+			return 0, false, err
+		}
+
+		// Our last item was created after our time, so we expect an
+		// item after any offset below it. We handle a nil item in
+		// case items were deleted while we were searching.
+		if item == nil || !item.created.Before(created) {
+			high = mid
+			continue
+		}
+
+		low = item.index
+	}
+
+	return low, true, nil
+}
+
+// listInRange makes paginated queries for the items created in
+// [startTime, endTime), using the query function provided. Our final page is
+// limited to the number of index offsets left before our end offset, so it
+// may include a few items created after our end time if items have been
+// deleted.
+func listInRange(ctx context.Context, queries *indexQueries,
+	query paginater.PaginatedQuery, maxItems uint64, startTime,
+	endTime time.Time) error {
+
+	startOffset, ok, err := locateOffset(queries, startTime)
+	if err != nil {
+		return err
+	}
+
+	// If no items were created at or after our start time, there is
+	// nothing for us to query.
+	if !ok {
+		return nil
+	}
+
+	endOffset, bounded, err := locateOffset(queries, endTime)
+	if err != nil {
+		return err
+	}
+
+	// If there are no items within our range, we can exit early.
+	if bounded && endOffset <= startOffset {
+		return nil
+	}
+
+	log.Debugf("Querying items in [%v, %v) from offset: %v to: %v "+
+		"(bounded: %v)", startTime, endTime, startOffset, endOffset,
+		bounded)
+
+	rangeQuery := func(offset, maxEvents uint64) (uint64, uint64, error) {
+		if bounded && offset >= endOffset {
+			return offset, 0, nil
+		}
+
+		// If our page may extend past our end offset, we only query
+		// as many items as there are offsets left before it, and end
+		// our pagination after this page.
+		if bounded && endOffset-offset < maxEvents {
+			lastOffset, _, err := query(offset, endOffset-offset)
+			return lastOffset, 0, err
+		}
+
+		return query(offset, maxEvents)
+	}
+
+	return paginater.QueryPaginated(ctx, rangeQuery, startOffset, maxItems)
+}
+
+// ListInvoicesInRange makes paginated calls to lnd to get the invoices that
+// were created in [startTime, endTime). Rather than querying our full set of
+// invoices, we locate the index offsets of the invoices in our range and only
+// query the pages between them.
+func ListInvoicesInRange(ctx context.Context, startTime, endTime time.Time,
+	maxInvoices uint64, lnd lndclient.LightningClient) (
+	[]lndclient.Invoice, error) {
+
+	var invoices []lndclient.Invoice
+
+	query := func(offset, maxInvoices uint64) (uint64, uint64, error) {
+		resp, err := lnd.ListInvoices(
+			ctx, lndclient.ListInvoicesRequest{
+				Offset:      offset,
+				MaxInvoices: maxInvoices,
+			},
+		)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		invoices = append(invoices, resp.Invoices...)
+
+		return resp.LastIndexOffset, uint64(len(resp.Invoices)), nil
+	}
+
+	err := listInRange(
+		ctx, invoiceIndexQueries(ctx, lnd), query, maxInvoices,
+		startTime, endTime,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("ListInvoicesInRange failed: %w", err)
+	}
+
+	return invoices, nil
+}
+
+// invoiceIndexQueries returns the queries used to locate the index offsets of
+// our invoices. We use lnd's raw client because lndclient does not expose
+// creation date filters.
+func invoiceIndexQueries(ctx context.Context,
+	lnd lndclient.LightningClient) *indexQueries {
+
+	listInvoices := func(req *lnrpc.ListInvoiceRequest) (*indexedItem,
+		error) {
+
+		rpcCtx, timeout, client := lnd.RawClientWithMacAuth(ctx)
+		rpcCtx, cancel := context.WithTimeout(rpcCtx, timeout)
+		defer cancel()
+
+		req.NumMaxInvoices = 1
+		resp, err := client.ListInvoices(rpcCtx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(resp.Invoices) == 0 {
+			return nil, nil
+		}
+
+		return &indexedItem{
+			index:   resp.Invoices[0].AddIndex,
+			created: time.Unix(resp.Invoices[0].CreationDate, 0),
+		}, nil
+	}
+
+	return &indexQueries{
+		filtered: func(created time.Time) (*indexedItem, error) {
+			return listInvoices(&lnrpc.ListInvoiceRequest{
+				CreationDateStart: unixTime(created),
+			})
+		},
+		after: func(offset uint64) (*indexedItem, error) {
+			return listInvoices(&lnrpc.ListInvoiceRequest{
+				IndexOffset: offset,
+			})
+		},
+		last: func() (*indexedItem, error) {
+			return listInvoices(&lnrpc.ListInvoiceRequest{
+				Reversed: true,
+			})
+		},
+	}
+}
+
+// ListPaymentsInRange makes paginated calls to lnd to get the payments that
+// were created in [startTime, endTime). Rather than querying our full set of
+// payments, we locate the index offsets of the payments in our range and only
+// query the pages between them.
+func ListPaymentsInRange(ctx context.Context, startTime, endTime time.Time,
+	maxPayments uint64, lnd lndclient.LightningClient) (
+	[]lndclient.Payment, error) {
+
+	var payments []lndclient.Payment
+
+	query := func(offset, maxEvents uint64) (uint64, uint64, error) {
+		resp, err := lnd.ListPayments(
+			ctx, lndclient.ListPaymentsRequest{
+				Offset:      offset,
+				MaxPayments: maxEvents,
+			},
+		)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		payments = append(payments, resp.Payments...)
+
+		return resp.LastIndexOffset, uint64(len(resp.Payments)), nil
+	}
+
+	err := listInRange(
+		ctx, paymentIndexQueries(ctx, lnd), query, maxPayments,
+		startTime, endTime,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("ListPaymentsInRange failed: %w", err)
+	}
+
+	return payments, nil
+}
+
+// paymentIndexQueries returns the queries used to locate the index offsets of
+// our payments. We use lnd's raw client because lndclient does not expose
+// creation date filters.
+func paymentIndexQueries(ctx context.Context,
+	lnd lndclient.LightningClient) *indexQueries {
+
+	listPayments := func(req *lnrpc.ListPaymentsRequest) (*indexedItem,
+		error) {
+
+		rpcCtx, timeout, client := lnd.RawClientWithMacAuth(ctx)
+		rpcCtx, cancel := context.WithTimeout(rpcCtx, timeout)
+		defer cancel()
+
+		req.MaxPayments = 1
+		resp, err := client.ListPayments(rpcCtx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(resp.Payments) == 0 {
+			return nil, nil
+		}
+
+		return &indexedItem{
+			index:   resp.Payments[0].PaymentIndex,
+			created: time.Unix(0, resp.Payments[0].CreationTimeNs),
+		}, nil
+	}
+
+	return &indexQueries{
+		filtered: func(created time.Time) (*indexedItem, error) {
+			return listPayments(&lnrpc.ListPaymentsRequest{
+				CreationDateStart: unixTime(created),
+			})
+		},
+		after: func(offset uint64) (*indexedItem, error) {
+			return listPayments(&lnrpc.ListPaymentsRequest{
+				IndexOffset: offset,
+			})
+		},
+		last: func() (*indexedItem, error) {
+			return listPayments(&lnrpc.ListPaymentsRequest{
+				Reversed: true,
+			})
+		},
+	}
+}
+
+// unixTime returns the unix time of a timestamp, rounded up to the next
+// second so that creation date filters do not include items created before
+// it. Timestamps before the unix epoch return zero.
+func unixTime(t time.Time) uint64 {
+	if t.Unix() < 0 {
+		return 0
+	}
+
+	unix := uint64(t.Unix())
+	if t.Nanosecond() != 0 {
+		unix++
+	}
+
+	return unix
+}
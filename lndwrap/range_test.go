@@ -0,0 +1,280 @@
+package lndwrap
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockItems is a set of items ordered by index offset, which mocks lnd's
+// invoices or payments.
+type mockItems struct {
+	items []indexedItem
+
+	// filters is true if our mock supports creation date filters.
+	filters bool
+
+	// queries is the number of single item queries that were made.
+	queries int
+}
+
+// newMockItems creates a set of items with the index offsets provided, each
+// created an hour after the previous one.
+func newMockItems(start time.Time, filters bool,
+	indexes ...uint64) *mockItems {
+
+	m := &mockItems{
+		filters: filters,
+	}
+
+	for i, index := range indexes {
+		m.items = append(m.items, indexedItem{
+			index:   index,
+			created: start.Add(time.Hour * time.Duration(i)),
+		})
+	}
+
+	return m
+}
+
+// first returns the first item that matches the predicate provided.
+func (m *mockItems) first(match func(indexedItem) bool) *indexedItem {
+	m.queries++
+
+	for _, item := range m.items {
+		if match(item) {
+			item := item
+			return &item
+		}
+	}
+
+	return nil
+}
+
+// indexQueries returns queries over our set of items.
+func (m *mockItems) indexQueries() *indexQueries {
+	return &indexQueries{
+		filtered: func(created time.Time) (*indexedItem, error) {
+			return m.first(func(item indexedItem) bool {
+				return !m.filters ||
+					!item.created.Before(created)
+			}), nil
+		},
+		after: func(offset uint64) (*indexedItem, error) {
+			return m.first(func(item indexedItem) bool {
+				return item.index > offset
+			}), nil
+		},
+		last: func() (*indexedItem, error) {
+			m.queries++
+
+			if len(m.items) == 0 {
+				return nil, nil
+			}
+
+			item := m.items[len(m.items)-1]
+			return &item, nil
+		},
+	}
+}
+
+// query is a paginated query over our set of items, which records the
+// indexes of the items returned.
+func (m *mockItems) query(returned *[]uint64) func(offset,
+	maxEvents uint64) (uint64, uint64, error) {
+
+	return func(offset, maxEvents uint64) (uint64, uint64, error) {
+		var count uint64
+		for _, item := range m.items {
+			if item.index <= offset || count == maxEvents {
+				continue
+			}
+
+			*returned = append(*returned, item.index)
+			offset = item.index
+			count++
+		}
+
+		return offset, count, nil
+	}
+}
+
+// TestLocateOffset tests locating the index offset of the items created at a
+// given time, with and without creation date filters.
+func TestLocateOffset(t *testing.T) {
+	t.Parallel()
+
+	start := time.Unix(1_000_000, 0)
+	indexes := []uint64{1, 2, 4, 5, 9, 10, 11, 15, 20, 21}
+
+	// Since index offsets have gaps, there may be multiple offsets that
+	// locate an item, so we test the first item after the offset rather
+	// than the offset itself.
+	tests := []struct {
+		name     string
+		created  time.Time
+		next     uint64
+		expected bool
+	}{
+		{
+			name:     "before all items",
+			created:  start.Add(-time.Hour),
+			next:     1,
+			expected: true,
+		},
+		{
+			name:     "first item",
+			created:  start,
+			next:     1,
+			expected: true,
+		},
+		{
+			name:     "between items",
+			created:  start.Add(time.Minute * 150),
+			next:     5,
+			expected: true,
+		},
+		{
+			name:     "after index gap",
+			created:  start.Add(time.Hour * 4),
+			next:     9,
+			expected: true,
+		},
+		{
+			name:     "last item",
+			created:  start.Add(time.Hour * 9),
+			next:     21,
+			expected: true,
+		},
+		{
+			name:     "after all items",
+			created:  start.Add(time.Hour * 10),
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, filters := range []bool{true, false} {
+				items := newMockItems(
+					start, filters, indexes...,
+				)
+
+				offset, ok, err := locateOffset(
+					items.indexQueries(), test.created,
+				)
+				require.NoError(t, err)
+				require.Equal(t, test.expected, ok)
+
+				// With filters we only need a single query.
+				if filters {
+					require.Equal(t, 1, items.queries)
+				}
+
+				if !ok {
+					continue
+				}
+
+				next, err := items.indexQueries().after(
+					offset,
+				)
+				require.NoError(t, err)
+				require.Equal(t, test.next, next.index)
+			}
+		})
+	}
+
+	// We should not find an offset if we have no items.
+	items := newMockItems(start, false)
+	_, ok, err := locateOffset(items.indexQueries(), start)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+// TestListInRange tests that we only query the pages that contain the items
+// in our range.
+func TestListInRange(t *testing.T) {
+	t.Parallel()
+
+	start := time.Unix(1_000_000, 0)
+
+	var indexes []uint64
+	for i := uint64(1); i <= 100; i++ {
+		indexes = append(indexes, i)
+	}
+
+	tests := []struct {
+		name      string
+		startTime time.Time
+		endTime   time.Time
+		expected  []uint64
+	}{
+		{
+			name:      "within items",
+			startTime: start.Add(time.Hour * 10),
+			endTime:   start.Add(time.Hour * 25),
+			expected:  indexes[10:25],
+		},
+		{
+			name:      "until present",
+			startTime: start.Add(time.Hour * 90),
+			endTime:   start.Add(time.Hour * 200),
+			expected:  indexes[90:],
+		},
+		{
+			name:      "all items",
+			startTime: start.Add(-time.Hour),
+			endTime:   start.Add(time.Hour * 200),
+			expected:  indexes,
+		},
+		{
+			name:      "no items",
+			startTime: start.Add(time.Minute * 10),
+			endTime:   start.Add(time.Minute * 20),
+		},
+		{
+			name:      "after items",
+			startTime: start.Add(time.Hour * 200),
+			endTime:   start.Add(time.Hour * 300),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, filters := range []bool{true, false} {
+				items := newMockItems(
+					start, filters, indexes...,
+				)
+
+				var returned []uint64
+				err := listInRange(
+					context.Background(),
+					items.indexQueries(),
+					items.query(&returned), 10,
+					test.startTime, test.endTime,
+				)
+				require.NoError(t, err)
+				require.Equal(t, test.expected, returned)
+			}
+		})
+	}
+}
+
+// TestUnixTime tests conversion of timestamps for creation date filters.
+func TestUnixTime(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, uint64(0), unixTime(time.Unix(-10, 0)))
+	require.Equal(t, uint64(10), unixTime(time.Unix(10, 0)))
+	require.Equal(t, uint64(11), unixTime(time.Unix(10, 1)))
+}
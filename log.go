@@ -3,9 +3,19 @@ package faraday
 import (
 	"github.com/btcsuite/btclog/v2"
 	"github.com/lightninglabs/faraday/accounting"
+	"github.com/lightninglabs/faraday/alerts"
+	"github.com/lightninglabs/faraday/archive"
+	"github.com/lightninglabs/faraday/chain"
 	"github.com/lightninglabs/faraday/dataset"
+	"github.com/lightninglabs/faraday/failures"
 	"github.com/lightninglabs/faraday/fiat"
 	"github.com/lightninglabs/faraday/frdrpcserver"
+	"github.com/lightninglabs/faraday/jobs"
+	"github.com/lightninglabs/faraday/journal"
+	"github.com/lightninglabs/faraday/lndwrap"
+	"github.com/lightninglabs/faraday/metrics"
+	"github.com/lightninglabs/faraday/periods"
+	"github.com/lightninglabs/faraday/policies"
 	"github.com/lightninglabs/faraday/recommend"
 	"github.com/lightninglabs/faraday/revenue"
 	"github.com/lightningnetwork/lnd/build"
@@ -37,6 +47,16 @@ func SetupLoggers(root *build.SubLoggerManager, intercept signal.Interceptor) {
 	addSubLogger(root, revenue.Subsystem, intercept, revenue.UseLogger)
 	addSubLogger(root, fiat.Subsystem, intercept, fiat.UseLogger)
 	addSubLogger(root, accounting.Subsystem, intercept, accounting.UseLogger)
+	addSubLogger(root, failures.Subsystem, intercept, failures.UseLogger)
+	addSubLogger(root, policies.Subsystem, intercept, policies.UseLogger)
+	addSubLogger(root, periods.Subsystem, intercept, periods.UseLogger)
+	addSubLogger(root, archive.Subsystem, intercept, archive.UseLogger)
+	addSubLogger(root, chain.Subsystem, intercept, chain.UseLogger)
+	addSubLogger(root, jobs.Subsystem, intercept, jobs.UseLogger)
+	addSubLogger(root, lndwrap.Subsystem, intercept, lndwrap.UseLogger)
+	addSubLogger(root, metrics.Subsystem, intercept, metrics.UseLogger)
+	addSubLogger(root, alerts.Subsystem, intercept, alerts.UseLogger)
+	addSubLogger(root, journal.Subsystem, intercept, journal.UseLogger)
 }
 
 // UseLogger uses a specified Logger to output package logging info.
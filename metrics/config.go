@@ -0,0 +1,19 @@
+package metrics
+
+import "time"
+
+// DefaultInterval is the default interval at which we update the metrics that
+// we export for our channels and revenue.
+const DefaultInterval = time.Minute * 5
+
+// Config defines exported config options for our metrics listener.
+type Config struct {
+	Listen   string        `long:"listen" description:"Address to serve prometheus metrics on, for example localhost:9466. If not specified, no metrics are exported."`
+	Interval time.Duration `long:"interval" description:"The interval at which channel and revenue metrics are updated. Valid time units are {s, m, h}."`
+}
+
+// DefaultConfig is the default config for our metrics listener, which does not
+// export metrics unless a listen address is set.
+var DefaultConfig = &Config{
+	Interval: DefaultInterval,
+}
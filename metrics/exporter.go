@@ -0,0 +1,237 @@
+package metrics
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lightninglabs/faraday/fiat"
+	"github.com/lightninglabs/faraday/insights"
+	"github.com/lightninglabs/faraday/revenue"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+)
+
+// forwardLogDelay is the delay behind the current time that we query
+// forwards up until. lnd writes forwards to its forwarding log in batches,
+// so forwards that happened just before the current time may not have been
+// written yet.
+const forwardLogDelay = time.Minute
+
+// errExporterAlreadyStarted is returned if the exporter is started more than
+// once.
+var errExporterAlreadyStarted = errors.New("metrics exporter already " +
+	"started")
+
+// ExporterConfig provides the functions required to export our metrics.
+type ExporterConfig struct {
+	// Listen is the address that we serve our metrics on.
+	Listen string
+
+	// Interval is the interval at which we update our channel and revenue
+	// metrics. If this value is not set, DefaultInterval is used.
+	Interval time.Duration
+
+	// RevenueReport returns a report of the revenue our channels produced
+	// with forwards in [start, end). The reports for consecutive periods
+	// must use the same fee attribution so that they can be merged.
+	RevenueReport func(start, end time.Time) (*revenue.Report, error)
+
+	// ChannelInsights returns insights for our currently open channels,
+	// using the revenue report provided, which covers the lifetime of our
+	// node.
+	ChannelInsights func(report *revenue.Report) ([]*insights.ChannelInfo,
+		error)
+
+	// LatestPrice returns the most recent fiat price that we have fetched
+	// and the time it was fetched at. If this function is not set,
+	// fiat.LatestPrice is used.
+	LatestPrice func() (*fiat.Price, time.Time)
+}
+
+// Exporter serves prometheus metrics for our channels, revenue, fiat prices
+// and rpcs. Channel and revenue metrics are updated periodically, and rpc
+// metrics are recorded by the interceptors that the exporter provides.
+type Exporter struct {
+	started int32 // To be used atomically.
+
+	cfg *ExporterConfig
+
+	registry *prometheus.Registry
+	node     *nodeCollector
+	rpc      *rpcMetrics
+
+	server *http.Server
+
+	// report is the revenue that our channels have produced with
+	// forwards up until reportedTo. We only query forwards after
+	// reportedTo on each update and merge them into this report, so that
+	// we do not query our full forwarding history each time and our
+	// totals only increase. We start from the unix epoch so that our
+	// report covers the lifetime of our node. These fields are only
+	// accessed by our update goroutine.
+	report     *revenue.Report
+	reportedTo time.Time
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewExporter returns an exporter with all of our metrics registered. Note
+// that the exporter is not serving metrics, and should be started using
+// Start().
+func NewExporter(cfg *ExporterConfig) *Exporter {
+	if cfg.Interval == 0 {
+		cfg.Interval = DefaultInterval
+	}
+
+	if cfg.LatestPrice == nil {
+		cfg.LatestPrice = fiat.LatestPrice
+	}
+
+	e := &Exporter{
+		cfg:        cfg,
+		registry:   prometheus.NewRegistry(),
+		node:       newNodeCollector(cfg.LatestPrice),
+		rpc:        newRPCMetrics(),
+		reportedTo: time.Unix(0, 0),
+		quit:       make(chan struct{}),
+	}
+
+	e.registry.MustRegister(e.node)
+	e.registry.MustRegister(e.rpc.collectors()...)
+	e.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(
+			collectors.ProcessCollectorOpts{},
+		),
+	)
+
+	return e
+}
+
+// Start starts serving our metrics and updating our channel and revenue
+// metrics.
+func (e *Exporter) Start() error {
+	if !atomic.CompareAndSwapInt32(&e.started, 0, 1) {
+		return errExporterAlreadyStarted
+	}
+
+	listener, err := net.Listen("tcp", e.cfg.Listen)
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(
+		e.registry, promhttp.HandlerOpts{},
+	))
+
+	e.server = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 3 * time.Second,
+	}
+
+	log.Infof("Serving prometheus metrics on %v", listener.Addr())
+
+	e.wg.Add(2)
+	go func() {
+		defer e.wg.Done()
+
+		err := e.server.Serve(listener)
+		if err != nil && err != http.ErrServerClosed {
+			log.Errorf("Could not serve metrics: %v", err)
+		}
+	}()
+
+	go func() {
+		defer e.wg.Done()
+		e.run()
+	}()
+
+	return nil
+}
+
+// Stop stops serving metrics and waits for the exporter to exit.
+func (e *Exporter) Stop() {
+	if atomic.LoadInt32(&e.started) == 0 {
+		return
+	}
+
+	close(e.quit)
+	if err := e.server.Close(); err != nil {
+		log.Errorf("Could not close metrics server: %v", err)
+	}
+
+	e.wg.Wait()
+}
+
+// UnaryServerInterceptor returns an interceptor which records the latency and
+// status code of unary rpcs.
+func (e *Exporter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return e.rpc.unaryInterceptor()
+}
+
+// StreamServerInterceptor returns an interceptor which records the duration
+// and status code of streaming rpcs.
+func (e *Exporter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return e.rpc.streamInterceptor()
+}
+
+// run updates our channel and revenue metrics immediately, and then on each
+// tick of our interval until we are stopped.
+func (e *Exporter) run() {
+	ticker := time.NewTicker(e.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := e.update(time.Now()); err != nil {
+			log.Errorf("Could not update metrics: %v", err)
+			e.node.updateFailed()
+		}
+
+		select {
+		case <-ticker.C:
+
+		case <-e.quit:
+			return
+		}
+	}
+}
+
+// update adds the revenue from forwards since our last update to our revenue
+// report, queries our channel insights and updates the metrics we export for
+// them. We use whole seconds for the end of our report, because lnd queries
+// its forwarding log with second precision.
+func (e *Exporter) update(now time.Time) error {
+	end := now.Add(-forwardLogDelay).Truncate(time.Second)
+	if end.After(e.reportedTo) {
+		report, err := e.cfg.RevenueReport(e.reportedTo, end)
+		if err != nil {
+			return err
+		}
+
+		if e.report == nil {
+			e.report = report
+		} else {
+			e.report.Merge(report)
+		}
+		e.reportedTo = end
+	}
+
+	channels, err := e.cfg.ChannelInsights(e.report)
+	if err != nil {
+		return err
+	}
+
+	e.node.update(e.report, channels, now)
+
+	log.Debugf("Updated metrics for %v channels", len(channels))
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package metrics
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/faraday/fiat"
+	"github.com/lightninglabs/faraday/insights"
+	"github.com/lightninglabs/faraday/revenue"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestExporterUpdate tests that each update only queries the revenue of
+// forwards since our last update, and that our totals include the revenue of
+// all our previous updates.
+func TestExporterUpdate(t *testing.T) {
+	t.Parallel()
+
+	type query struct {
+		start time.Time
+		end   time.Time
+	}
+
+	var (
+		queries   []query
+		reportErr error
+	)
+
+	exporter := NewExporter(&ExporterConfig{
+		RevenueReport: func(start, end time.Time) (*revenue.Report,
+			error) {
+
+			queries = append(queries, query{start, end})
+
+			// Each period has a single forward with a 10 msat
+			// fee.
+			pairs := map[string]map[string]revenue.Revenue{
+				"a:1": {
+					"b:1": {AmountOutgoing: 1000},
+				},
+				"b:1": {
+					"a:1": {AmountIncoming: 1010},
+				},
+			}
+
+			return &revenue.Report{ChannelPairs: pairs}, reportErr
+		},
+		ChannelInsights: func(*revenue.Report) (
+			[]*insights.ChannelInfo, error) {
+
+			return nil, nil
+		},
+		LatestPrice: func() (*fiat.Price, time.Time) {
+			return nil, time.Time{}
+		},
+	})
+
+	totals := func() (lnwire.MilliSatoshi, lnwire.MilliSatoshi) {
+		exporter.node.mtx.Lock()
+		defer exporter.node.mtx.Unlock()
+
+		return exporter.node.fees, exporter.node.volume
+	}
+
+	// Our first update should report over the lifetime of our node, up
+	// until our forwarding log delay, in whole seconds.
+	now := time.Unix(1_000_000, 500)
+	firstEnd := time.Unix(1_000_000-60, 0)
+
+	require.NoError(t, exporter.update(now))
+	require.Equal(t, []query{{time.Unix(0, 0), firstEnd}}, queries)
+
+	fees, volume := totals()
+	require.Equal(t, lnwire.MilliSatoshi(10), fees)
+	require.Equal(t, lnwire.MilliSatoshi(1000), volume)
+
+	// An update within the same second should not query our revenue
+	// again.
+	require.NoError(t, exporter.update(now.Add(time.Millisecond)))
+	require.Len(t, queries, 1)
+
+	// Our next update should only query from the end of our last update,
+	// and add its revenue to our totals.
+	now = now.Add(time.Minute * 5)
+	secondEnd := firstEnd.Add(time.Minute * 5)
+
+	require.NoError(t, exporter.update(now))
+	require.Equal(t, query{firstEnd, secondEnd}, queries[1])
+
+	fees, volume = totals()
+	require.Equal(t, lnwire.MilliSatoshi(20), fees)
+	require.Equal(t, lnwire.MilliSatoshi(2000), volume)
+
+	// If our query fails, we should retry the same period on our next
+	// update.
+	reportErr = errors.New("failed")
+	now = now.Add(time.Minute * 5)
+	require.ErrorIs(t, exporter.update(now), reportErr)
+
+	reportErr = nil
+	require.NoError(t, exporter.update(now))
+	require.Equal(t, queries[2], queries[3])
+	require.Equal(t, secondEnd, queries[3].start)
+
+	fees, _ = totals()
+	require.Equal(t, lnwire.MilliSatoshi(30), fees)
+}
@@ -0,0 +1,297 @@
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/faraday/fiat"
+	"github.com/lightninglabs/faraday/insights"
+	"github.com/lightninglabs/faraday/revenue"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// namespace is the namespace that all of our metrics are exported in.
+	namespace = "faraday"
+
+	// chanPointLabel is the label that identifies the channel that a
+	// channel metric describes.
+	chanPointLabel = "chan_point"
+
+	// currencyLabel is the label that identifies the currency that a price
+	// metric is quoted in.
+	currencyLabel = "currency"
+)
+
+var (
+	channelUptimeDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "channel", "uptime_ratio"),
+		"Ratio of the channel's monitored time that its peer was "+
+			"online.",
+		[]string{chanPointLabel}, nil,
+	)
+
+	channelMonitoredDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(
+			namespace, "channel", "monitored_seconds",
+		),
+		"Amount of time that the channel's uptime has been monitored "+
+			"for.",
+		[]string{chanPointLabel}, nil,
+	)
+
+	channelVolumeIncomingDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(
+			namespace, "channel", "volume_incoming_msat",
+		),
+		"Volume forwarded with the channel as the incoming channel.",
+		[]string{chanPointLabel}, nil,
+	)
+
+	channelVolumeOutgoingDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(
+			namespace, "channel", "volume_outgoing_msat",
+		),
+		"Volume forwarded with the channel as the outgoing channel.",
+		[]string{chanPointLabel}, nil,
+	)
+
+	channelFeesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(
+			namespace, "channel", "fees_earned_msat",
+		),
+		"Fees attributed to the channel for the forwards it was part "+
+			"of.",
+		[]string{chanPointLabel}, nil,
+	)
+
+	channelConfirmationsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "channel", "confirmations"),
+		"Number of confirmations of the channel's funding transaction.",
+		[]string{chanPointLabel}, nil,
+	)
+
+	forwardingFeesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(
+			namespace, "forwarding", "fees_msat_total",
+		),
+		"Total fees earned by forwarding over the lifetime of the "+
+			"node.",
+		nil, nil,
+	)
+
+	forwardingVolumeDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(
+			namespace, "forwarding", "volume_msat_total",
+		),
+		"Total volume forwarded over the lifetime of the node.",
+		nil, nil,
+	)
+
+	fiatPriceDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "fiat", "price"),
+		"Most recent price of one bitcoin that has been fetched.",
+		[]string{currencyLabel}, nil,
+	)
+
+	fiatPriceTimestampDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(
+			namespace, "fiat", "price_timestamp_seconds",
+		),
+		"Unix time at which the most recent price was quoted.",
+		[]string{currencyLabel}, nil,
+	)
+
+	fiatFetchedTimestampDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(
+			namespace, "fiat", "fetched_timestamp_seconds",
+		),
+		"Unix time at which the most recent price was fetched.",
+		[]string{currencyLabel}, nil,
+	)
+
+	lastUpdateDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(
+			namespace, "metrics", "last_update_timestamp_seconds",
+		),
+		"Unix time at which channel and revenue metrics were last "+
+			"updated.",
+		nil, nil,
+	)
+
+	updateErrorsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(
+			namespace, "metrics", "update_errors_total",
+		),
+		"Number of times that updating channel and revenue metrics "+
+			"failed.",
+		nil, nil,
+	)
+)
+
+// nodeCollector exports the most recent snapshot of our channel insights and
+// revenue, along with the freshness of our fiat prices. Our snapshot is
+// updated periodically rather than on each scrape, because producing it
+// requires a number of queries to lnd.
+type nodeCollector struct {
+	// latestPrice returns the most recent price that we have fetched and
+	// the time it was fetched at. The price is nil if we have not fetched
+	// any prices.
+	latestPrice func() (*fiat.Price, time.Time)
+
+	channels     []*insights.ChannelInfo
+	fees         lnwire.MilliSatoshi
+	volume       lnwire.MilliSatoshi
+	updated      time.Time
+	updateErrors uint64
+	mtx          sync.Mutex
+}
+
+// A compile time check to ensure that nodeCollector implements the
+// prometheus.Collector interface.
+var _ prometheus.Collector = (*nodeCollector)(nil)
+
+// newNodeCollector returns a collector which has not yet been updated.
+func newNodeCollector(latestPrice func() (*fiat.Price,
+	time.Time)) *nodeCollector {
+
+	return &nodeCollector{
+		latestPrice: latestPrice,
+	}
+}
+
+// update replaces our snapshot with the revenue report and channel insights
+// provided.
+func (n *nodeCollector) update(report *revenue.Report,
+	channels []*insights.ChannelInfo, updated time.Time) {
+
+	fees, volume := report.Totals()
+
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	n.channels = channels
+	n.fees = fees
+	n.volume = volume
+	n.updated = updated
+}
+
+// updateFailed records a failed attempt to update our snapshot.
+func (n *nodeCollector) updateFailed() {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	n.updateErrors++
+}
+
+// Describe sends the descriptors of all the metrics that we export.
+//
+// NOTE: This is part of the prometheus.Collector interface.
+func (n *nodeCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- channelUptimeDesc
+	ch <- channelMonitoredDesc
+	ch <- channelVolumeIncomingDesc
+	ch <- channelVolumeOutgoingDesc
+	ch <- channelFeesDesc
+	ch <- channelConfirmationsDesc
+	ch <- forwardingFeesDesc
+	ch <- forwardingVolumeDesc
+	ch <- fiatPriceDesc
+	ch <- fiatPriceTimestampDesc
+	ch <- fiatFetchedTimestampDesc
+	ch <- lastUpdateDesc
+	ch <- updateErrorsDesc
+}
+
+// Collect sends the current values of our metrics. Channel and revenue
+// metrics are only exported once our snapshot has been updated, and price
+// metrics are only exported once we have fetched a price.
+//
+// NOTE: This is part of the prometheus.Collector interface.
+func (n *nodeCollector) Collect(ch chan<- prometheus.Metric) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	ch <- prometheus.MustNewConstMetric(
+		updateErrorsDesc, prometheus.CounterValue,
+		float64(n.updateErrors),
+	)
+
+	if !n.updated.IsZero() {
+		n.collectSnapshot(ch)
+	}
+
+	price, fetched := n.latestPrice()
+	if price == nil {
+		return
+	}
+
+	value, _ := price.Price.Float64()
+	ch <- prometheus.MustNewConstMetric(
+		fiatPriceDesc, prometheus.GaugeValue, value, price.Currency,
+	)
+	ch <- prometheus.MustNewConstMetric(
+		fiatPriceTimestampDesc, prometheus.GaugeValue,
+		unixSeconds(price.Timestamp), price.Currency,
+	)
+	ch <- prometheus.MustNewConstMetric(
+		fiatFetchedTimestampDesc, prometheus.GaugeValue,
+		unixSeconds(fetched), price.Currency,
+	)
+}
+
+// collectSnapshot sends the metrics for our current snapshot. It must be
+// called with our mutex held.
+func (n *nodeCollector) collectSnapshot(ch chan<- prometheus.Metric) {
+	ch <- prometheus.MustNewConstMetric(
+		lastUpdateDesc, prometheus.GaugeValue, unixSeconds(n.updated),
+	)
+	ch <- prometheus.MustNewConstMetric(
+		forwardingFeesDesc, prometheus.CounterValue, float64(n.fees),
+	)
+	ch <- prometheus.MustNewConstMetric(
+		forwardingVolumeDesc, prometheus.CounterValue,
+		float64(n.volume),
+	)
+
+	for _, channel := range n.channels {
+		// We cannot calculate an uptime ratio for channels that lnd
+		// has not monitored yet, so we only export the time they have
+		// been monitored for.
+		if channel.MonitoredFor > 0 {
+			ch <- prometheus.MustNewConstMetric(
+				channelUptimeDesc, prometheus.GaugeValue,
+				channel.Uptime.Seconds()/
+					channel.MonitoredFor.Seconds(),
+				channel.ChannelPoint,
+			)
+		}
+
+		ch <- prometheus.MustNewConstMetric(
+			channelMonitoredDesc, prometheus.GaugeValue,
+			channel.MonitoredFor.Seconds(), channel.ChannelPoint,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			channelVolumeIncomingDesc, prometheus.GaugeValue,
+			float64(channel.VolumeIncoming), channel.ChannelPoint,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			channelVolumeOutgoingDesc, prometheus.GaugeValue,
+			float64(channel.VolumeOutgoing), channel.ChannelPoint,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			channelFeesDesc, prometheus.GaugeValue,
+			float64(channel.FeesEarned), channel.ChannelPoint,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			channelConfirmationsDesc, prometheus.GaugeValue,
+			float64(channel.Confirmations), channel.ChannelPoint,
+		)
+	}
+}
+
+// unixSeconds returns a timestamp as fractional seconds since the unix epoch.
+func unixSeconds(t time.Time) float64 {
+	return float64(t.UnixNano()) / float64(time.Second)
+}
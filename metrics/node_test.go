@@ -0,0 +1,127 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/faraday/fiat"
+	"github.com/lightninglabs/faraday/insights"
+	"github.com/lightninglabs/faraday/revenue"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNodeCollector tests the metrics exported by our node collector before
+// and after it has been updated.
+func TestNodeCollector(t *testing.T) {
+	t.Parallel()
+
+	var (
+		price   *fiat.Price
+		fetched = time.Unix(1_000_100, 0)
+	)
+
+	collector := newNodeCollector(func() (*fiat.Price, time.Time) {
+		return price, fetched
+	})
+
+	// Before we have updated our snapshot or fetched a price, we only
+	// export our error count.
+	require.Equal(t, 1, testutil.CollectAndCount(collector))
+
+	collector.updateFailed()
+	expected := `
+# HELP faraday_metrics_update_errors_total Number of times that updating channel and revenue metrics failed.
+# TYPE faraday_metrics_update_errors_total counter
+faraday_metrics_update_errors_total 1
+`
+	require.NoError(t, testutil.CollectAndCompare(
+		collector, strings.NewReader(expected),
+	))
+
+	report := &revenue.Report{
+		ChannelPairs: map[string]map[string]revenue.Revenue{
+			"a:1": {
+				"b:1": {
+					AmountOutgoing: 1000,
+					FeesOutgoing:   10,
+				},
+			},
+			"b:1": {
+				"a:1": {
+					AmountIncoming: 1010,
+				},
+			},
+		},
+	}
+
+	channels := []*insights.ChannelInfo{
+		{
+			ChannelPoint:   "a:1",
+			MonitoredFor:   time.Hour,
+			Uptime:         time.Minute * 45,
+			VolumeOutgoing: 1000,
+			FeesEarned:     10,
+			Confirmations:  6,
+		},
+		{
+			// A channel that has not been monitored does not
+			// export an uptime ratio.
+			ChannelPoint: "c:1",
+		},
+	}
+
+	price = &fiat.Price{
+		Timestamp: time.Unix(1_000_000, 0),
+		Price:     decimal.NewFromInt(50_000),
+		Currency:  "USD",
+	}
+
+	collector.update(report, channels, time.Unix(1_000_200, 0))
+
+	expected = `
+# HELP faraday_channel_confirmations Number of confirmations of the channel's funding transaction.
+# TYPE faraday_channel_confirmations gauge
+faraday_channel_confirmations{chan_point="a:1"} 6
+faraday_channel_confirmations{chan_point="c:1"} 0
+# HELP faraday_channel_uptime_ratio Ratio of the channel's monitored time that its peer was online.
+# TYPE faraday_channel_uptime_ratio gauge
+faraday_channel_uptime_ratio{chan_point="a:1"} 0.75
+# HELP faraday_fiat_fetched_timestamp_seconds Unix time at which the most recent price was fetched.
+# TYPE faraday_fiat_fetched_timestamp_seconds gauge
+faraday_fiat_fetched_timestamp_seconds{currency="USD"} 1.0001e+06
+# HELP faraday_fiat_price Most recent price of one bitcoin that has been fetched.
+# TYPE faraday_fiat_price gauge
+faraday_fiat_price{currency="USD"} 50000
+# HELP faraday_fiat_price_timestamp_seconds Unix time at which the most recent price was quoted.
+# TYPE faraday_fiat_price_timestamp_seconds gauge
+faraday_fiat_price_timestamp_seconds{currency="USD"} 1e+06
+# HELP faraday_forwarding_fees_msat_total Total fees earned by forwarding over the lifetime of the node.
+# TYPE faraday_forwarding_fees_msat_total counter
+faraday_forwarding_fees_msat_total 10
+# HELP faraday_forwarding_volume_msat_total Total volume forwarded over the lifetime of the node.
+# TYPE faraday_forwarding_volume_msat_total counter
+faraday_forwarding_volume_msat_total 1000
+# HELP faraday_metrics_last_update_timestamp_seconds Unix time at which channel and revenue metrics were last updated.
+# TYPE faraday_metrics_last_update_timestamp_seconds gauge
+faraday_metrics_last_update_timestamp_seconds 1.0002e+06
+`
+	require.NoError(t, testutil.CollectAndCompare(
+		collector, strings.NewReader(expected),
+		"faraday_channel_confirmations",
+		"faraday_channel_uptime_ratio",
+		"faraday_fiat_fetched_timestamp_seconds",
+		"faraday_fiat_price",
+		"faraday_fiat_price_timestamp_seconds",
+		"faraday_forwarding_fees_msat_total",
+		"faraday_forwarding_volume_msat_total",
+		"faraday_metrics_last_update_timestamp_seconds",
+	))
+
+	// We export our error count, last update time, two totals, three
+	// price metrics, six metrics for our first channel and five for our
+	// second.
+	require.Equal(t, 18, testutil.CollectAndCount(collector))
+}
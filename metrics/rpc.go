@@ -0,0 +1,102 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// methodLabel is the label that identifies the rpc method that an rpc
+	// metric describes.
+	methodLabel = "method"
+
+	// codeLabel is the label that identifies the grpc status code that an
+	// rpc returned.
+	codeLabel = "code"
+)
+
+// rpcMetrics records the latency and outcome of the rpcs served by faraday.
+type rpcMetrics struct {
+	// handled counts the rpcs that have completed by method and status
+	// code, so that errors can be monitored.
+	handled *prometheus.CounterVec
+
+	// latency records the time taken to serve rpcs by method.
+	latency *prometheus.HistogramVec
+}
+
+// newRPCMetrics creates our rpc metrics.
+func newRPCMetrics() *rpcMetrics {
+	handled := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "rpc",
+		Name:      "requests_total",
+		Help: "Number of rpcs completed by method and status " +
+			"code.",
+	}, []string{methodLabel, codeLabel})
+
+	// Audits may take minutes to produce, so our buckets extend well past
+	// the latencies that are usual for rpcs.
+	latency := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "rpc",
+		Name:      "request_duration_seconds",
+		Help:      "Time taken to complete rpcs by method.",
+		Buckets: []float64{
+			0.01, 0.05, 0.1, 0.5, 1, 5, 10, 30, 60, 300,
+		},
+	}, []string{methodLabel})
+
+	return &rpcMetrics{
+		handled: handled,
+		latency: latency,
+	}
+}
+
+// collectors returns the collectors for our rpc metrics.
+func (r *rpcMetrics) collectors() []prometheus.Collector {
+	return []prometheus.Collector{r.handled, r.latency}
+}
+
+// observe records an rpc that completed with the error provided.
+func (r *rpcMetrics) observe(method string, start time.Time, err error) {
+	code := status.Code(err)
+
+	r.handled.WithLabelValues(method, code.String()).Inc()
+	r.latency.WithLabelValues(method).Observe(
+		time.Since(start).Seconds(),
+	)
+}
+
+// unaryInterceptor returns an interceptor which records metrics for unary
+// rpcs.
+func (r *rpcMetrics) unaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		r.observe(info.FullMethod, start, err)
+
+		return resp, err
+	}
+}
+
+// streamInterceptor returns an interceptor which records metrics for
+// streaming rpcs. The latency of a stream is the time that it was open for.
+func (r *rpcMetrics) streamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream,
+		info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+
+		start := time.Now()
+		err := handler(srv, ss)
+		r.observe(info.FullMethod, start, err)
+
+		return err
+	}
+}
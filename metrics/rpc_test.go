@@ -0,0 +1,63 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestRPCInterceptors tests that our interceptors record the status code and
+// latency of rpcs.
+func TestRPCInterceptors(t *testing.T) {
+	t.Parallel()
+
+	rpc := newRPCMetrics()
+	unary := rpc.unaryInterceptor()
+	stream := rpc.streamInterceptor()
+
+	errDenied := status.Error(codes.PermissionDenied, "denied")
+
+	unaryInfo := &grpc.UnaryServerInfo{FullMethod: "/frdrpc/Unary"}
+	for _, err := range []error{nil, nil, errDenied} {
+		err := err
+		_, returned := unary(
+			context.Background(), nil, unaryInfo,
+			func(context.Context, interface{}) (interface{},
+				error) {
+
+				return nil, err
+			},
+		)
+		require.Equal(t, err, returned)
+	}
+
+	streamInfo := &grpc.StreamServerInfo{FullMethod: "/frdrpc/Stream"}
+	err := stream(nil, nil, streamInfo, func(interface{},
+		grpc.ServerStream) error {
+
+		return errDenied
+	})
+	require.Equal(t, errDenied, err)
+
+	require.Equal(t, float64(2), testutil.ToFloat64(
+		rpc.handled.WithLabelValues("/frdrpc/Unary", "OK"),
+	))
+	require.Equal(t, float64(1), testutil.ToFloat64(
+		rpc.handled.WithLabelValues(
+			"/frdrpc/Unary", "PermissionDenied",
+		),
+	))
+	require.Equal(t, float64(1), testutil.ToFloat64(
+		rpc.handled.WithLabelValues(
+			"/frdrpc/Stream", "PermissionDenied",
+		),
+	))
+
+	// We expect a latency series for each of our methods.
+	require.Equal(t, 2, testutil.CollectAndCount(rpc.latency))
+}
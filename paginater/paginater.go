@@ -1,6 +1,10 @@
 package paginater
 
-import "context"
+import (
+	"context"
+
+	"github.com/lightninglabs/faraday/progress"
+)
 
 // PaginatedQuery is a function which makes a call to a paginated api and adds
 // returns the index offset of the last entry and the number of events that were
@@ -11,7 +15,8 @@ type PaginatedQuery func(offset, maxEvents uint64) (uint64, uint64, error)
 // retrieved all the items from the query endpoint, or the calling context is
 // cancelled. Note that the query function is responsible for collecting the
 // items returned by the API (if required) so that the pagination logic can
-// remain generic.
+// remain generic. Each page fetched is recorded by the context's progress
+// tracker, if it has one.
 func QueryPaginated(ctx context.Context, query PaginatedQuery, offset,
 	maxEvents uint64) error {
 
@@ -21,6 +26,7 @@ func QueryPaginated(ctx context.Context, query PaginatedQuery, offset,
 		if err != nil {
 			return err
 		}
+		progress.FromContext(ctx).AddPages(1)
 
 		// If we have less than the maximum number of items, we do not
 		// need to query further for more items.
@@ -0,0 +1,246 @@
+package periods
+
+import (
+	"time"
+
+	"github.com/lightninglabs/faraday/accounting"
+)
+
+// DriftType describes the way in which live data differs from a closed
+// period's frozen entries.
+type DriftType int
+
+const (
+	// DriftTypeAdded indicates that a live entry has no corresponding
+	// frozen entry.
+	DriftTypeAdded DriftType = iota
+
+	// DriftTypeRemoved indicates that a frozen entry has no corresponding
+	// live entry.
+	DriftTypeRemoved
+
+	// DriftTypeChanged indicates that a live entry's values differ from
+	// its frozen entry.
+	DriftTypeChanged
+)
+
+// String returns the string representation of a drift type.
+func (d DriftType) String() string {
+	switch d {
+	case DriftTypeAdded:
+		return "added"
+
+	case DriftTypeRemoved:
+		return "removed"
+
+	default:
+		return "changed"
+	}
+}
+
+// Drift describes a single difference between a closed period's frozen
+// entries and the entries produced from live data.
+type Drift struct {
+	// Type is the type of difference.
+	Type DriftType
+
+	// Frozen is the closed period's entry. It is nil for added entries.
+	Frozen *accounting.HarmonyEntry
+
+	// Live is the live entry. It is nil for removed entries.
+	Live *accounting.HarmonyEntry
+}
+
+// PeriodDrift contains the differences between a closed period's entries and
+// live data, limited to the portion of the period that was audited.
+type PeriodDrift struct {
+	// Period is the closed period.
+	Period *ClosedPeriod
+
+	// Drift contains each difference found.
+	Drift []*Drift
+}
+
+// entryKey identifies an entry across audits. Since we cannot rely on all
+// entries having a unique reference, we identify entries by all of the
+// values that describe the event they represent.
+type entryKey struct {
+	timestamp int64
+	entryType accounting.EntryType
+	txid      string
+	reference string
+	onChain   bool
+}
+
+// newEntryKey returns the key for an entry.
+func newEntryKey(entry *accounting.HarmonyEntry) entryKey {
+	return entryKey{
+		timestamp: entry.Timestamp.Unix(),
+		entryType: entry.Type,
+		txid:      entry.TxID,
+		reference: entry.Reference,
+		onChain:   entry.OnChain,
+	}
+}
+
+// ApplyClosedPeriods replaces the live entries for [start, end) that fall
+// within closed periods with the periods' frozen entries. It returns the
+// resulting report, and the drift between live and frozen entries for each
+// closed period that overlaps with the range. Fiat values are only compared
+// if compareFiat is set, since live entries have no fiat values if they were
+// not requested.
+func ApplyClosedPeriods(live accounting.Report, start, end time.Time,
+	closed []*ClosedPeriod, compareFiat bool) (accounting.Report,
+	[]*PeriodDrift) {
+
+	var (
+		overlapping []*ClosedPeriod
+		report      accounting.Report
+	)
+
+	for _, period := range closed {
+		if period.Overlaps(start, end) {
+			overlapping = append(overlapping, period)
+		}
+	}
+
+	if len(overlapping) == 0 {
+		return live, nil
+	}
+
+	// Split our live entries into those that fall within each closed
+	// period, and those that can be used as-is.
+	liveEntries := make([]accounting.Report, len(overlapping))
+	for _, entry := range live {
+		index := periodIndex(overlapping, entry.Timestamp)
+		if index < 0 {
+			report = append(report, entry)
+			continue
+		}
+
+		liveEntries[index] = append(liveEntries[index], entry)
+	}
+
+	drift := make([]*PeriodDrift, len(overlapping))
+	for i, period := range overlapping {
+		var frozen accounting.Report
+		for _, entry := range period.Entries {
+			if entry.Timestamp.Before(start) ||
+				!entry.Timestamp.Before(end) {
+
+				continue
+			}
+
+			frozen = append(frozen, entry)
+		}
+
+		report = append(report, frozen...)
+
+		drift[i] = &PeriodDrift{
+			Period: period,
+			Drift:  getDrift(frozen, liveEntries[i], compareFiat),
+		}
+
+		log.Debugf("Using %v frozen entries for closed period %v-%v, "+
+			"%v differences from live data", len(frozen),
+			period.Start, period.End, len(drift[i].Drift))
+	}
+
+	return report, drift
+}
+
+// periodIndex returns the index of the period that contains a timestamp, or
+// -1 if no period contains it.
+func periodIndex(periods []*ClosedPeriod, timestamp time.Time) int {
+	for i, period := range periods {
+		if period.Contains(timestamp) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// getDrift compares a set of frozen and live entries. Custom categories are
+// not compared, because they depend on the categories that an audit was
+// requested with rather than on the data that it was produced from.
+func getDrift(frozen, live accounting.Report,
+	compareFiat bool) []*Drift {
+
+	// Since more than one entry may share a key, we track a list of
+	// live entries for each key and match them to frozen entries in
+	// order.
+	liveEntries := make(map[entryKey][]*accounting.HarmonyEntry)
+	for _, entry := range live {
+		key := newEntryKey(entry)
+		liveEntries[key] = append(liveEntries[key], entry)
+	}
+
+	var drift []*Drift
+	for _, frozenEntry := range frozen {
+		key := newEntryKey(frozenEntry)
+
+		matches := liveEntries[key]
+		if len(matches) == 0 {
+			drift = append(drift, &Drift{
+				Type:   DriftTypeRemoved,
+				Frozen: frozenEntry,
+			})
+
+			continue
+		}
+
+		liveEntry := matches[0]
+		liveEntries[key] = matches[1:]
+
+		if sameValues(frozenEntry, liveEntry, compareFiat) {
+			continue
+		}
+
+		drift = append(drift, &Drift{
+			Type:   DriftTypeChanged,
+			Frozen: frozenEntry,
+			Live:   liveEntry,
+		})
+	}
+
+	// Any live entries that were not matched to a frozen entry have been
+	// added since the period was closed. We iterate over our original
+	// list so that added entries are reported in a deterministic order.
+	for _, entry := range live {
+		key := newEntryKey(entry)
+
+		remaining := liveEntries[key]
+		if len(remaining) == 0 || remaining[0] != entry {
+			continue
+		}
+
+		liveEntries[key] = remaining[1:]
+		drift = append(drift, &Drift{
+			Type: DriftTypeAdded,
+			Live: entry,
+		})
+	}
+
+	return drift
+}
+
+// sameValues returns a boolean indicating whether two entries have the same
+// values.
+func sameValues(frozen, live *accounting.HarmonyEntry,
+	compareFiat bool) bool {
+
+	if frozen.Amount != live.Amount || frozen.Credit != live.Credit {
+		return false
+	}
+
+	if frozen.Note != live.Note {
+		return false
+	}
+
+	if !compareFiat {
+		return true
+	}
+
+	return frozen.FiatValue.Equal(live.FiatValue)
+}
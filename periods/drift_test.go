@@ -0,0 +1,145 @@
+package periods
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/faraday/accounting"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// TestApplyClosedPeriods tests replacement of live entries with frozen
+// entries and detection of drift between them.
+func TestApplyClosedPeriods(t *testing.T) {
+	start := time.Unix(1_600_000_000, 0)
+
+	entry := func(offset time.Duration, ref string,
+		amt int64) *accounting.HarmonyEntry {
+
+		return &accounting.HarmonyEntry{
+			Timestamp: start.Add(offset),
+			Amount:    1000,
+			FiatValue: decimal.NewFromInt(amt),
+			Reference: ref,
+			Type:      accounting.EntryTypeReceipt,
+			Credit:    true,
+		}
+	}
+
+	// Our closed period covers the first day, and contains two entries.
+	var (
+		frozen1 = entry(time.Hour, "a", 1)
+		frozen2 = entry(time.Hour*2, "b", 1)
+	)
+
+	period, err := NewClosedPeriod(
+		start, start.Add(time.Hour*24), start, "",
+		accounting.Report{frozen1, frozen2},
+	)
+	require.NoError(t, err)
+
+	// Our live data has the first entry with a different fiat value, has
+	// lost the second entry and gained a third within the period. It also
+	// has an entry after the period.
+	var (
+		live1 = entry(time.Hour, "a", 2)
+		live3 = entry(time.Hour*3, "c", 1)
+		after = entry(time.Hour*25, "d", 1)
+		live  = accounting.Report{live1, live3, after}
+	)
+
+	tests := []struct {
+		name           string
+		start          time.Time
+		end            time.Time
+		compareFiat    bool
+		expectedReport accounting.Report
+		expectedDrift  []*Drift
+	}{
+		{
+			name:           "no overlap",
+			start:          start.Add(time.Hour * 24),
+			end:            start.Add(time.Hour * 48),
+			expectedReport: accounting.Report{after},
+		},
+		{
+			name:  "overlap without fiat",
+			start: start,
+			end:   start.Add(time.Hour * 48),
+			expectedReport: accounting.Report{
+				after, frozen1, frozen2,
+			},
+			expectedDrift: []*Drift{
+				{Type: DriftTypeRemoved, Frozen: frozen2},
+				{Type: DriftTypeAdded, Live: live3},
+			},
+		},
+		{
+			name:        "overlap with fiat",
+			start:       start,
+			end:         start.Add(time.Hour * 48),
+			compareFiat: true,
+			expectedReport: accounting.Report{
+				after, frozen1, frozen2,
+			},
+			expectedDrift: []*Drift{
+				{
+					Type:   DriftTypeChanged,
+					Frozen: frozen1,
+					Live:   live1,
+				},
+				{Type: DriftTypeRemoved, Frozen: frozen2},
+				{Type: DriftTypeAdded, Live: live3},
+			},
+		},
+		{
+			// When we only audit part of a closed period, only the
+			// frozen entries in range are used and compared.
+			name:           "partial overlap",
+			start:          start.Add(time.Minute * 90),
+			end:            start.Add(time.Hour * 48),
+			expectedReport: accounting.Report{after, frozen2},
+			expectedDrift: []*Drift{
+				{Type: DriftTypeRemoved, Frozen: frozen2},
+				{Type: DriftTypeAdded, Live: live3},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Filter our live entries to the audit's range, since
+			// a live audit would only include entries in range.
+			var inRange accounting.Report
+			for _, entry := range live {
+				if entry.Timestamp.Before(test.start) ||
+					!entry.Timestamp.Before(test.end) {
+
+					continue
+				}
+
+				inRange = append(inRange, entry)
+			}
+
+			report, drift := ApplyClosedPeriods(
+				inRange, test.start, test.end,
+				[]*ClosedPeriod{period}, test.compareFiat,
+			)
+			require.Equal(t, test.expectedReport, report)
+
+			if test.expectedDrift == nil {
+				require.Nil(t, drift)
+				return
+			}
+
+			require.Len(t, drift, 1)
+			require.Equal(t, period, drift[0].Period)
+			require.Equal(t, test.expectedDrift, drift[0].Drift)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package periods
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"time"
+
+	"github.com/lightninglabs/faraday/accounting"
+	"github.com/lightninglabs/faraday/fiat"
+)
+
+// EncodingVersion is the version of the canonical encoding that we calculate
+// the digests of newly closed periods over. Each period records the version
+// that it was signed with, so this value must be incremented if the encoding
+// changes, and the encodings of previous versions must be kept.
+const EncodingVersion uint32 = 1
+
+// ErrUnknownEncoding is returned when we do not know the encoding version
+// that a period was signed with.
+var ErrUnknownEncoding = errors.New("unknown period encoding version")
+
+// digestDomain is prepended to the canonical encoding of a period, followed
+// by the encoding version, so that period digests can't be confused with
+// digests of other data.
+var digestDomain = []byte("faraday closed period")
+
+// encodePeriod writes the canonical encoding of a period, excluding its
+// signature and encoding version. Version 1 of the encoding is defined as
+// follows, where all integers are big endian:
+//   - A period is its start, end and closed at times, its faraday version,
+//     its entries and then its prices.
+//   - Times are unix seconds as 8 byte two's complement values, followed by
+//     their nanoseconds within the second as a uint32.
+//   - Strings are their length in bytes as a uint32, followed by their bytes.
+//     Decimals are their shortest string representation.
+//   - Lists are their number of elements as a uint32, followed by each
+//     element in order.
+//   - An entry is its timestamp, amount in msat as a uint64, fiat value,
+//     transaction id, reference, note, entry type as a uint64, category, on
+//     chain and credit bools as a single byte each, followed by its price.
+//   - A price is a single byte, 0 if it is not set, or 1 followed by its
+//     timestamp, price and currency.
+func encodePeriod(w *bytes.Buffer, period *ClosedPeriod) {
+	writeTime(w, period.Start)
+	writeTime(w, period.End)
+	writeTime(w, period.ClosedAt)
+	writeString(w, period.Version)
+
+	writeUint32(w, uint32(len(period.Entries)))
+	for _, entry := range period.Entries {
+		encodeEntry(w, entry)
+	}
+
+	writeUint32(w, uint32(len(period.Prices)))
+	for _, price := range period.Prices {
+		encodePrice(w, price)
+	}
+}
+
+// encodeEntry writes the canonical encoding of an audit entry.
+func encodeEntry(w *bytes.Buffer, entry *accounting.HarmonyEntry) {
+	writeTime(w, entry.Timestamp)
+	writeUint64(w, uint64(entry.Amount))
+	writeString(w, entry.FiatValue.String())
+	writeString(w, entry.TxID)
+	writeString(w, entry.Reference)
+	writeString(w, entry.Note)
+	writeUint64(w, uint64(entry.Type))
+	writeString(w, entry.Category)
+	writeBool(w, entry.OnChain)
+	writeBool(w, entry.Credit)
+	encodePrice(w, entry.BTCPrice)
+}
+
+// encodePrice writes the canonical encoding of an optional price.
+func encodePrice(w *bytes.Buffer, price *fiat.Price) {
+	writeBool(w, price != nil)
+	if price == nil {
+		return
+	}
+
+	writeTime(w, price.Timestamp)
+	writeString(w, price.Price.String())
+	writeString(w, price.Currency)
+}
+
+// writeTime writes a time as big endian unix seconds and nanoseconds.
+func writeTime(w *bytes.Buffer, t time.Time) {
+	writeUint64(w, uint64(t.Unix()))
+	writeUint32(w, uint32(t.Nanosecond()))
+}
+
+// writeString writes a length prefixed string.
+func writeString(w *bytes.Buffer, s string) {
+	writeUint32(w, uint32(len(s)))
+	w.WriteString(s)
+}
+
+// writeBool writes a bool as a single byte.
+func writeBool(w *bytes.Buffer, b bool) {
+	if b {
+		w.WriteByte(1)
+	} else {
+		w.WriteByte(0)
+	}
+}
+
+// writeUint32 writes a big endian uint32.
+func writeUint32(w *bytes.Buffer, value uint32) {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], value)
+	w.Write(b[:])
+}
+
+// writeUint64 writes a big endian uint64.
+func writeUint64(w *bytes.Buffer, value uint64) {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], value)
+	w.Write(b[:])
+}
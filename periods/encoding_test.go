@@ -0,0 +1,88 @@
+package periods
+
+import (
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/faraday/accounting"
+	"github.com/lightninglabs/faraday/fiat"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDigest tests that a period's digest is calculated over our canonical
+// encoding, and that we fail for encoding versions that we do not know.
+func TestDigest(t *testing.T) {
+	price := &fiat.Price{
+		Timestamp: time.Unix(5, 0),
+		Price:     decimal.NewFromFloat(1.50),
+		Currency:  "USD",
+	}
+
+	period := &ClosedPeriod{
+		Start:    time.Unix(1, 0),
+		End:      time.Unix(2, 0),
+		ClosedAt: time.Unix(3, 7),
+		Version:  "v",
+		Entries: accounting.Report{
+			{
+				Timestamp: time.Unix(1, 0),
+				Amount:    10,
+				FiatValue: decimal.NewFromInt(2),
+				TxID:      "tx",
+				Type:      accounting.EntryTypeReceipt,
+				Credit:    true,
+				BTCPrice:  price,
+			},
+		},
+		Prices:          []*fiat.Price{price},
+		EncodingVersion: EncodingVersion,
+		Signature:       "signature",
+	}
+
+	// Our price is its presence byte, timestamp, price and currency.
+	encodedPrice := []byte{
+		1,
+		0, 0, 0, 0, 0, 0, 0, 5, 0, 0, 0, 0,
+		0, 0, 0, 3, '1', '.', '5',
+		0, 0, 0, 3, 'U', 'S', 'D',
+	}
+
+	// Our entry is its timestamp, amount, fiat value, txid, reference,
+	// note, type, category, on chain and credit bools, and price.
+	entry := []byte{
+		0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 10,
+		0, 0, 0, 1, '2',
+		0, 0, 0, 2, 't', 'x',
+		0, 0, 0, 0,
+		0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, byte(accounting.EntryTypeReceipt),
+		0, 0, 0, 0,
+		0,
+		1,
+	}
+	entry = append(entry, encodedPrice...)
+
+	// Our period is its domain, encoding version, start, end and closed
+	// at times, version, entries and prices. Its signature is not
+	// included.
+	expected := append([]byte("faraday closed period"), 0, 0, 0, 1)
+	expected = append(expected, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0)
+	expected = append(expected, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0)
+	expected = append(expected, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 7)
+	expected = append(expected, 0, 0, 0, 1, 'v')
+	expected = append(expected, 0, 0, 0, 1)
+	expected = append(expected, entry...)
+	expected = append(expected, 0, 0, 0, 1)
+	expected = append(expected, encodedPrice...)
+
+	digest, err := period.Digest()
+	require.NoError(t, err)
+	require.Equal(t, sha256.Sum256(expected), digest)
+
+	period.EncodingVersion = 0
+	_, err = period.Digest()
+	require.ErrorIs(t, err, ErrUnknownEncoding)
+}
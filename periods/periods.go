@@ -0,0 +1,139 @@
+package periods
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lightninglabs/faraday/accounting"
+	"github.com/lightninglabs/faraday/fiat"
+)
+
+var (
+	// ErrInvalidPeriod is returned when a period's end time is not after
+	// its start time.
+	ErrInvalidPeriod = errors.New("period end time must be after start " +
+		"time")
+
+	// ErrPeriodOverlap is returned when we try to close a period that
+	// overlaps with a period that has already been closed.
+	ErrPeriodOverlap = errors.New("period overlaps with a closed period")
+)
+
+// ClosedPeriod is a frozen snapshot of the audit entries for a period. Once
+// a period is closed, audits that overlap with it use its entries rather than
+// the entries produced from lnd's current state.
+type ClosedPeriod struct {
+	// Start is the start time of the period, inclusive.
+	Start time.Time
+
+	// End is the end time of the period, exclusive.
+	End time.Time
+
+	// ClosedAt is the time that the period was closed.
+	ClosedAt time.Time
+
+	// Version is the version of faraday that produced the period's
+	// entries.
+	Version string
+
+	// Entries contains the audit entries for the period.
+	Entries accounting.Report
+
+	// Prices contains the distinct bitcoin prices that were used to
+	// produce the fiat values of the period's entries.
+	Prices []*fiat.Price
+
+	// EncodingVersion is the version of the canonical encoding that the
+	// period's digest is calculated over.
+	EncodingVersion uint32
+
+	// Signature is our node's signature over the period's digest. It is
+	// not included in the digest itself.
+	Signature string
+}
+
+// priceKey is used to deduplicate the prices used in a period.
+type priceKey struct {
+	timestamp int64
+	price     string
+	currency  string
+}
+
+// NewClosedPeriod creates a closed period from the entries provided,
+// recording the prices that were used to produce them. Entries that fall
+// outside of the period are not included.
+func NewClosedPeriod(start, end, closedAt time.Time, version string,
+	entries accounting.Report) (*ClosedPeriod, error) {
+
+	if !end.After(start) {
+		return nil, ErrInvalidPeriod
+	}
+
+	period := &ClosedPeriod{
+		Start:           start,
+		End:             end,
+		ClosedAt:        closedAt,
+		Version:         version,
+		EncodingVersion: EncodingVersion,
+	}
+
+	seen := make(map[priceKey]bool)
+	for _, entry := range entries {
+		if !period.Contains(entry.Timestamp) {
+			continue
+		}
+
+		period.Entries = append(period.Entries, entry)
+
+		if entry.BTCPrice == nil {
+			continue
+		}
+
+		key := priceKey{
+			timestamp: entry.BTCPrice.Timestamp.UnixNano(),
+			price:     entry.BTCPrice.Price.String(),
+			currency:  entry.BTCPrice.Currency,
+		}
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+		period.Prices = append(period.Prices, entry.BTCPrice)
+	}
+
+	return period, nil
+}
+
+// Contains returns a boolean indicating whether a timestamp falls within
+// the period.
+func (c *ClosedPeriod) Contains(timestamp time.Time) bool {
+	return !timestamp.Before(c.Start) && timestamp.Before(c.End)
+}
+
+// Overlaps returns a boolean indicating whether the period overlaps with
+// [start, end).
+func (c *ClosedPeriod) Overlaps(start, end time.Time) bool {
+	return start.Before(c.End) && end.After(c.Start)
+}
+
+// Digest returns the sha256 digest of the period's canonical encoding,
+// prefixed with our digest domain and the period's encoding version. We do
+// not use a serialization of the period's go struct, since it is not
+// guaranteed to be stable across versions of faraday or its dependencies.
+func (c *ClosedPeriod) Digest() ([32]byte, error) {
+	if c.EncodingVersion != EncodingVersion {
+		return [32]byte{}, fmt.Errorf("%w: %v", ErrUnknownEncoding,
+			c.EncodingVersion)
+	}
+
+	var buf bytes.Buffer
+	buf.Write(digestDomain)
+	writeUint32(&buf, c.EncodingVersion)
+	encodePeriod(&buf, c)
+
+	return sha256.Sum256(buf.Bytes()), nil
+}
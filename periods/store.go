@@ -0,0 +1,134 @@
+package periods
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+var (
+	// periodsBucket is the top level bucket that closed periods are
+	// stored in. Periods are keyed by start time so that they are sorted
+	// by time.
+	periodsBucket = []byte("closed-periods")
+
+	// errBucketNotFound is returned if our periods bucket has not been
+	// created.
+	errBucketNotFound = errors.New("closed periods bucket not found")
+)
+
+// keyLength is the length of our period keys, which are 8 byte timestamps.
+const keyLength = 8
+
+// Store persists closed periods.
+type Store struct {
+	db kvdb.Backend
+}
+
+// NewStore creates a period store backed by the database provided, creating
+// our periods bucket if it does not yet exist.
+func NewStore(db kvdb.Backend) (*Store, error) {
+	err := kvdb.Update(db, func(tx kvdb.RwTx) error {
+		_, err := tx.CreateTopLevelBucket(periodsBucket)
+		return err
+	}, func() {})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Store{
+		db: db,
+	}, nil
+}
+
+// AddPeriod persists a closed period. Closed periods may not be modified, so
+// ErrPeriodOverlap is returned if the period overlaps with a period that
+// has already been closed.
+func (s *Store) AddPeriod(period *ClosedPeriod) error {
+	value, err := json.Marshal(period)
+	if err != nil {
+		return err
+	}
+
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(periodsBucket)
+		if bucket == nil {
+			return errBucketNotFound
+		}
+
+		err := bucket.ForEach(func(_, v []byte) error {
+			existing, err := readPeriod(v)
+			if err != nil {
+				return err
+			}
+
+			if existing.Overlaps(period.Start, period.End) {
+				return ErrPeriodOverlap
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put(timestampKey(period.Start), value)
+	}, func() {})
+}
+
+// ListPeriods returns all of our closed periods, sorted by start time.
+func (s *Store) ListPeriods() ([]*ClosedPeriod, error) {
+	var periods []*ClosedPeriod
+
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(periodsBucket)
+		if bucket == nil {
+			return errBucketNotFound
+		}
+
+		return bucket.ForEach(func(_, v []byte) error {
+			period, err := readPeriod(v)
+			if err != nil {
+				return err
+			}
+
+			periods = append(periods, period)
+
+			return nil
+		})
+	}, func() {
+		periods = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return periods, nil
+}
+
+// timestampKey returns the 8 byte key for a timestamp. Timestamps before the
+// unix epoch are clamped to zero so that they sort first.
+func timestampKey(timestamp time.Time) []byte {
+	var ts uint64
+	if nanos := timestamp.UnixNano(); nanos > 0 {
+		ts = uint64(nanos)
+	}
+
+	key := make([]byte, keyLength)
+	binary.BigEndian.PutUint64(key, ts)
+
+	return key
+}
+
+// readPeriod deserializes a stored period.
+func readPeriod(value []byte) (*ClosedPeriod, error) {
+	period := &ClosedPeriod{}
+	if err := json.Unmarshal(value, period); err != nil {
+		return nil, err
+	}
+
+	return period, nil
+}
@@ -0,0 +1,122 @@
+package periods
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/faraday/accounting"
+	"github.com/lightninglabs/faraday/fiat"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStore tests storage of closed periods, and that periods are unchanged
+// by storage so that their digests can be reproduced.
+func TestStore(t *testing.T) {
+	db, err := kvdb.GetBoltBackend(&kvdb.BoltBackendConfig{
+		DBPath:     t.TempDir(),
+		DBFileName: "test.db",
+		DBTimeout:  time.Second,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	store, err := NewStore(db)
+	require.NoError(t, err)
+
+	start := time.Unix(1_600_000_000, 0)
+	price := &fiat.Price{
+		Timestamp: start,
+		Price:     decimal.NewFromFloat(10_000.5),
+		Currency:  "USD",
+	}
+
+	entries := accounting.Report{
+		{
+			Timestamp: start.Add(time.Minute),
+			Amount:    1000,
+			FiatValue: decimal.NewFromFloat(0.0001),
+			TxID:      "txid",
+			Type:      accounting.EntryTypeReceipt,
+			OnChain:   true,
+			Credit:    true,
+			BTCPrice:  price,
+		},
+		{
+			Timestamp: start.Add(time.Hour),
+			Amount:    10,
+			FiatValue: decimal.NewFromFloat(0.000001),
+			Type:      accounting.EntryTypeFee,
+			BTCPrice:  price,
+		},
+	}
+
+	period, err := NewClosedPeriod(
+		start, start.Add(time.Hour*24), start.Add(time.Hour*48),
+		"0.2.16-alpha", entries,
+	)
+	require.NoError(t, err)
+	require.Len(t, period.Entries, 2)
+	require.Equal(t, []*fiat.Price{price}, period.Prices)
+
+	period.Signature = "signature"
+	digest, err := period.Digest()
+	require.NoError(t, err)
+
+	// Our earlier period is stored second to test sorting.
+	earlier, err := NewClosedPeriod(
+		start.Add(time.Hour*-24), start, start, "0.2.16-alpha", nil,
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, store.AddPeriod(period))
+	require.NoError(t, store.AddPeriod(earlier))
+
+	// Periods that overlap with a closed period can't be added.
+	overlap, err := NewClosedPeriod(
+		start.Add(time.Hour*23), start.Add(time.Hour*25), start,
+		"0.2.16-alpha", nil,
+	)
+	require.NoError(t, err)
+	require.ErrorIs(t, store.AddPeriod(overlap), ErrPeriodOverlap)
+
+	periods, err := store.ListPeriods()
+	require.NoError(t, err)
+	require.Len(t, periods, 2)
+
+	require.True(t, periods[0].Start.Equal(earlier.Start))
+
+	stored := periods[1]
+	require.Equal(t, "signature", stored.Signature)
+	require.Len(t, stored.Entries, 2)
+	require.True(t, stored.Entries[0].FiatValue.Equal(
+		entries[0].FiatValue,
+	))
+
+	storedDigest, err := stored.Digest()
+	require.NoError(t, err)
+	require.Equal(t, digest, storedDigest)
+}
+
+// TestNewClosedPeriod tests validation of closed periods.
+func TestNewClosedPeriod(t *testing.T) {
+	now := time.Unix(1_600_000_000, 0)
+
+	_, err := NewClosedPeriod(now, now, now, "", nil)
+	require.ErrorIs(t, err, ErrInvalidPeriod)
+
+	// Entries outside of the period are excluded.
+	period, err := NewClosedPeriod(
+		now, now.Add(time.Hour), now, "", accounting.Report{
+			{Timestamp: now.Add(-time.Second)},
+			{Timestamp: now},
+			{Timestamp: now.Add(time.Hour)},
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, period.Entries, 1)
+	require.Empty(t, period.Prices)
+}
@@ -0,0 +1,124 @@
+package policies
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// errMonitorAlreadyStarted is returned if the monitor is started more than
+// once.
+var errMonitorAlreadyStarted = errors.New("policy monitor already started")
+
+// defaultInterval is the default interval at which we snapshot our policies.
+const defaultInterval = time.Minute * 10
+
+// MonitorConfig provides the functions required to monitor our policies.
+type MonitorConfig struct {
+	// ListPolicies returns the current policies for our open channels.
+	ListPolicies func() ([]*Snapshot, error)
+
+	// AddSnapshot persists a policy snapshot if it differs from the
+	// channel's most recent snapshot, returning a boolean that indicates
+	// whether it was stored.
+	AddSnapshot func(snapshot *Snapshot) (bool, error)
+
+	// Interval is the interval at which we check our policies for
+	// changes. If this value is not set, a default of 10 minutes is used.
+	Interval time.Duration
+}
+
+// Monitor periodically snapshots our channel policies, recording each change.
+type Monitor struct {
+	started int32 // To be used atomically.
+
+	cfg *MonitorConfig
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewMonitor returns a policy monitor. Note that the monitor is not running,
+// and should be started using Start().
+func NewMonitor(cfg *MonitorConfig) *Monitor {
+	if cfg.Interval == 0 {
+		cfg.Interval = defaultInterval
+	}
+
+	return &Monitor{
+		cfg:  cfg,
+		quit: make(chan struct{}),
+	}
+}
+
+// Start starts snapshotting our policies.
+func (m *Monitor) Start() error {
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return errMonitorAlreadyStarted
+	}
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		m.run()
+	}()
+
+	return nil
+}
+
+// Stop stops the monitor and waits for it to exit.
+func (m *Monitor) Stop() {
+	if atomic.LoadInt32(&m.started) == 0 {
+		return
+	}
+
+	close(m.quit)
+	m.wg.Wait()
+}
+
+// run snapshots our policies immediately, and then on each tick of our
+// interval until we are stopped.
+func (m *Monitor) run() {
+	ticker := time.NewTicker(m.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := m.snapshot(); err != nil {
+			log.Errorf("Could not snapshot policies: %v", err)
+		}
+
+		select {
+		case <-ticker.C:
+
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// snapshot looks up our current policies and persists those that have changed
+// since our last snapshot.
+func (m *Monitor) snapshot() error {
+	policies, err := m.cfg.ListPolicies()
+	if err != nil {
+		return err
+	}
+
+	var changed int
+	for _, policy := range policies {
+		added, err := m.cfg.AddSnapshot(policy)
+		if err != nil {
+			return err
+		}
+
+		if added {
+			changed++
+		}
+	}
+
+	log.Debugf("Snapshotted %v policies, %v changed", len(policies),
+		changed)
+
+	return nil
+}
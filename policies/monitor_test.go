@@ -0,0 +1,70 @@
+package policies
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestSnapshot tests persisting of our current policies.
+func TestSnapshot(t *testing.T) {
+	var (
+		policies = []*Snapshot{
+			{ChannelPoint: "a:1"},
+			{ChannelPoint: "b:1"},
+		}
+
+		testErr = errors.New("failed")
+	)
+
+	tests := []struct {
+		name        string
+		listErr     error
+		addErr      error
+		expectedErr error
+		expected    []*Snapshot
+	}{
+		{
+			name:     "all policies added",
+			expected: policies,
+		},
+		{
+			name:        "list fails",
+			listErr:     testErr,
+			expectedErr: testErr,
+		},
+		{
+			name:        "add fails",
+			addErr:      testErr,
+			expectedErr: testErr,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var added []*Snapshot
+			monitor := NewMonitor(&MonitorConfig{
+				ListPolicies: func() ([]*Snapshot, error) {
+					return policies, test.listErr
+				},
+				AddSnapshot: func(s *Snapshot) (bool, error) {
+					if test.addErr != nil {
+						return false, test.addErr
+					}
+
+					added = append(added, s)
+					return true, nil
+				},
+			})
+
+			err := monitor.snapshot()
+			require.Equal(t, test.expectedErr, err)
+			require.Equal(t, test.expected, added)
+		})
+	}
+}
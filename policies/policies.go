@@ -0,0 +1,89 @@
+// Package policies records the forwarding policies that our node sets for its
+// channels and reports the volume and fees that each channel earned under
+// each of its policies. Lnd only provides our current policies, so they are
+// snapshotted whenever they change and persisted so that reports can cover
+// any period that faraday has been monitoring for.
+package policies
+
+import (
+	"sort"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// Snapshot is a record of the forwarding policy that our node set for one of
+// its channels.
+type Snapshot struct {
+	// ChannelPoint is the outpoint of the channel.
+	ChannelPoint string
+
+	// ChannelID is the short channel id of the channel.
+	ChannelID uint64
+
+	// Timestamp is the time that the policy took effect.
+	Timestamp time.Time
+
+	// FeeBase is the base fee charged for forwards that leave our node on
+	// the channel.
+	FeeBase lnwire.MilliSatoshi
+
+	// FeeRate is the proportional fee, in parts per million, charged for
+	// forwards that leave our node on the channel.
+	FeeRate int64
+
+	// InboundFeeBase is the base fee charged for forwards that arrive at
+	// our node on the channel. This value is negative if we offer an
+	// inbound discount.
+	InboundFeeBase int64
+
+	// InboundFeeRate is the proportional fee, in parts per million,
+	// charged for forwards that arrive at our node on the channel. This
+	// value is negative if we offer an inbound discount.
+	InboundFeeRate int64
+
+	// TimeLockDelta is the time lock delta that we require for forwards
+	// over the channel.
+	TimeLockDelta uint32
+
+	// MinHtlc is the smallest htlc that we will forward over the channel.
+	MinHtlc lnwire.MilliSatoshi
+
+	// MaxHtlc is the largest htlc that we will forward over the channel.
+	MaxHtlc lnwire.MilliSatoshi
+
+	// Disabled indicates whether we have disabled forwarding over the
+	// channel.
+	Disabled bool
+}
+
+// samePolicy returns a boolean indicating whether two snapshots record the
+// same policy, ignoring the time at which they were taken.
+func (s *Snapshot) samePolicy(other *Snapshot) bool {
+	return s.ChannelID == other.ChannelID &&
+		s.FeeBase == other.FeeBase &&
+		s.FeeRate == other.FeeRate &&
+		s.InboundFeeBase == other.InboundFeeBase &&
+		s.InboundFeeRate == other.InboundFeeRate &&
+		s.TimeLockDelta == other.TimeLockDelta &&
+		s.MinHtlc == other.MinHtlc &&
+		s.MaxHtlc == other.MaxHtlc &&
+		s.Disabled == other.Disabled
+}
+
+// PolicyAt returns the snapshot that was in effect at the time provided,
+// given a set of snapshots for a single channel that are sorted by timestamp.
+// If no snapshot had been taken by the time provided, nil is returned.
+func PolicyAt(snapshots []*Snapshot, at time.Time) *Snapshot {
+	// Find the index of the first snapshot taken after our timestamp, the
+	// snapshot before it is the one that was in effect.
+	i := sort.Search(len(snapshots), func(i int) bool {
+		return snapshots[i].Timestamp.After(at)
+	})
+
+	if i == 0 {
+		return nil
+	}
+
+	return snapshots[i-1]
+}
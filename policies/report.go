@@ -0,0 +1,278 @@
+package policies
+
+import (
+	"sort"
+	"time"
+
+	"github.com/lightninglabs/faraday/revenue"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// Config contains the functions required to produce a policy history report.
+type Config struct {
+	// ListChannels returns all open, public channels.
+	ListChannels func() ([]lndclient.ChannelInfo, error)
+
+	// ClosedChannels returns all closed channels.
+	ClosedChannels func() ([]lndclient.ClosedChannel, error)
+
+	// ListSnapshots returns our policy snapshots, keyed by channel point
+	// and sorted by timestamp.
+	ListSnapshots func() (map[string][]*Snapshot, error)
+
+	// ForwardingHistory returns the forwards that occurred over the
+	// period that the report covers.
+	ForwardingHistory func() ([]lndclient.ForwardingEvent, error)
+
+	// StartTime is the beginning of the period the report covers.
+	StartTime time.Time
+
+	// EndTime is the end of the period the report covers.
+	EndTime time.Time
+}
+
+// Period contains the forwards that a channel made under a single policy.
+type Period struct {
+	// Policy is the policy that was in effect during the period.
+	Policy *Snapshot
+
+	// Start is the beginning of the period.
+	Start time.Time
+
+	// End is the end of the period.
+	End time.Time
+
+	// Forwards is the number of forwards that left our node on the
+	// channel during the period.
+	Forwards int
+
+	// Volume is the total amount forwarded out over the channel.
+	Volume lnwire.MilliSatoshi
+
+	// Fees is the total fees earned by forwards out over the channel.
+	Fees lnwire.MilliSatoshi
+}
+
+// VolumePerDay returns the average volume that the channel forwarded per day
+// during the period, so that periods of different lengths can be compared.
+func (p *Period) VolumePerDay() float64 {
+	days := p.End.Sub(p.Start).Hours() / 24
+	if days <= 0 {
+		return 0
+	}
+
+	return float64(p.Volume) / days
+}
+
+// ChannelHistory contains the policy periods for a channel.
+type ChannelHistory struct {
+	// ChannelPoint is the outpoint of the channel.
+	ChannelPoint string
+
+	// Periods contains each of the policies that the channel had during
+	// the report, sorted by time.
+	Periods []*Period
+
+	// Elasticity is our estimate of the channel's fee elasticity: the
+	// percentage change in the channel's daily volume for each percentage
+	// change in its fee rate. This value is only set if ElasticityPoints
+	// is non-zero.
+	Elasticity float64
+
+	// ElasticityPoints is the number of fee rate changes that our
+	// elasticity estimate is based on.
+	ElasticityPoints int
+}
+
+// Report contains the policy history for each of our channels that has
+// policy snapshots during the period covered.
+type Report struct {
+	// Channels contains a history for each channel, sorted by channel
+	// point.
+	Channels []*ChannelHistory
+}
+
+// GetReport produces a report of the volume and fees that each of our
+// channels earned under each of its policies over the period specified.
+func GetReport(cfg *Config) (*Report, error) {
+	channels, err := cfg.ListChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	closedChannels, err := cfg.ClosedChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots, err := cfg.ListSnapshots()
+	if err != nil {
+		return nil, err
+	}
+
+	fwds, err := cfg.ForwardingHistory()
+	if err != nil {
+		return nil, err
+	}
+
+	return getReport(
+		revenue.ChannelIDs(channels, closedChannels), snapshots, fwds,
+		cfg.StartTime, cfg.EndTime,
+	), nil
+}
+
+// getReport splits each channel's history into periods between consecutive
+// policy snapshots in [start, end), and adds each forward to the period that
+// its outgoing channel was in when it was made. Forwards that occurred before
+// we took a snapshot for their outgoing channel are not included.
+func getReport(channelIDs map[lnwire.ShortChannelID]string,
+	snapshots map[string][]*Snapshot, fwds []lndclient.ForwardingEvent,
+	start, end time.Time) *Report {
+
+	report := &Report{}
+	histories := make(map[string]*ChannelHistory)
+
+	for channel, channelSnapshots := range snapshots {
+		periods := getPeriods(channelSnapshots, start, end)
+		if len(periods) == 0 {
+			continue
+		}
+
+		history := &ChannelHistory{
+			ChannelPoint: channel,
+			Periods:      periods,
+		}
+		histories[channel] = history
+		report.Channels = append(report.Channels, history)
+	}
+
+	for _, fwd := range fwds {
+		channelID := lnwire.NewShortChanIDFromInt(fwd.ChannelOut)
+
+		history, ok := histories[channelIDs[channelID]]
+		if !ok {
+			continue
+		}
+
+		period := periodAt(history.Periods, fwd.Timestamp)
+		if period == nil {
+			continue
+		}
+
+		period.Forwards++
+		period.Volume += fwd.AmountMsatOut
+
+		if fwd.AmountMsatIn > fwd.AmountMsatOut {
+			period.Fees += fwd.AmountMsatIn - fwd.AmountMsatOut
+		}
+	}
+
+	for _, history := range report.Channels {
+		history.Elasticity, history.ElasticityPoints = elasticity(
+			history.Periods,
+		)
+	}
+
+	sort.SliceStable(report.Channels, func(i, j int) bool {
+		return report.Channels[i].ChannelPoint <
+			report.Channels[j].ChannelPoint
+	})
+
+	return report
+}
+
+// getPeriods returns the periods that each of a channel's snapshots was in
+// effect for, limited to [start, end).
+func getPeriods(snapshots []*Snapshot, start, end time.Time) []*Period {
+	var periods []*Period
+
+	for i, snapshot := range snapshots {
+		periodStart := snapshot.Timestamp
+		if periodStart.Before(start) {
+			periodStart = start
+		}
+
+		periodEnd := end
+		if i+1 < len(snapshots) {
+			next := snapshots[i+1].Timestamp
+			if next.Before(end) {
+				periodEnd = next
+			}
+		}
+
+		if !periodEnd.After(periodStart) {
+			continue
+		}
+
+		periods = append(periods, &Period{
+			Policy: snapshot,
+			Start:  periodStart,
+			End:    periodEnd,
+		})
+	}
+
+	return periods
+}
+
+// periodAt returns the period that contains the timestamp provided, or nil
+// if no period contains it.
+func periodAt(periods []*Period, timestamp time.Time) *Period {
+	for _, period := range periods {
+		if !timestamp.Before(period.Start) &&
+			timestamp.Before(period.End) {
+
+			return period
+		}
+	}
+
+	return nil
+}
+
+// elasticity estimates a channel's fee elasticity as the mean arc elasticity
+// of its daily volume with respect to its fee rate across each consecutive
+// pair of periods in which its fee rate changed. Pairs in which the channel
+// was disabled or had no volume are skipped, because they do not reflect
+// demand at the fee rate. The number of pairs used is returned alongside the
+// estimate.
+func elasticity(periods []*Period) (float64, int) {
+	var (
+		total  float64
+		points int
+	)
+
+	for i := 1; i < len(periods); i++ {
+		prev, curr := periods[i-1], periods[i]
+
+		if prev.Policy.FeeRate == curr.Policy.FeeRate {
+			continue
+		}
+
+		if prev.Policy.Disabled || curr.Policy.Disabled {
+			continue
+		}
+
+		prevVolume := prev.VolumePerDay()
+		currVolume := curr.VolumePerDay()
+		if prevVolume == 0 || currVolume == 0 {
+			continue
+		}
+
+		prevRate := float64(prev.Policy.FeeRate)
+		currRate := float64(curr.Policy.FeeRate)
+
+		volumeChange := (currVolume - prevVolume) /
+			((currVolume + prevVolume) / 2)
+		rateChange := (currRate - prevRate) /
+			((currRate + prevRate) / 2)
+
+		total += volumeChange / rateChange
+		points++
+	}
+
+	if points == 0 {
+		return 0, 0
+	}
+
+	return total / float64(points), points
+}
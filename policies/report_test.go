@@ -0,0 +1,197 @@
+package policies
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetReport tests splitting of channel forwards into policy periods and
+// estimation of fee elasticity.
+func TestGetReport(t *testing.T) {
+	var (
+		day   = time.Hour * 24
+		start = time.Unix(1_600_000_000, 0)
+		end   = start.Add(day * 20)
+
+		chanA = "a:1"
+		chanB = "b:1"
+		chanC = "c:1"
+
+		channelIDs = map[lnwire.ShortChannelID]string{
+			lnwire.NewShortChanIDFromInt(1): chanA,
+			lnwire.NewShortChanIDFromInt(2): chanB,
+			lnwire.NewShortChanIDFromInt(3): chanC,
+		}
+	)
+
+	// Channel A's first policy was set before our report, so its period
+	// should start at the beginning of the report. Its last policy was set
+	// after our report ends, so should not be included.
+	snapA1 := &Snapshot{Timestamp: start.Add(-day * 5), FeeRate: 100}
+	snapA2 := &Snapshot{Timestamp: start.Add(day * 10), FeeRate: 200}
+	snapA3 := &Snapshot{Timestamp: end.Add(day), FeeRate: 300}
+
+	// Channel B's policy was set during our report.
+	snapB := &Snapshot{Timestamp: start.Add(day * 5), FeeRate: 50}
+
+	// Channel C's policy was set after our report, so it should not be
+	// included.
+	snapC := &Snapshot{Timestamp: end.Add(day), FeeRate: 10}
+
+	snapshots := map[string][]*Snapshot{
+		chanA: {snapA1, snapA2, snapA3},
+		chanB: {snapB},
+		chanC: {snapC},
+	}
+
+	fwd := func(ts time.Duration, channel uint64,
+		amtIn, amtOut lnwire.MilliSatoshi) lndclient.ForwardingEvent {
+
+		return lndclient.ForwardingEvent{
+			Timestamp:     start.Add(ts),
+			ChannelOut:    channel,
+			AmountMsatIn:  amtIn,
+			AmountMsatOut: amtOut,
+		}
+	}
+
+	fwds := []lndclient.ForwardingEvent{
+		fwd(day, 1, 1_000_100, 1_000_000),
+		fwd(day*2, 1, 1_000_100, 1_000_000),
+		fwd(day*15, 1, 1_000_200, 1_000_000),
+
+		// A forward over channel B before we had a snapshot for it,
+		// which should not be included.
+		fwd(day, 2, 2000, 1000),
+		fwd(day*6, 2, 2000, 1000),
+
+		// A forward over a channel that we do not know.
+		fwd(day, 4, 2000, 1000),
+	}
+
+	report := getReport(channelIDs, snapshots, fwds, start, end)
+
+	// Channel A's daily volume halved when its fee rate doubled, so we
+	// expect an arc elasticity of -1.
+	require.Equal(t, &Report{
+		Channels: []*ChannelHistory{
+			{
+				ChannelPoint: chanA,
+				Periods: []*Period{
+					{
+						Policy:   snapA1,
+						Start:    start,
+						End:      snapA2.Timestamp,
+						Forwards: 2,
+						Volume:   2_000_000,
+						Fees:     200,
+					},
+					{
+						Policy:   snapA2,
+						Start:    snapA2.Timestamp,
+						End:      end,
+						Forwards: 1,
+						Volume:   1_000_000,
+						Fees:     200,
+					},
+				},
+				Elasticity:       -1,
+				ElasticityPoints: 1,
+			},
+			{
+				ChannelPoint: chanB,
+				Periods: []*Period{
+					{
+						Policy:   snapB,
+						Start:    snapB.Timestamp,
+						End:      end,
+						Forwards: 1,
+						Volume:   1000,
+						Fees:     1000,
+					},
+				},
+			},
+		},
+	}, report)
+}
+
+// TestElasticity tests estimation of fee elasticity from policy periods.
+func TestElasticity(t *testing.T) {
+	day := time.Hour * 24
+	start := time.Unix(0, 0)
+
+	period := func(i int, rate int64, disabled bool,
+		volume lnwire.MilliSatoshi) *Period {
+
+		return &Period{
+			Policy: &Snapshot{
+				FeeRate:  rate,
+				Disabled: disabled,
+			},
+			Start:  start.Add(day * time.Duration(i)),
+			End:    start.Add(day * time.Duration(i+1)),
+			Volume: volume,
+		}
+	}
+
+	tests := []struct {
+		name               string
+		periods            []*Period
+		expectedElasticity float64
+		expectedPoints     int
+	}{
+		{
+			name: "no periods",
+		},
+		{
+			name: "no fee rate change",
+			periods: []*Period{
+				period(0, 100, false, 1000),
+				period(1, 100, false, 2000),
+			},
+		},
+		{
+			name: "no volume",
+			periods: []*Period{
+				period(0, 100, false, 1000),
+				period(1, 200, false, 0),
+			},
+		},
+		{
+			name: "disabled",
+			periods: []*Period{
+				period(0, 100, false, 1000),
+				period(1, 200, true, 1000),
+			},
+		},
+		{
+			// Volume is unchanged when we halve our fee rate, then
+			// doubles when we halve it again, giving elasticities
+			// of 0 and -1.
+			name: "mean of changes",
+			periods: []*Period{
+				period(0, 200, false, 1000),
+				period(1, 100, false, 1000),
+				period(2, 50, false, 2000),
+			},
+			expectedElasticity: -0.5,
+			expectedPoints:     2,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			e, points := elasticity(test.periods)
+			require.InDelta(t, test.expectedElasticity, e, 1e-9)
+			require.Equal(t, test.expectedPoints, points)
+		})
+	}
+}
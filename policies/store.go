@@ -0,0 +1,216 @@
+package policies
+
+import (
+	"encoding/binary"
+	"errors"
+	"time"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// snapshotsBucket is the top level bucket that policy snapshots are
+	// stored in. It contains a sub-bucket for each channel, keyed by
+	// channel point, in which snapshots are keyed by timestamp so that
+	// they are sorted by time.
+	snapshotsBucket = []byte("policy-snapshots")
+
+	// errBucketNotFound is returned if our snapshots bucket has not been
+	// created.
+	errBucketNotFound = errors.New("policy snapshots bucket not found")
+
+	// errInvalidValue is returned when a stored snapshot cannot be
+	// decoded.
+	errInvalidValue = errors.New("invalid stored policy snapshot")
+)
+
+const (
+	// keyLength is the length of our snapshot keys, which are 8 byte
+	// timestamps.
+	keyLength = 8
+
+	// valueLength is the length of our snapshot values: an 8 byte channel
+	// id, 8 byte base fee, fee rate, inbound base fee and inbound fee
+	// rate, a 4 byte time lock delta, 8 byte minimum and maximum htlc
+	// amounts and a single byte disabled flag.
+	valueLength = 61
+)
+
+// Store persists policy snapshots.
+type Store struct {
+	db kvdb.Backend
+}
+
+// NewStore creates a policy store backed by the database provided, creating
+// our snapshots bucket if it does not yet exist.
+func NewStore(db kvdb.Backend) (*Store, error) {
+	err := kvdb.Update(db, func(tx kvdb.RwTx) error {
+		_, err := tx.CreateTopLevelBucket(snapshotsBucket)
+		return err
+	}, func() {})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Store{
+		db: db,
+	}, nil
+}
+
+// AddSnapshot persists a policy snapshot if its policy differs from the
+// channel's most recent snapshot, returning a boolean that indicates whether
+// the snapshot was stored.
+func (s *Store) AddSnapshot(snapshot *Snapshot) (bool, error) {
+	var added bool
+
+	err := kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(snapshotsBucket)
+		if bucket == nil {
+			return errBucketNotFound
+		}
+
+		channelBucket, err := bucket.CreateBucketIfNotExists(
+			[]byte(snapshot.ChannelPoint),
+		)
+		if err != nil {
+			return err
+		}
+
+		k, v := channelBucket.ReadWriteCursor().Last()
+		if k != nil {
+			latest, err := readSnapshot(
+				snapshot.ChannelPoint, k, v,
+			)
+			if err != nil {
+				return err
+			}
+
+			if latest.samePolicy(snapshot) {
+				return nil
+			}
+		}
+
+		added = true
+
+		return channelBucket.Put(
+			timestampKey(snapshot.Timestamp),
+			snapshotValue(snapshot),
+		)
+	}, func() {
+		added = false
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return added, nil
+}
+
+// ListSnapshots returns all of our stored snapshots, keyed by channel point
+// and sorted by timestamp.
+func (s *Store) ListSnapshots() (map[string][]*Snapshot, error) {
+	var snapshots map[string][]*Snapshot
+
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(snapshotsBucket)
+		if bucket == nil {
+			return errBucketNotFound
+		}
+
+		return bucket.ForEach(func(channel, _ []byte) error {
+			channelBucket := bucket.NestedReadBucket(channel)
+			if channelBucket == nil {
+				return nil
+			}
+
+			channelPoint := string(channel)
+
+			return channelBucket.ForEach(func(k, v []byte) error {
+				snapshot, err := readSnapshot(
+					channelPoint, k, v,
+				)
+				if err != nil {
+					return err
+				}
+
+				snapshots[channelPoint] = append(
+					snapshots[channelPoint], snapshot,
+				)
+
+				return nil
+			})
+		})
+	}, func() {
+		snapshots = make(map[string][]*Snapshot)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshots, nil
+}
+
+// timestampKey returns the 8 byte key for a timestamp. Timestamps before the
+// unix epoch are clamped to zero so that they sort first.
+func timestampKey(timestamp time.Time) []byte {
+	var ts uint64
+	if nanos := timestamp.UnixNano(); nanos > 0 {
+		ts = uint64(nanos)
+	}
+
+	key := make([]byte, keyLength)
+	binary.BigEndian.PutUint64(key, ts)
+
+	return key
+}
+
+// snapshotValue serializes the values of a snapshot that are not included in
+// its key or bucket.
+func snapshotValue(snapshot *Snapshot) []byte {
+	value := make([]byte, valueLength)
+	binary.BigEndian.PutUint64(value, snapshot.ChannelID)
+	binary.BigEndian.PutUint64(value[8:], uint64(snapshot.FeeBase))
+	binary.BigEndian.PutUint64(value[16:], uint64(snapshot.FeeRate))
+	binary.BigEndian.PutUint64(value[24:], uint64(snapshot.InboundFeeBase))
+	binary.BigEndian.PutUint64(value[32:], uint64(snapshot.InboundFeeRate))
+	binary.BigEndian.PutUint32(value[40:], snapshot.TimeLockDelta)
+	binary.BigEndian.PutUint64(value[44:], uint64(snapshot.MinHtlc))
+	binary.BigEndian.PutUint64(value[52:], uint64(snapshot.MaxHtlc))
+
+	if snapshot.Disabled {
+		value[60] = 1
+	}
+
+	return value
+}
+
+// readSnapshot deserializes a snapshot from its channel point, key and value.
+func readSnapshot(channelPoint string, key, value []byte) (*Snapshot,
+	error) {
+
+	if len(key) != keyLength || len(value) != valueLength {
+		return nil, errInvalidValue
+	}
+
+	timestamp := binary.BigEndian.Uint64(key)
+
+	return &Snapshot{
+		ChannelPoint:   channelPoint,
+		ChannelID:      binary.BigEndian.Uint64(value),
+		Timestamp:      time.Unix(0, int64(timestamp)),
+		FeeBase:        lnwire.MilliSatoshi(uint64At(value, 8)),
+		FeeRate:        int64(uint64At(value, 16)),
+		InboundFeeBase: int64(uint64At(value, 24)),
+		InboundFeeRate: int64(uint64At(value, 32)),
+		TimeLockDelta:  binary.BigEndian.Uint32(value[40:]),
+		MinHtlc:        lnwire.MilliSatoshi(uint64At(value, 44)),
+		MaxHtlc:        lnwire.MilliSatoshi(uint64At(value, 52)),
+		Disabled:       value[60] == 1,
+	}, nil
+}
+
+// uint64At reads a big endian uint64 from the offset provided.
+func uint64At(value []byte, offset int) uint64 {
+	return binary.BigEndian.Uint64(value[offset:])
+}
@@ -0,0 +1,93 @@
+package policies
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStore tests storage of policy snapshots and deduplication of snapshots
+// that do not change a channel's policy.
+func TestStore(t *testing.T) {
+	db, err := kvdb.GetBoltBackend(&kvdb.BoltBackendConfig{
+		DBPath:     t.TempDir(),
+		DBFileName: "test.db",
+		DBTimeout:  time.Second,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	store, err := NewStore(db)
+	require.NoError(t, err)
+
+	now := time.Unix(0, 1_600_000_000_000_000_000)
+	snapshot1 := &Snapshot{
+		ChannelPoint:   "a:1",
+		ChannelID:      1,
+		Timestamp:      now,
+		FeeBase:        1000,
+		FeeRate:        100,
+		InboundFeeBase: -10,
+		InboundFeeRate: -5,
+		TimeLockDelta:  80,
+		MinHtlc:        1,
+		MaxHtlc:        1_000_000,
+	}
+
+	// Our second snapshot has the same policy as the first, so it should
+	// not be stored.
+	snapshot2 := *snapshot1
+	snapshot2.Timestamp = now.Add(time.Hour)
+
+	// Our third snapshot disables the channel, so it should be stored.
+	snapshot3 := snapshot2
+	snapshot3.Timestamp = now.Add(time.Hour * 2)
+	snapshot3.Disabled = true
+
+	// Add a snapshot for another channel.
+	snapshot4 := &Snapshot{
+		ChannelPoint: "b:1",
+		ChannelID:    2,
+		Timestamp:    now,
+		FeeRate:      500,
+	}
+
+	for _, test := range []struct {
+		snapshot *Snapshot
+		added    bool
+	}{
+		{snapshot1, true},
+		{&snapshot2, false},
+		{&snapshot3, true},
+		{snapshot4, true},
+	} {
+		added, err := store.AddSnapshot(test.snapshot)
+		require.NoError(t, err)
+		require.Equal(t, test.added, added)
+	}
+
+	snapshots, err := store.ListSnapshots()
+	require.NoError(t, err)
+	require.Equal(t, map[string][]*Snapshot{
+		"a:1": {snapshot1, &snapshot3},
+		"b:1": {snapshot4},
+	}, snapshots)
+}
+
+// TestPolicyAt tests lookup of the policy that was in effect at a time.
+func TestPolicyAt(t *testing.T) {
+	snapshots := []*Snapshot{
+		{Timestamp: time.Unix(100, 0), FeeRate: 1},
+		{Timestamp: time.Unix(200, 0), FeeRate: 2},
+	}
+
+	require.Nil(t, PolicyAt(snapshots, time.Unix(99, 0)))
+	require.Equal(t, snapshots[0], PolicyAt(snapshots, time.Unix(100, 0)))
+	require.Equal(t, snapshots[0], PolicyAt(snapshots, time.Unix(199, 0)))
+	require.Equal(t, snapshots[1], PolicyAt(snapshots, time.Unix(300, 0)))
+	require.Nil(t, PolicyAt(nil, time.Unix(300, 0)))
+}
@@ -0,0 +1,85 @@
+// Package progress tracks the work done by long running operations, such as
+// node audits, so that their progress can be reported while they run.
+package progress
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// trackerKey is the context key that we store trackers under.
+type trackerKey struct{}
+
+// Progress is a snapshot of the work done by an operation.
+type Progress struct {
+	// Pages is the number of pages that have been fetched from paginated
+	// apis.
+	Pages uint64
+
+	// Prices is the number of fiat prices that have been fetched.
+	Prices uint64
+
+	// Entries is the number of report entries that have been built.
+	Entries uint64
+}
+
+// Tracker counts the work done by an operation. It is safe for concurrent
+// use, and all of its methods may be called on a nil tracker so that
+// operations which are not tracked do not need to check for one.
+type Tracker struct {
+	pages   uint64 // To be used atomically.
+	prices  uint64 // To be used atomically.
+	entries uint64 // To be used atomically.
+}
+
+// NewContext returns a context that carries the tracker provided.
+func NewContext(ctx context.Context, tracker *Tracker) context.Context {
+	return context.WithValue(ctx, trackerKey{}, tracker)
+}
+
+// FromContext returns the tracker carried by a context, or nil if the
+// context is not tracked.
+func FromContext(ctx context.Context) *Tracker {
+	tracker, _ := ctx.Value(trackerKey{}).(*Tracker)
+	return tracker
+}
+
+// AddPages records that pages were fetched from a paginated api.
+func (t *Tracker) AddPages(count uint64) {
+	if t == nil {
+		return
+	}
+
+	atomic.AddUint64(&t.pages, count)
+}
+
+// AddPrices records that fiat prices were fetched.
+func (t *Tracker) AddPrices(count uint64) {
+	if t == nil {
+		return
+	}
+
+	atomic.AddUint64(&t.prices, count)
+}
+
+// AddEntries records that report entries were built.
+func (t *Tracker) AddEntries(count uint64) {
+	if t == nil {
+		return
+	}
+
+	atomic.AddUint64(&t.entries, count)
+}
+
+// Progress returns a snapshot of the work that has been tracked.
+func (t *Tracker) Progress() Progress {
+	if t == nil {
+		return Progress{}
+	}
+
+	return Progress{
+		Pages:   atomic.LoadUint64(&t.pages),
+		Prices:  atomic.LoadUint64(&t.prices),
+		Entries: atomic.LoadUint64(&t.entries),
+	}
+}
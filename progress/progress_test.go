@@ -0,0 +1,35 @@
+package progress
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestTracker tests tracking progress through a context, and that contexts
+// without a tracker can be used.
+func TestTracker(t *testing.T) {
+	t.Parallel()
+
+	// Operations that are not tracked should be able to record progress.
+	untracked := FromContext(context.Background())
+	require.Nil(t, untracked)
+
+	untracked.AddPages(1)
+	require.Equal(t, Progress{}, untracked.Progress())
+
+	tracker := &Tracker{}
+	ctx := NewContext(context.Background(), tracker)
+
+	FromContext(ctx).AddPages(2)
+	FromContext(ctx).AddPrices(3)
+	FromContext(ctx).AddEntries(4)
+	FromContext(ctx).AddEntries(1)
+
+	require.Equal(t, Progress{
+		Pages:   2,
+		Prices:  3,
+		Entries: 5,
+	}, tracker.Progress())
+}
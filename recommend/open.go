@@ -0,0 +1,344 @@
+package recommend
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/lightninglabs/faraday/failures"
+	"github.com/lightninglabs/faraday/insights"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+var (
+	// DefaultLiquidityDays is the default number of days of demand that
+	// we suggest adding capacity for.
+	DefaultLiquidityDays float64 = 7
+
+	// DefaultMinChannelSize is the default minimum channel size that we
+	// will suggest opening.
+	DefaultMinChannelSize btcutil.Amount = 1_000_000
+
+	// DefaultMaxChannelSize is the default maximum channel size that we
+	// will suggest opening. This is the largest channel size permitted for
+	// channels that do not support large channels.
+	DefaultMaxChannelSize btcutil.Amount = (1 << 24) - 1
+
+	// errZeroWindow is returned when open recommendations are requested
+	// without a revenue window.
+	errZeroWindow = errors.New("non-zero revenue window required for " +
+		"open recommendations")
+
+	// errInvalidChannelSize is returned when the minimum channel size
+	// provided is larger than the maximum channel size.
+	errInvalidChannelSize = errors.New("minimum channel size must not " +
+		"exceed maximum channel size")
+)
+
+// insufficientBalance is the failure reason recorded for forwards that failed
+// because we did not have enough balance. We only count these failures when
+// recommending opens, because other failures would not be resolved by adding
+// capacity.
+var insufficientBalance = routerrpc.FailureDetail_INSUFFICIENT_BALANCE.String()
+
+// OpenRecommendationConfig provides the functions and parameters required to
+// provide open recommendations.
+type OpenRecommendationConfig struct {
+	// OpenChannels returns all of our currently open channels.
+	OpenChannels func() ([]lndclient.ChannelInfo, error)
+
+	// ChannelInsights returns insights for our open channels over the
+	// revenue window.
+	ChannelInsights func() ([]*insights.ChannelInfo, error)
+
+	// FailureReport returns a report of the forwards that failed at our
+	// node over the revenue window.
+	FailureReport func() (*failures.Report, error)
+
+	// EstimateFeeRate returns a fee rate estimate for the confirmation
+	// target provided.
+	EstimateFeeRate func(confTarget int32) (chainfee.SatPerKWeight, error)
+
+	// ConfTarget is the confirmation target that we estimate opening fees
+	// for.
+	ConfTarget int32
+
+	// RevenueWindow is the period of time that our insights and failure
+	// report cover.
+	RevenueWindow time.Duration
+
+	// LiquidityDays is the number of days of outgoing demand that we
+	// suggest adding capacity for.
+	LiquidityDays float64
+
+	// MinChannelSize is the smallest channel size we will suggest.
+	MinChannelSize btcutil.Amount
+
+	// MaxChannelSize is the largest channel size we will suggest.
+	MaxChannelSize btcutil.Amount
+}
+
+// OpenRecommendation suggests adding capacity with a peer.
+type OpenRecommendation struct {
+	// Peer is the public key of the peer.
+	Peer route.Vertex
+
+	// Channels is the number of channels we currently have with the peer.
+	Channels int
+
+	// Capacity is the total capacity of our channels with the peer.
+	Capacity btcutil.Amount
+
+	// LocalBalance is our total balance in channels with the peer.
+	LocalBalance btcutil.Amount
+
+	// FeesEarned is the total fees that our channels with the peer earned
+	// over the revenue window.
+	FeesEarned lnwire.MilliSatoshi
+
+	// OutgoingVolume is the amount that we forwarded out to the peer over
+	// the revenue window.
+	OutgoingVolume lnwire.MilliSatoshi
+
+	// MissedVolume is the amount that we failed to forward out to the
+	// peer over the revenue window because we had insufficient balance.
+	MissedVolume lnwire.MilliSatoshi
+
+	// MissedFees is the fees we would have earned for our missed volume.
+	MissedFees lnwire.MilliSatoshi
+
+	// DailyYield is the fees earned and missed per day as a fraction of
+	// the capacity we have with the peer.
+	DailyYield float64
+
+	// SuggestedSize is the size of the channel we suggest opening.
+	SuggestedSize btcutil.Amount
+
+	// ExpectedDailyFees is the fees we expect the new channel to earn per
+	// day if it earns the same yield as our existing channels.
+	ExpectedDailyFees lnwire.MilliSatoshi
+
+	// OpenCost is the estimated on chain fee to open the channel.
+	OpenCost btcutil.Amount
+
+	// PaybackDays is the number of days it is expected to take for the new
+	// channel to earn its opening fee back.
+	PaybackDays float64
+}
+
+// OpenReport contains a set of open recommendations.
+type OpenReport struct {
+	// FeeRate is the fee rate that our opening costs were estimated with.
+	FeeRate chainfee.SatPerKWeight
+
+	// Recommendations contains our open recommendations, sorted by payback
+	// period.
+	Recommendations []*OpenRecommendation
+}
+
+// peerStats accumulates the channel statistics for a peer.
+type peerStats struct {
+	channels       int
+	capacity       btcutil.Amount
+	localBalance   btcutil.Amount
+	feesEarned     lnwire.MilliSatoshi
+	outgoingVolume lnwire.MilliSatoshi
+	missed         failures.Missed
+}
+
+// OpenRecommendations suggests adding capacity with peers that have earned
+// fees over our revenue window. We suggest a channel size that would cover
+// the peer's outgoing demand, including forwards that failed because we had
+// insufficient balance, for the configured number of days. Payback periods
+// are estimated by assuming that new capacity will earn the same fee yield as
+// our existing channels with the peer.
+func OpenRecommendations(cfg *OpenRecommendationConfig) (*OpenReport,
+	error) {
+
+	if cfg.RevenueWindow == 0 {
+		return nil, errZeroWindow
+	}
+
+	if cfg.MinChannelSize > cfg.MaxChannelSize {
+		return nil, errInvalidChannelSize
+	}
+
+	channels, err := cfg.OpenChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	channelInsights, err := cfg.ChannelInsights()
+	if err != nil {
+		return nil, err
+	}
+
+	failureReport, err := cfg.FailureReport()
+	if err != nil {
+		return nil, err
+	}
+
+	feeRate, err := cfg.EstimateFeeRate(cfg.ConfTarget)
+	if err != nil {
+		return nil, err
+	}
+
+	peers := getPeerStats(channels, channelInsights, failureReport)
+
+	report := &OpenReport{
+		FeeRate: feeRate,
+	}
+
+	openCost := feeRate.FeeForWeight(fundingWeight())
+	days := cfg.RevenueWindow.Hours() / 24
+
+	for peer, stats := range peers {
+		rec := recommendOpen(cfg, stats, days, openCost)
+		if rec == nil {
+			continue
+		}
+
+		rec.Peer = peer
+		report.Recommendations = append(report.Recommendations, rec)
+	}
+
+	sort.SliceStable(report.Recommendations, func(i, j int) bool {
+		recI := report.Recommendations[i]
+		recJ := report.Recommendations[j]
+
+		if recI.PaybackDays != recJ.PaybackDays {
+			return recI.PaybackDays < recJ.PaybackDays
+		}
+
+		return recI.Peer.String() < recJ.Peer.String()
+	})
+
+	return report, nil
+}
+
+// getPeerStats aggregates the statistics for our channels by peer.
+func getPeerStats(channels []lndclient.ChannelInfo,
+	channelInsights []*insights.ChannelInfo,
+	failureReport *failures.Report) map[route.Vertex]*peerStats {
+
+	insightsByChannel := make(map[string]*insights.ChannelInfo)
+	for _, insight := range channelInsights {
+		insightsByChannel[insight.ChannelPoint] = insight
+	}
+
+	missedByChannel := make(map[string]*failures.Missed)
+	if failureReport != nil {
+		for _, channel := range failureReport.Channels {
+			missed, ok := channel.Reasons[insufficientBalance]
+			if !ok || channel.ChannelPoint == "" {
+				continue
+			}
+
+			missedByChannel[channel.ChannelPoint] = missed
+		}
+	}
+
+	peers := make(map[route.Vertex]*peerStats)
+	for _, channel := range channels {
+		stats, ok := peers[channel.PubKeyBytes]
+		if !ok {
+			stats = &peerStats{}
+			peers[channel.PubKeyBytes] = stats
+		}
+
+		stats.channels++
+		stats.capacity += channel.Capacity
+		stats.localBalance += channel.LocalBalance
+
+		if insight, ok := insightsByChannel[channel.ChannelPoint]; ok {
+			stats.feesEarned += insight.FeesEarned
+			stats.outgoingVolume += insight.VolumeOutgoing
+		}
+
+		if missed, ok := missedByChannel[channel.ChannelPoint]; ok {
+			stats.missed.Count += missed.Count
+			stats.missed.Volume += missed.Volume
+			stats.missed.Fees += missed.Fees
+		}
+	}
+
+	return peers
+}
+
+// recommendOpen returns an open recommendation for a peer, or nil if the peer
+// has not earned any fees or already has enough balance to cover its demand.
+func recommendOpen(cfg *OpenRecommendationConfig, stats *peerStats,
+	days float64, openCost btcutil.Amount) *OpenRecommendation {
+
+	if stats.feesEarned == 0 || stats.capacity == 0 {
+		return nil
+	}
+
+	// Calculate the amount of balance we need to cover the peer's demand
+	// for our liquidity days, less the balance that we already have.
+	demandPerDay := float64(stats.outgoingVolume+stats.missed.Volume) /
+		days
+	required := demandPerDay*cfg.LiquidityDays -
+		float64(lnwire.NewMSatFromSatoshis(stats.localBalance))
+
+	if required <= 0 {
+		return nil
+	}
+
+	size := btcutil.Amount(math.Ceil(required / 1000))
+	if size < cfg.MinChannelSize {
+		size = cfg.MinChannelSize
+	}
+	if size > cfg.MaxChannelSize {
+		size = cfg.MaxChannelSize
+	}
+
+	capacity := float64(lnwire.NewMSatFromSatoshis(stats.capacity))
+	dailyYield := float64(stats.feesEarned+stats.missed.Fees) /
+		capacity / days
+
+	expectedFees := lnwire.MilliSatoshi(math.Round(
+		dailyYield * float64(lnwire.NewMSatFromSatoshis(size)),
+	))
+
+	payback := math.Inf(1)
+	if expectedFees > 0 {
+		payback = float64(lnwire.NewMSatFromSatoshis(openCost)) /
+			float64(expectedFees)
+	}
+
+	return &OpenRecommendation{
+		Channels:          stats.channels,
+		Capacity:          stats.capacity,
+		LocalBalance:      stats.localBalance,
+		FeesEarned:        stats.feesEarned,
+		OutgoingVolume:    stats.outgoingVolume,
+		MissedVolume:      stats.missed.Volume,
+		MissedFees:        stats.missed.Fees,
+		DailyYield:        dailyYield,
+		SuggestedSize:     size,
+		ExpectedDailyFees: expectedFees,
+		OpenCost:          openCost,
+		PaybackDays:       payback,
+	}
+}
+
+// fundingWeight returns the estimated weight of a channel funding
+// transaction which spends a single taproot input and has a change output.
+func fundingWeight() lntypes.WeightUnit {
+	var funding input.TxWeightEstimator
+	funding.AddTaprootKeySpendInput(txscript.SigHashDefault)
+	funding.AddP2WSHOutput()
+	funding.AddP2TROutput()
+
+	return funding.Weight()
+}
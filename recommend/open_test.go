@@ -0,0 +1,158 @@
+package recommend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/faraday/failures"
+	"github.com/lightninglabs/faraday/insights"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOpenRecommendations tests suggesting channel opens with productive
+// peers.
+func TestOpenRecommendations(t *testing.T) {
+	var (
+		peer1 = route.Vertex{1}
+		peer2 = route.Vertex{2}
+		peer3 = route.Vertex{3}
+		peer4 = route.Vertex{4}
+	)
+
+	cfg := &OpenRecommendationConfig{
+		OpenChannels: func() ([]lndclient.ChannelInfo, error) {
+			return []lndclient.ChannelInfo{
+				{
+					ChannelPoint: "a:1",
+					PubKeyBytes:  peer1,
+					Capacity:     1_000_000,
+					LocalBalance: 100_000,
+				},
+				{
+					ChannelPoint: "b:1",
+					PubKeyBytes:  peer2,
+					Capacity:     500_000,
+				},
+				{
+					ChannelPoint: "c:1",
+					PubKeyBytes:  peer2,
+					Capacity:     500_000,
+				},
+				{
+					ChannelPoint: "d:1",
+					PubKeyBytes:  peer3,
+					Capacity:     1_000_000,
+				},
+				{
+					ChannelPoint: "e:1",
+					PubKeyBytes:  peer4,
+					Capacity:     1_000_000,
+					LocalBalance: 900_000,
+				},
+			}, nil
+		},
+		ChannelInsights: func() ([]*insights.ChannelInfo, error) {
+			return []*insights.ChannelInfo{
+				{
+					ChannelPoint:   "a:1",
+					FeesEarned:     100_000,
+					VolumeOutgoing: 2_000_000_000,
+				},
+				{
+					ChannelPoint:   "b:1",
+					FeesEarned:     10_000,
+					VolumeOutgoing: 50_000_000,
+				},
+				{
+					ChannelPoint:   "c:1",
+					FeesEarned:     10_000,
+					VolumeOutgoing: 50_000_000,
+				},
+				{
+					ChannelPoint:   "d:1",
+					VolumeOutgoing: 50_000_000,
+				},
+				{
+					ChannelPoint:   "e:1",
+					FeesEarned:     1000,
+					VolumeOutgoing: 100_000_000,
+				},
+			}, nil
+		},
+		FailureReport: func() (*failures.Report, error) {
+			return &failures.Report{
+				Channels: []*failures.ChannelReport{
+					{
+						ChannelPoint: "a:1",
+						Reasons: map[string]*failures.Missed{
+							insufficientBalance: {
+								Count:  1,
+								Volume: 1_000_000_000,
+								Fees:   50_000,
+							},
+							failures.ReasonDownstream: {
+								Count:  1,
+								Volume: 1_000_000_000,
+								Fees:   50_000,
+							},
+						},
+					},
+				},
+			}, nil
+		},
+		EstimateFeeRate: func(int32) (chainfee.SatPerKWeight, error) {
+			return 1000, nil
+		},
+		RevenueWindow:  time.Hour * 24 * 10,
+		LiquidityDays:  DefaultLiquidityDays,
+		MinChannelSize: DefaultMinChannelSize,
+		MaxChannelSize: DefaultMaxChannelSize,
+	}
+
+	// Peer 3 has not earned any fees, and peer 4 has enough balance to
+	// cover its demand, so neither should be recommended.
+	report, err := OpenRecommendations(cfg)
+	require.NoError(t, err)
+	require.Len(t, report.Recommendations, 2)
+
+	// At 1000 sat/kw, our opening cost is equal to the weight of our
+	// funding transaction.
+	openCost := fundingWeight()
+
+	// Peer 1 needs 7 days of 300k sat outgoing demand, less its 100k sat
+	// local balance, so we suggest 2M sats. It yields 150k msat over 10
+	// days with 1M sats of capacity, so a 2M sat channel should earn 30k
+	// msat a day.
+	rec := report.Recommendations[0]
+	require.Equal(t, peer1, rec.Peer)
+	require.EqualValues(t, 2_000_000, rec.SuggestedSize)
+	require.EqualValues(t, 1_000_000_000, rec.MissedVolume)
+	require.EqualValues(t, 30_000, rec.ExpectedDailyFees)
+	require.EqualValues(t, openCost, rec.OpenCost)
+	require.InDelta(t, float64(openCost)*1000/30_000, rec.PaybackDays,
+		1e-9)
+
+	// Peer 2's demand is less than our minimum channel size, so we
+	// suggest the minimum. It yields 20k msat over 10 days with 1M sats
+	// of capacity, so a 1M sat channel should earn 2k msat a day.
+	rec = report.Recommendations[1]
+	require.Equal(t, peer2, rec.Peer)
+	require.Equal(t, 2, rec.Channels)
+	require.EqualValues(t, DefaultMinChannelSize, rec.SuggestedSize)
+	require.EqualValues(t, 2000, rec.ExpectedDailyFees)
+	require.InDelta(t, float64(openCost)*1000/2000, rec.PaybackDays,
+		1e-9)
+
+	// Check that we fail for invalid channel sizes and windows.
+	cfg.MinChannelSize = DefaultMaxChannelSize + 1
+	_, err = OpenRecommendations(cfg)
+	require.Equal(t, errInvalidChannelSize, err)
+
+	cfg.MinChannelSize = DefaultMinChannelSize
+	cfg.RevenueWindow = 0
+	_, err = OpenRecommendations(cfg)
+	require.Equal(t, errZeroWindow, err)
+}
@@ -37,6 +37,21 @@ var (
 	// recommendations based on outliers when there is no user provided
 	// value.
 	DefaultOutlierMultiplier float64 = 3
+
+	// DefaultModifiedZScore is the default absolute modified z-score above
+	// which values are considered outliers when there is no user provided
+	// value. This value is recommended by Iglewicz and Hoaglin.
+	DefaultModifiedZScore float64 = 3.5
+
+	// DefaultLowerPercentile is the default percentile beneath which values
+	// are considered to be lower outliers when there is no user provided
+	// value.
+	DefaultLowerPercentile float64 = 5
+
+	// DefaultUpperPercentile is the default percentile above which values
+	// are considered to be upper outliers when there is no user provided
+	// value.
+	DefaultUpperPercentile float64 = 95
 )
 
 // Metric is an enum which indicate what data point our recommendations should
@@ -105,21 +120,41 @@ type Report struct {
 	// Recommendations is a map of chanel outpoints to a bool which
 	// indicates whether we should close the channel.
 	Recommendations map[string]Recommendation
+
+	// OutlierBounds contains the bounds that were used to identify
+	// outliers. This value is only set for outlier recommendations, and
+	// will be nil if there were too few channels to calculate bounds.
+	OutlierBounds *dataset.Bounds
 }
 
 // OutlierRecommendations returns recommendations based on whether a value is a
-// lower outlier within its current dataset. It takes an outlier multiplier value
-// which is the number of inter quartile ranges a value should be away from the
-// lower/upper quartile to be considered an outlier. Recommended values are 1.5
-// for more aggressive recommendations and 3 for more cautious recommendations.
+// lower outlier within its current dataset. It takes an outlier config which
+// determines the method used to identify outliers. For inter-quartile range
+// outliers, the multiplier is the number of inter quartile ranges a value
+// should be away from the lower/upper quartile to be considered an outlier.
+// Recommended values are 1.5 for more aggressive recommendations and 3 for
+// more cautious recommendations.
 func OutlierRecommendations(cfg *CloseRecommendationConfig,
-	outlierMultiplier float64) (*Report, error) {
+	outlierCfg *dataset.OutlierConfig) (*Report, error) {
 
-	getRecs := func(dataset dataset.Dataset) (map[string]Recommendation, error) {
-		return getOutlierRecs(dataset, outlierMultiplier, false)
+	var bounds *dataset.Bounds
+	getRecs := func(data dataset.Dataset) (map[string]Recommendation, error) {
+		var (
+			recs map[string]Recommendation
+			err  error
+		)
+
+		recs, bounds, err = getOutlierRecs(data, outlierCfg, false)
+		return recs, err
 	}
 
-	return closeRecommendations(cfg, getRecs)
+	report, err := closeRecommendations(cfg, getRecs)
+	if err != nil {
+		return nil, err
+	}
+
+	report.OutlierBounds = bounds
+	return report, nil
 }
 
 // ThresholdRecommendations returns a recommendations based on whether a value is
@@ -219,19 +254,19 @@ func getThresholdRecs(values dataset.Dataset,
 }
 
 // getOutlierRecs generates map of channel outpoint strings to booleans
-// indicating whether we recommend closing a channel. It takes a outlier
-// multiplier which scales the degree to which we want to calculate outliers,
-// and an upper outlier boolean which determines whether we want to identify
-// upper or lower outliers.
+// indicating whether we recommend closing a channel, along with the bounds
+// used to identify outliers. It takes an outlier config which determines how
+// we calculate outliers, and an upper outlier boolean which determines whether
+// we want to identify upper or lower outliers.
 func getOutlierRecs(values dataset.Dataset,
-	outlierMultiplier float64,
-	upperOutlier bool) (map[string]Recommendation, error) {
+	outlierCfg *dataset.OutlierConfig, upperOutlier bool) (
+	map[string]Recommendation, *dataset.Bounds, error) {
 
 	recommendations := make(map[string]Recommendation)
 
-	outliers, err := values.GetOutliers(outlierMultiplier)
+	outliers, bounds, err := values.GetOutliersWithConfig(outlierCfg)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Add a recommendation for each channel to our set of recommendations.
@@ -259,7 +294,7 @@ func getOutlierRecs(values dataset.Dataset,
 		}
 	}
 
-	return recommendations, nil
+	return recommendations, bounds, nil
 }
 
 // filterChannels filters out channels that are beneath the minimum age, or
@@ -98,10 +98,12 @@ func TestCloseRecommendations(t *testing.T) {
 			recFunc := func(data dataset.Dataset) (
 				m map[string]Recommendation, err error) {
 
-				return getOutlierRecs(
-					data, DefaultOutlierMultiplier,
-					test.upperOutlier,
+				recs, _, err := getOutlierRecs(
+					data, &dataset.OutlierConfig{
+						Multiplier: DefaultOutlierMultiplier,
+					}, test.upperOutlier,
 				)
+				return recs, err
 			}
 
 			_, err := closeRecommendations(
@@ -251,9 +253,11 @@ func TestOutlierRecommendations(t *testing.T) {
 
 			uptimeData := dataset.New(test.channelUptimes)
 
-			recs, err := getOutlierRecs(
-				uptimeData, test.outlierMultiplier,
-				test.upperOutlier,
+			recs, _, err := getOutlierRecs(
+				uptimeData, &dataset.OutlierConfig{
+					Method:     dataset.IQRMethod,
+					Multiplier: test.outlierMultiplier,
+				}, test.upperOutlier,
 			)
 			if err != nil {
 				t.Fatalf("unexpected error: %v", err)
@@ -0,0 +1,118 @@
+package revenue
+
+import (
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// feeRateParts is the number of parts that fee rates are expressed in.
+const feeRateParts = 1_000_000
+
+// Policy describes the fees that our node charges to forward htlcs over a
+// channel.
+type Policy struct {
+	// FeeBase is the base fee charged for forwards that leave our node on
+	// the channel.
+	FeeBase lnwire.MilliSatoshi
+
+	// FeeRate is the proportional fee, in parts per million, charged for
+	// forwards that leave our node on the channel.
+	FeeRate int64
+
+	// InboundFeeBase is the base fee charged for forwards that arrive at
+	// our node on the channel. This value is negative if we offer an
+	// inbound discount.
+	InboundFeeBase int64
+
+	// InboundFeeRate is the proportional fee, in parts per million,
+	// charged for forwards that arrive at our node on the channel. This
+	// value is negative if we offer an inbound discount.
+	InboundFeeRate int64
+}
+
+// OutboundFee returns the fee that the policy charges to forward the amount
+// provided out over the channel.
+func (p *Policy) OutboundFee(amt lnwire.MilliSatoshi) lnwire.MilliSatoshi {
+	return p.FeeBase + amt*lnwire.MilliSatoshi(p.FeeRate)/feeRateParts
+}
+
+// InboundFee returns the inbound fee that the policy charges for a forward
+// arriving on the channel. Inbound fees are calculated on the amount that is
+// forwarded onwards, including the outbound fee. Negative values represent a
+// discount.
+func (p *Policy) InboundFee(amt lnwire.MilliSatoshi) int64 {
+	return p.InboundFeeBase + int64(amt)*p.InboundFeeRate/feeRateParts
+}
+
+// PolicyLookup returns the policy that was in effect for a channel at the
+// time provided. If no policy is known for the channel, a nil policy should be
+// returned.
+type PolicyLookup func(channel string, at time.Time) (*Policy, error)
+
+// inboundFee decomposes the fee earned for a forward using the policies of its
+// incoming and outgoing channels, returning the inbound component of the fee.
+// The remainder of the fee is attributed to the outgoing channel's policy. We
+// follow lnd in not allowing inbound discounts to reduce the total fee below
+// zero. If we do not have the policies required to decompose the fee, false
+// is returned.
+func inboundFee(event revenueEvent, lookup PolicyLookup) (int64, bool,
+	error) {
+
+	outPolicy, err := lookup(event.outgoingChannel, event.timestamp)
+	if err != nil {
+		return 0, false, err
+	}
+
+	inPolicy, err := lookup(event.incomingChannel, event.timestamp)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if outPolicy == nil || inPolicy == nil {
+		return 0, false, nil
+	}
+
+	outbound := outPolicy.OutboundFee(event.outgoingAmt)
+	inbound := inPolicy.InboundFee(event.outgoingAmt + outbound)
+
+	if inbound < -int64(outbound) {
+		inbound = -int64(outbound)
+	}
+
+	return inbound, true, nil
+}
+
+// addInboundFees decomposes the fees for each of the events provided into
+// their outbound and inbound components, and adds the inbound surcharges and
+// discounts to the incoming channel's revenue records. Events that we do not
+// have policies for are counted as undecomposed, rather than guessing their
+// inbound fees from other policies.
+func addInboundFees(report *Report, events []revenueEvent,
+	lookup PolicyLookup) error {
+
+	for _, event := range events {
+		fee, ok, err := inboundFee(event, lookup)
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			log.Tracef("no policies for forward %v -> %v at %v",
+				event.incomingChannel, event.outgoingChannel,
+				event.timestamp)
+
+			report.addUndecomposed(
+				event.incomingChannel, event.outgoingChannel,
+			)
+
+			continue
+		}
+
+		report.addInbound(
+			event.incomingChannel, event.outgoingChannel, fee,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,185 @@
+package revenue
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestInboundFee tests decomposition of a forward's fee into its inbound
+// component.
+func TestInboundFee(t *testing.T) {
+	var (
+		chanIn  = "a:1"
+		chanOut = "b:1"
+
+		// outPolicy charges 1100 msat to forward our event's outgoing
+		// amount.
+		outPolicy = &Policy{
+			FeeBase: 1000,
+			FeeRate: 100,
+		}
+
+		event = revenueEvent{
+			timestamp:       time.Unix(1000, 0),
+			incomingChannel: chanIn,
+			outgoingChannel: chanOut,
+			incomingAmt:     1_000_850,
+			outgoingAmt:     1_000_000,
+		}
+
+		testErr = errors.New("lookup failed")
+	)
+
+	tests := []struct {
+		name        string
+		inPolicy    *Policy
+		outPolicy   *Policy
+		lookupErr   error
+		expectedFee int64
+		expectedOk  bool
+		expectedErr error
+	}{
+		{
+			name:      "no incoming policy",
+			outPolicy: outPolicy,
+		},
+		{
+			name:     "no outgoing policy",
+			inPolicy: &Policy{InboundFeeBase: 100},
+		},
+		{
+			name:        "lookup fails",
+			lookupErr:   testErr,
+			expectedErr: testErr,
+		},
+		{
+			name:       "no inbound fee",
+			inPolicy:   &Policy{},
+			outPolicy:  outPolicy,
+			expectedOk: true,
+		},
+		{
+			name:        "inbound surcharge",
+			inPolicy:    &Policy{InboundFeeBase: 100},
+			outPolicy:   outPolicy,
+			expectedFee: 100,
+			expectedOk:  true,
+		},
+		{
+			// The inbound rate is charged on our outgoing amount
+			// plus the outbound fee: -200 + 1_001_100 * -50 / 1m.
+			name: "inbound discount",
+			inPolicy: &Policy{
+				InboundFeeBase: -200,
+				InboundFeeRate: -50,
+			},
+			outPolicy:   outPolicy,
+			expectedFee: -250,
+			expectedOk:  true,
+		},
+		{
+			name:        "discount limited to outbound fee",
+			inPolicy:    &Policy{InboundFeeBase: -5000},
+			outPolicy:   outPolicy,
+			expectedFee: -1100,
+			expectedOk:  true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			lookup := func(channel string, at time.Time) (*Policy,
+				error) {
+
+				require.Equal(t, event.timestamp, at)
+
+				if channel == chanIn {
+					return test.inPolicy, test.lookupErr
+				}
+
+				return test.outPolicy, test.lookupErr
+			}
+
+			fee, ok, err := inboundFee(event, lookup)
+			require.Equal(t, test.expectedErr, err)
+			require.Equal(t, test.expectedOk, ok)
+			require.Equal(t, test.expectedFee, fee)
+		})
+	}
+}
+
+// TestAddInboundFees tests adding inbound surcharges and discounts to a
+// revenue report.
+func TestAddInboundFees(t *testing.T) {
+	var (
+		chan1 = "a:1"
+		chan2 = "b:1"
+		chan3 = "c:1"
+	)
+
+	policies := map[string]*Policy{
+		chan1: {FeeBase: 50, InboundFeeBase: -10},
+		chan2: {FeeBase: 50, InboundFeeBase: 20},
+	}
+
+	lookup := func(channel string, _ time.Time) (*Policy, error) {
+		return policies[channel], nil
+	}
+
+	events := []revenueEvent{
+		{
+			incomingChannel: chan1,
+			outgoingChannel: chan2,
+			incomingAmt:     1000,
+			outgoingAmt:     900,
+		},
+		{
+			incomingChannel: chan2,
+			outgoingChannel: chan1,
+			incomingAmt:     1000,
+			outgoingAmt:     900,
+		},
+		{
+			incomingChannel: chan2,
+			outgoingChannel: chan1,
+			incomingAmt:     1000,
+			outgoingAmt:     900,
+		},
+		// We have no policy for channel 3, so we should not decompose
+		// its fees.
+		{
+			incomingChannel: chan3,
+			outgoingChannel: chan1,
+			incomingAmt:     1000,
+			outgoingAmt:     900,
+		},
+	}
+
+	report := getReport(events, AttributeSplit, nil)
+	require.NoError(t, addInboundFees(report, events, lookup))
+
+	require.EqualValues(
+		t, 10, report.ChannelPairs[chan1][chan2].InboundDiscounts,
+	)
+	require.EqualValues(
+		t, 40, report.ChannelPairs[chan2][chan1].InboundSurcharges,
+	)
+
+	chan3Revenue := report.ChannelPairs[chan3][chan1]
+	require.Zero(t, chan3Revenue.InboundDiscounts)
+	require.Zero(t, chan3Revenue.InboundSurcharges)
+	require.EqualValues(t, 1, chan3Revenue.UndecomposedForwards)
+
+	// Forwards that we could decompose should not be counted as
+	// undecomposed, and undecomposed forwards are only recorded against
+	// their incoming channel.
+	require.Zero(t, report.ChannelPairs[chan2][chan1].UndecomposedForwards)
+	require.Zero(t, report.ChannelPairs[chan1][chan3].UndecomposedForwards)
+}
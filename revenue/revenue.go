@@ -1,10 +1,99 @@
 package revenue
 
 import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
 	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
 
+// FeeAttribution determines how the fee earned by a forward is attributed to
+// its incoming and outgoing channels.
+type FeeAttribution int
+
+const (
+	// AttributeFull attributes the full fee of each forward to both its
+	// incoming and outgoing channels. This is our default, so that each
+	// channel pair reports all the fees that it was involved in earning,
+	// but it double counts fees when they are summed across channels.
+	AttributeFull FeeAttribution = iota
+
+	// AttributeSplit splits fees evenly between the incoming and outgoing
+	// channels.
+	AttributeSplit
+
+	// AttributeOutgoing attributes all fees to the outgoing channel,
+	// since it is the outgoing channel's liquidity that we are paid for.
+	AttributeOutgoing
+
+	// AttributeIncoming attributes all fees to the incoming channel.
+	AttributeIncoming
+
+	// AttributeCapacity splits fees between the incoming and outgoing
+	// channels in proportion to their capacities. This approximates the
+	// liquidity that each channel provided using its total capacity,
+	// rather than its balances at the time of each forward.
+	AttributeCapacity
+)
+
+// String returns the string representation of a fee attribution.
+func (f FeeAttribution) String() string {
+	switch f {
+	case AttributeFull:
+		return "full"
+
+	case AttributeSplit:
+		return "split"
+
+	case AttributeOutgoing:
+		return "outgoing"
+
+	case AttributeIncoming:
+		return "incoming"
+
+	case AttributeCapacity:
+		return "capacity weighted"
+
+	default:
+		return "unknown"
+	}
+}
+
+// ErrUnknownAttribution is returned when a revenue report is requested with
+// an unknown fee attribution.
+var ErrUnknownAttribution = errors.New("unknown fee attribution")
+
+// incomingShare returns the fraction of a forward's fee that is attributed to
+// its incoming channel, given the capacities of its incoming and outgoing
+// channels. If we do not know either channel's capacity, capacity weighted
+// attribution falls back to an even split. Full attribution does not share
+// fees between channels, so it is handled separately.
+func (f FeeAttribution) incomingShare(incoming,
+	outgoing btcutil.Amount) float64 {
+
+	switch f {
+	case AttributeOutgoing:
+		return 0
+
+	case AttributeIncoming:
+		return 1
+
+	case AttributeCapacity:
+		if incoming == 0 || outgoing == 0 {
+			return 0.5
+		}
+
+		return float64(incoming) / float64(incoming+outgoing)
+
+	default:
+		return 0.5
+	}
+}
+
 // Config contains all the functions required to calculate revenue.
 type Config struct {
 	// ListChannels returns all open, public channels.
@@ -17,10 +106,34 @@ type Config struct {
 	// The period that these results queried over determines the period
 	// that the report is generated for.
 	ForwardingHistory func() ([]lndclient.ForwardingEvent, error)
+
+	// ListAliases returns the alias short channel ids that lnd has recorded
+	// for our channels. Zero-conf and option-scid-alias channels forward
+	// under these aliases, so we need them to attribute their forwards.
+	// This function is optional, if it is not set, only the short channel
+	// ids reported for our channels are used.
+	ListAliases func() ([]*lnrpc.AliasMap, error)
+
+	// FeeAttribution determines how each forward's fee is attributed to
+	// its incoming and outgoing channels.
+	FeeAttribution FeeAttribution
+
+	// ChannelPolicy looks up the fee policy that was in effect for a
+	// channel at the time of a forward, which is used to decompose each
+	// forward's fee into its outbound and inbound components. This
+	// function is optional, if it is not set, inbound fees are not
+	// reported.
+	ChannelPolicy PolicyLookup
 }
 
 // GetRevenueReport produces a revenue report over the period specified.
 func GetRevenueReport(cfg *Config) (*Report, error) {
+	if cfg.FeeAttribution < AttributeFull ||
+		cfg.FeeAttribution > AttributeCapacity {
+
+		return nil, ErrUnknownAttribution
+	}
+
 	// To provide the user with a revenue report by outpoint, we need to map
 	// short channel ids in the forwarding log to outpoints. Lookup all open
 	// and closed channels to produce a map of short channel id to outpoint.
@@ -35,16 +148,28 @@ func GetRevenueReport(cfg *Config) (*Report, error) {
 	}
 
 	// Add the channels looked up to a map of short channel id to outpoint
-	// string.
-	channelIDs := make(map[lnwire.ShortChannelID]string)
+	// string. We also track each channel's capacity so that we can weight
+	// fees by capacity.
+	channelIDs := ChannelIDs(channels, closedChannels)
+	capacities := make(map[string]btcutil.Amount)
 	for _, channel := range channels {
-		id := lnwire.NewShortChanIDFromInt(channel.ChannelID)
-		channelIDs[id] = channel.ChannelPoint
+		capacities[channel.ChannelPoint] = channel.Capacity
 	}
 
 	for _, closedChannel := range closedChannels {
-		id := lnwire.NewShortChanIDFromInt(closedChannel.ChannelID)
-		channelIDs[id] = closedChannel.ChannelPoint
+		capacities[closedChannel.ChannelPoint] = closedChannel.Capacity
+	}
+
+	// Closed channels do not report their aliases, so we lookup lnd's
+	// alias mappings to resolve aliases that are no longer reported for
+	// our channels.
+	if cfg.ListAliases != nil {
+		aliasMaps, err := cfg.ListAliases()
+		if err != nil {
+			return nil, err
+		}
+
+		addAliases(channelIDs, aliasMaps)
 	}
 
 	fwds, err := cfg.ForwardingHistory()
@@ -52,24 +177,110 @@ func GetRevenueReport(cfg *Config) (*Report, error) {
 		return nil, err
 	}
 
-	events := getRevenueEvents(channelIDs, fwds)
-	return getReport(events), nil
+	events, unattributed := getRevenueEvents(channelIDs, fwds)
+
+	report := getReport(events, cfg.FeeAttribution, capacities)
+	report.Unattributed = unattributed
+	report.Attribution = cfg.FeeAttribution
+
+	if cfg.ChannelPolicy != nil {
+		err := addInboundFees(report, events, cfg.ChannelPolicy)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return report, nil
+}
+
+// ChannelIDs returns a map of the short channel ids that our open and closed
+// channels are referred to by in lnd's forwarding history to their channel
+// points. Open channels may also be referred to by their confirmed zero-conf
+// short channel id or any of their aliases.
+func ChannelIDs(channels []lndclient.ChannelInfo,
+	closed []lndclient.ClosedChannel) map[lnwire.ShortChannelID]string {
+
+	channelIDs := make(map[lnwire.ShortChannelID]string)
+	for _, channel := range channels {
+		ids := append(
+			[]uint64{channel.ChannelID, channel.ZeroConfScid},
+			channel.AliasScids...,
+		)
+
+		for _, id := range ids {
+			if id == 0 {
+				continue
+			}
+
+			shortID := lnwire.NewShortChanIDFromInt(id)
+			channelIDs[shortID] = channel.ChannelPoint
+		}
+	}
+
+	for _, closedChannel := range closed {
+		id := lnwire.NewShortChanIDFromInt(closedChannel.ChannelID)
+		channelIDs[id] = closedChannel.ChannelPoint
+	}
+
+	return channelIDs
+}
+
+// addAliases adds the short channel ids in each of the alias mappings provided
+// to our set of known channel ids if any of the ids in the mapping is already
+// known. Each mapping contains a base short channel id, which is either our
+// confirmed short channel id or the first alias for zero-conf channels, and
+// all of the aliases used for the channel.
+func addAliases(channelIDs map[lnwire.ShortChannelID]string,
+	aliasMaps []*lnrpc.AliasMap) {
+
+	for _, aliasMap := range aliasMaps {
+		ids := append([]uint64{aliasMap.BaseScid}, aliasMap.Aliases...)
+
+		var channelPoint string
+		for _, id := range ids {
+			shortID := lnwire.NewShortChanIDFromInt(id)
+			if outpoint, ok := channelIDs[shortID]; ok {
+				channelPoint = outpoint
+				break
+			}
+		}
+
+		if channelPoint == "" {
+			log.Debugf("cannot find channel for aliases: %v",
+				ids)
+
+			continue
+		}
+
+		for _, id := range ids {
+			shortID := lnwire.NewShortChanIDFromInt(id)
+			if _, ok := channelIDs[shortID]; !ok {
+				channelIDs[shortID] = channelPoint
+			}
+		}
+	}
 }
 
 // getRevenueEvents produces a set of revenue events from a set of forwarding
-// events that can be used to create a report.
+// events that can be used to create a report. Forwards for which we cannot
+// find the incoming or outgoing channel are returned as unattributed
+// forwards.
 func getRevenueEvents(channelIDs map[lnwire.ShortChannelID]string,
-	fwdEvents []lndclient.ForwardingEvent) []revenueEvent {
+	fwdEvents []lndclient.ForwardingEvent) ([]revenueEvent,
+	[]UnattributedForward) {
 
 	// Do not prealloc because we may not add events if we cannot get their
 	// channel ID from the channelIDs map.
 	// nolint: prealloc
-	var events []revenueEvent
+	var (
+		events       []revenueEvent
+		unattributed []UnattributedForward
+	)
 
 	// Get the event's channel outpoints from out known list of maps and
-	// create a revenue event. Return an error if the short channel id's
-	// outpoint cannot be found, because we expect all known short channel
-	// ids to be provided.
+	// create a revenue event. If either short channel id's outpoint
+	// cannot be found, we record the forward as unattributed so that its
+	// revenue is not silently dropped.
 	for _, fwd := range fwdEvents {
 		shortChanIn := lnwire.NewShortChanIDFromInt(
 			fwd.ChannelIn,
@@ -78,29 +289,30 @@ func getRevenueEvents(channelIDs map[lnwire.ShortChannelID]string,
 			fwd.ChannelOut,
 		)
 
-		incoming, ok := channelIDs[shortChanIn]
-		if !ok {
-			log.Errorf("cannot find channel "+
-				"incoming outpoint for forward: %v(%v "+
-				"msat) -> %v(%v msat)", shortChanIn,
-				fwd.AmountMsatIn, shortChanOut,
-				fwd.AmountMsatOut)
+		incoming, inOk := channelIDs[shortChanIn]
+		outgoing, outOk := channelIDs[shortChanOut]
 
-			continue
-		}
-
-		outgoing, ok := channelIDs[shortChanOut]
-		if !ok {
-			log.Errorf("cannot find channel "+
-				"outgoing outpoint for forward: %v(%v "+
-				"msat) -> %v(%v msat)", shortChanIn,
-				fwd.AmountMsatIn, shortChanOut,
+		if !inOk || !outOk {
+			log.Warnf("cannot find channel outpoint for "+
+				"forward: %v(%v msat) -> %v(%v msat)",
+				shortChanIn, fwd.AmountMsatIn, shortChanOut,
 				fwd.AmountMsatOut)
 
+			unattributed = append(unattributed, UnattributedForward{
+				Timestamp:       fwd.Timestamp,
+				ChannelIn:       shortChanIn,
+				ChannelOut:      shortChanOut,
+				IncomingChannel: incoming,
+				OutgoingChannel: outgoing,
+				AmountIn:        fwd.AmountMsatIn,
+				AmountOut:       fwd.AmountMsatOut,
+			})
+
 			continue
 		}
 
 		events = append(events, revenueEvent{
+			timestamp:       fwd.Timestamp,
 			incomingChannel: incoming,
 			outgoingChannel: outgoing,
 			incomingAmt:     fwd.AmountMsatIn,
@@ -108,7 +320,40 @@ func getRevenueEvents(channelIDs map[lnwire.ShortChannelID]string,
 		})
 	}
 
-	return events
+	return events, unattributed
+}
+
+// UnattributedForward is a forward that could not be attributed to a pair of
+// channels because we could not find the channel point for its incoming or
+// outgoing short channel id.
+type UnattributedForward struct {
+	// Timestamp is the time that the forward was settled.
+	Timestamp time.Time
+
+	// ChannelIn is the short channel id that the forward arrived on.
+	ChannelIn lnwire.ShortChannelID
+
+	// ChannelOut is the short channel id that the forward left on.
+	ChannelOut lnwire.ShortChannelID
+
+	// IncomingChannel is the channel point of the incoming channel, or an
+	// empty string if it could not be found.
+	IncomingChannel string
+
+	// OutgoingChannel is the channel point of the outgoing channel, or an
+	// empty string if it could not be found.
+	OutgoingChannel string
+
+	// AmountIn is the amount in msat that arrived on the incoming channel.
+	AmountIn lnwire.MilliSatoshi
+
+	// AmountOut is the amount in msat that left on the outgoing channel.
+	AmountOut lnwire.MilliSatoshi
+}
+
+// Fee returns the fee that we earned for the forward.
+func (u UnattributedForward) Fee() lnwire.MilliSatoshi {
+	return u.AmountIn - u.AmountOut
 }
 
 // Report provides a pairwise report on channel revenue. It maps a
@@ -120,6 +365,15 @@ type Report struct {
 	// ChannelPairs contains a map of the string representation of a channel's
 	// outpoint to a map of pair channels with which it has generated revenue.
 	ChannelPairs map[string]map[string]Revenue
+
+	// Unattributed contains forwards that could not be included in our
+	// channel pairs because we could not find the channel point for their
+	// incoming or outgoing channel.
+	Unattributed []UnattributedForward
+
+	// Attribution is the fee attribution that the report was created
+	// with.
+	Attribution FeeAttribution
 }
 
 // Revenue describes the volume of forwards that a channel has been a part of
@@ -143,6 +397,74 @@ type Revenue struct {
 	// FeesIncoming is the amount in msat of fees that we attribute to the
 	// channel for its role as the incoming channel in forwards.
 	FeesIncoming lnwire.MilliSatoshi
+
+	// InboundSurcharges is the amount in msat of inbound fees that we
+	// charged for forwards that arrived on the channel, in addition to the
+	// outgoing channel's fee.
+	InboundSurcharges lnwire.MilliSatoshi
+
+	// InboundDiscounts is the amount in msat of inbound discounts that we
+	// gave to forwards that arrived on the channel, which reduced the fees
+	// we earned from the outgoing channel's fee.
+	InboundDiscounts lnwire.MilliSatoshi
+
+	// UndecomposedForwards is the number of forwards that arrived on the
+	// channel whose fees we could not decompose into their outbound and
+	// inbound components, because we do not know the policies that were
+	// in effect at the time. The inbound fees of these forwards are not
+	// included in our inbound surcharges and discounts.
+	UndecomposedForwards uint64
+}
+
+// Totals returns the total fees earned and volume forwarded in the report.
+// Each forward is recorded against both its incoming and outgoing channel,
+// and depending on the report's fee attribution its fee may be attributed in
+// full to both of them. We therefore calculate our fees from the difference
+// between our incoming and outgoing amounts, which does not depend on fee
+// attribution, and only count outgoing volume. Forwards that could not be
+// attributed to our channels are included in our totals.
+func (r Report) Totals() (lnwire.MilliSatoshi, lnwire.MilliSatoshi) {
+	var incoming, outgoing lnwire.MilliSatoshi
+	for _, pairs := range r.ChannelPairs {
+		for _, revenue := range pairs {
+			incoming += revenue.AmountIncoming
+			outgoing += revenue.AmountOutgoing
+		}
+	}
+
+	for _, forward := range r.Unattributed {
+		incoming += forward.AmountIn
+		outgoing += forward.AmountOut
+	}
+
+	return incoming - outgoing, outgoing
+}
+
+// Merge adds the revenue and unattributed forwards of another report to the
+// report, so that the reports of consecutive periods can be combined. Both
+// reports must have been created with the same fee attribution.
+func (r *Report) Merge(other *Report) {
+	for targetChan, pairs := range other.ChannelPairs {
+		for pairChan, revenue := range pairs {
+			record := r.getRevenue(targetChan, pairChan)
+			r.setRevenue(targetChan, pairChan, record.add(revenue))
+		}
+	}
+
+	r.Unattributed = append(r.Unattributed, other.Unattributed...)
+}
+
+// add returns the sum of two revenue records.
+func (r Revenue) add(other Revenue) Revenue {
+	r.AmountOutgoing += other.AmountOutgoing
+	r.AmountIncoming += other.AmountIncoming
+	r.FeesOutgoing += other.FeesOutgoing
+	r.FeesIncoming += other.FeesIncoming
+	r.InboundSurcharges += other.InboundSurcharges
+	r.InboundDiscounts += other.InboundDiscounts
+	r.UndecomposedForwards += other.UndecomposedForwards
+
+	return r
 }
 
 // getRevenue gets a revenue record for a given target channel and its
@@ -208,19 +530,49 @@ func (r Report) addOutgoing(outgoingChannel,
 	r.setRevenue(outgoingChannel, incomingChannel, revenue)
 }
 
+// addInbound gets the existing revenue record for the incoming channel with
+// the outgoing channel, adds the inbound fee charged for a forward and updates
+// the Revenue Report. Negative inbound fees are recorded as discounts.
+func (r Report) addInbound(incomingChannel, outgoingChannel string,
+	fee int64) {
+
+	revenue := r.getRevenue(incomingChannel, outgoingChannel)
+
+	if fee < 0 {
+		revenue.InboundDiscounts += lnwire.MilliSatoshi(-fee)
+	} else {
+		revenue.InboundSurcharges += lnwire.MilliSatoshi(fee)
+	}
+
+	r.setRevenue(incomingChannel, outgoingChannel, revenue)
+}
+
+// addUndecomposed records a forward from the incoming channel to the outgoing
+// channel whose fee we could not decompose against the incoming channel.
+func (r Report) addUndecomposed(incomingChannel, outgoingChannel string) {
+	revenue := r.getRevenue(incomingChannel, outgoingChannel)
+	revenue.UndecomposedForwards++
+	r.setRevenue(incomingChannel, outgoingChannel, revenue)
+}
+
 // revenueEvent provides the information captured by ForwardingEvents with
 // channel outpoint strings rather than short channel ids.
 type revenueEvent struct {
+	timestamp       time.Time
 	incomingChannel string
 	outgoingChannel string
 	incomingAmt     lnwire.MilliSatoshi
 	outgoingAmt     lnwire.MilliSatoshi
 }
 
-// getReport creates a revenue report for the set of events provided. It
-// takes an attribute incoming float which determines the fee split between
-// incoming and outgoing channels.
-func getReport(events []revenueEvent) *Report {
+// getReport creates a revenue report for the set of events provided. Each
+// event's fee is attributed to its incoming and outgoing channels according to
+// the fee attribution provided. With the exception of full attribution, fees
+// are split between the channels so that they are not double counted when
+// they are summed across channels.
+func getReport(events []revenueEvent, attribution FeeAttribution,
+	capacities map[string]btcutil.Amount) *Report {
+
 	report := &Report{
 		ChannelPairs: make(map[string]map[string]Revenue),
 	}
@@ -229,16 +581,28 @@ func getReport(events []revenueEvent) *Report {
 		// Calculate total fees earned for this event.
 		fee := event.incomingAmt - event.outgoingAmt
 
-		// Calculate fees earned by the incoming channel in this event.
-		fees := lnwire.MilliSatoshi(float64(fee))
+		// Calculate fees earned by the incoming channel in this event,
+		// the remainder of the fee is earned by the outgoing channel.
+		// If we attribute the full fee, both channels earn all of it.
+		feesIn, feesOut := fee, fee
+		if attribution != AttributeFull {
+			share := attribution.incomingShare(
+				capacities[event.incomingChannel],
+				capacities[event.outgoingChannel],
+			)
+			feesIn = lnwire.MilliSatoshi(
+				math.Round(float64(fee) * share),
+			)
+			feesOut = fee - feesIn
+		}
 
 		// Update the revenue record for the incoming channel.
 		report.addIncoming(event.incomingChannel, event.outgoingChannel,
-			event.incomingAmt, fees)
+			event.incomingAmt, feesIn)
 
 		// Update the revenue record for the downstream channel.
 		report.addOutgoing(event.outgoingChannel, event.incomingChannel,
-			event.outgoingAmt, fees)
+			event.outgoingAmt, feesOut)
 	}
 
 	return report
@@ -5,7 +5,9 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/btcsuite/btcd/btcutil"
 	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/stretchr/testify/require"
 )
@@ -36,6 +38,8 @@ func TestGetRevenueReport(t *testing.T) {
 		openChannels   []lndclient.ChannelInfo
 		closedChannels []lndclient.ClosedChannel
 		fwdHistory     []lndclient.ForwardingEvent
+		aliases        []*lnrpc.AliasMap
+		attribution    FeeAttribution
 		expectedReport *Report
 		expectErr      error
 	}{
@@ -64,8 +68,56 @@ func TestGetRevenueReport(t *testing.T) {
 			expectErr: nil,
 			expectedReport: &Report{
 				ChannelPairs: make(map[string]map[string]Revenue),
+				Unattributed: []UnattributedForward{
+					{
+						ChannelIn: lnwire.ShortChannelID{
+							TxPosition: 123,
+						},
+					},
+				},
 			},
 		},
+		{
+			name: "alias channels",
+			openChannels: []lndclient.ChannelInfo{
+				{
+					ChannelPoint: chan1.ChannelPoint,
+					ChannelID:    chan1.ChannelID,
+					AliasScids:   []uint64{124},
+				},
+			},
+			closedChannels: []lndclient.ClosedChannel{{
+				ChannelPoint: chan2.ChannelPoint,
+				ChannelID:    chan2.ChannelID,
+			}},
+			aliases: []*lnrpc.AliasMap{
+				{
+					BaseScid: chan2.ChannelID,
+					Aliases:  []uint64{322},
+				},
+			},
+			fwdHistory: []lndclient.ForwardingEvent{
+				{
+					ChannelIn:     124,
+					ChannelOut:    322,
+					AmountMsatOut: 100,
+					AmountMsatIn:  150,
+				},
+			},
+			expectedReport: &Report{
+				ChannelPairs: map[string]map[string]Revenue{
+					chan1.ChannelPoint: {
+						chan2.ChannelPoint: Revenue{
+							AmountIncoming: 150,
+							FeesIncoming:   50,
+						}},
+					chan2.ChannelPoint: {
+						chan1.ChannelPoint: Revenue{
+							AmountOutgoing: 100,
+							FeesOutgoing:   50,
+						}},
+				}},
+		},
 		{
 			name:         "open and closed channel",
 			openChannels: []lndclient.ChannelInfo{chan1},
@@ -100,6 +152,11 @@ func TestGetRevenueReport(t *testing.T) {
 				}},
 			expectErr: nil,
 		},
+		{
+			name:        "unknown attribution",
+			attribution: AttributeCapacity + 1,
+			expectErr:   ErrUnknownAttribution,
+		},
 	}
 
 	for _, test := range tests {
@@ -118,6 +175,10 @@ func TestGetRevenueReport(t *testing.T) {
 				ForwardingHistory: func() ([]lndclient.ForwardingEvent, error) {
 					return test.fwdHistory, test.forwardHistErr
 				},
+				ListAliases: func() ([]*lnrpc.AliasMap, error) {
+					return test.aliases, nil
+				},
+				FeeAttribution: test.attribution,
 			}
 
 			report, err := GetRevenueReport(cfg)
@@ -160,7 +221,8 @@ func TestGetEvents(t *testing.T) {
 		chanOutID: chanOutOutpoint,
 	}
 
-	events := getRevenueEvents(channelIDFound, mockedEvents)
+	events, unattributed := getRevenueEvents(channelIDFound, mockedEvents)
+	require.Len(t, unattributed, 0)
 
 	// expectedEvents is the set of events we expect to get when we can
 	// lookup all our channels.
@@ -177,22 +239,101 @@ func TestGetEvents(t *testing.T) {
 
 	// Now, we make a query with an empty channel map (which means we cannot
 	// lookup the mapping from short channel ID to channel point). We expect
-	// getRevenueEvents to skip this event and succeed with an empty set of
-	// events.
+	// getRevenueEvents to skip this event and return it as an unattributed
+	// forward.
 	channelNotFound := make(map[lnwire.ShortChannelID]string)
-	events = getRevenueEvents(channelNotFound, mockedEvents)
+	events, unattributed = getRevenueEvents(channelNotFound, mockedEvents)
 	require.Len(t, events, 0)
+	require.Equal(t, []UnattributedForward{
+		{
+			ChannelIn:  chanInID,
+			ChannelOut: chanOutID,
+			AmountIn:   4000,
+			AmountOut:  2000,
+		},
+	}, unattributed)
+
+	// Finally, test the case where we can only find our incoming channel.
+	// We expect the known channel point to be included in the
+	// unattributed forward.
+	channelInFound := map[lnwire.ShortChannelID]string{
+		chanInID: chanInOutpoint,
+	}
+	events, unattributed = getRevenueEvents(channelInFound, mockedEvents)
+	require.Len(t, events, 0)
+	require.Len(t, unattributed, 1)
+	require.Equal(t, chanInOutpoint, unattributed[0].IncomingChannel)
+	require.Equal(t, "", unattributed[0].OutgoingChannel)
+	require.EqualValues(t, 2000, unattributed[0].Fee())
+}
+
+// TestAddAliases tests resolution of alias short channel ids to channel
+// points using lnd's alias mappings.
+func TestAddAliases(t *testing.T) {
+	var (
+		base      = lnwire.NewShortChanIDFromInt(100)
+		alias1    = lnwire.NewShortChanIDFromInt(101)
+		alias2    = lnwire.NewShortChanIDFromInt(102)
+		unknown   = lnwire.NewShortChanIDFromInt(200)
+		unknownAl = lnwire.NewShortChanIDFromInt(201)
+		confirmed = lnwire.NewShortChanIDFromInt(300)
+	)
+
+	// Our first channel is known by its base short channel id, and our
+	// second channel is only known by the alias that was reported for it.
+	channelIDs := map[lnwire.ShortChannelID]string{
+		base:      "a:1",
+		confirmed: "b:1",
+		alias2:    "c:1",
+	}
+
+	addAliases(channelIDs, []*lnrpc.AliasMap{
+		{
+			BaseScid: base.ToUint64(),
+			Aliases:  []uint64{alias1.ToUint64()},
+		},
+		{
+			BaseScid: unknown.ToUint64(),
+			Aliases: []uint64{
+				unknownAl.ToUint64(), alias2.ToUint64(),
+			},
+		},
+		{
+			BaseScid: 400,
+			Aliases:  []uint64{401},
+		},
+	})
+
+	// We expect our first alias to resolve to our base channel, and the
+	// second mapping to resolve to the channel known by its alias. Our
+	// mapping with no known ids should not be added.
+	require.Equal(t, map[lnwire.ShortChannelID]string{
+		base:      "a:1",
+		alias1:    "a:1",
+		confirmed: "b:1",
+		alias2:    "c:1",
+		unknown:   "c:1",
+		unknownAl: "c:1",
+	}, channelIDs)
 }
 
 // TestGetReport tests creation of a revenue report for a set of
 // revenue events. It covers the case where there are no events, and
-// the case where one channel is involved in multiple forwards.
+// the case where one channel is involved in multiple forwards with each of
+// our fee attribution models.
 func TestGetReport(t *testing.T) {
 	var (
 		channel1 = "a:1"
 		channel2 = "a:2"
 	)
 
+	// Give our second channel three times the capacity of our first
+	// channel so that we can test capacity weighted attribution.
+	capacities := map[string]btcutil.Amount{
+		channel1: 1000,
+		channel2: 3000,
+	}
+
 	// chan1Incoming is a forwarding event where channel 1 is the incoming
 	// channel.
 	chan1Incoming := revenueEvent{
@@ -219,9 +360,50 @@ func TestGetReport(t *testing.T) {
 		outgoingAmt:     90,
 	}
 
+	allEvents := []revenueEvent{
+		chan1Incoming,
+		chan1Outgoing,
+		chan2Event,
+	}
+
+	// getExpected returns the report we expect for all of our events,
+	// given the fees attributed to the incoming and outgoing channel for
+	// each of our events, in order.
+	getExpected := func(in1, out1, in2, out2, in3,
+		out3 lnwire.MilliSatoshi) *Report {
+
+		return &Report{
+			ChannelPairs: map[string]map[string]Revenue{
+				channel1: {
+					channel2: {
+						AmountOutgoing: 200,
+						AmountIncoming: 1000,
+						FeesOutgoing:   out2,
+						FeesIncoming:   in1,
+					},
+				},
+				channel2: {
+					channel1: {
+						AmountOutgoing: 500,
+						AmountIncoming: 400,
+						FeesOutgoing:   out1,
+						FeesIncoming:   in2,
+					},
+					channel2: {
+						AmountOutgoing: 90,
+						AmountIncoming: 100,
+						FeesOutgoing:   out3,
+						FeesIncoming:   in3,
+					},
+				},
+			},
+		}
+	}
+
 	tests := []struct {
 		name           string
 		events         []revenueEvent
+		attribution    FeeAttribution
 		expectedReport *Report
 	}{
 		{
@@ -232,38 +414,34 @@ func TestGetReport(t *testing.T) {
 			},
 		},
 		{
-			name: "multiple forwards for one channel",
-			events: []revenueEvent{
-				chan1Incoming,
-				chan1Outgoing,
-				chan2Event,
-			},
-			expectedReport: &Report{
-				ChannelPairs: map[string]map[string]Revenue{
-					channel1: {
-						channel2: {
-							AmountOutgoing: 200,
-							AmountIncoming: 1000,
-							FeesOutgoing:   200,
-							FeesIncoming:   500,
-						},
-					},
-					channel2: {
-						channel1: {
-							AmountOutgoing: 500,
-							AmountIncoming: 400,
-							FeesOutgoing:   500,
-							FeesIncoming:   200,
-						},
-						channel2: {
-							AmountOutgoing: 90,
-							AmountIncoming: 100,
-							FeesOutgoing:   10,
-							FeesIncoming:   10,
-						},
-					},
-				},
-			},
+			name:           "full attribution",
+			events:         allEvents,
+			attribution:    AttributeFull,
+			expectedReport: getExpected(500, 500, 200, 200, 10, 10),
+		},
+		{
+			name:           "split attribution",
+			events:         allEvents,
+			attribution:    AttributeSplit,
+			expectedReport: getExpected(250, 250, 100, 100, 5, 5),
+		},
+		{
+			name:           "outgoing attribution",
+			events:         allEvents,
+			attribution:    AttributeOutgoing,
+			expectedReport: getExpected(0, 500, 0, 200, 0, 10),
+		},
+		{
+			name:           "incoming attribution",
+			events:         allEvents,
+			attribution:    AttributeIncoming,
+			expectedReport: getExpected(500, 0, 200, 0, 10, 0),
+		},
+		{
+			name:           "capacity weighted attribution",
+			events:         allEvents,
+			attribution:    AttributeCapacity,
+			expectedReport: getExpected(125, 375, 150, 50, 5, 5),
 		},
 	}
 
@@ -271,7 +449,9 @@ func TestGetReport(t *testing.T) {
 		test := test
 
 		t.Run(test.name, func(t *testing.T) {
-			report := getReport(test.events)
+			report := getReport(
+				test.events, test.attribution, capacities,
+			)
 
 			if !reflect.DeepEqual(report, test.expectedReport) {
 				t.Fatalf("expected revenue: %v, got: %v",
@@ -280,3 +460,126 @@ func TestGetReport(t *testing.T) {
 		})
 	}
 }
+
+// TestReportTotals tests that we do not double count fees or volume when
+// summing over channel pairs with any fee attribution, and that we include
+// unattributed forwards.
+func TestReportTotals(t *testing.T) {
+	t.Parallel()
+
+	// Two forwards with fees of 10 and 20 msat.
+	events := []revenueEvent{
+		{
+			incomingChannel: "chan1",
+			outgoingChannel: "chan2",
+			incomingAmt:     1010,
+			outgoingAmt:     1000,
+		},
+		{
+			incomingChannel: "chan2",
+			outgoingChannel: "chan1",
+			incomingAmt:     2020,
+			outgoingAmt:     2000,
+		},
+	}
+
+	capacities := map[string]btcutil.Amount{
+		"chan1": 100_000,
+		"chan2": 300_000,
+	}
+
+	attributions := []FeeAttribution{
+		AttributeFull, AttributeSplit, AttributeOutgoing,
+		AttributeIncoming, AttributeCapacity,
+	}
+
+	for _, attribution := range attributions {
+		attribution := attribution
+
+		t.Run(attribution.String(), func(t *testing.T) {
+			t.Parallel()
+
+			report := getReport(events, attribution, capacities)
+			report.Unattributed = []UnattributedForward{
+				{
+					AmountIn:  4040,
+					AmountOut: 4000,
+				},
+			}
+
+			fees, volume := report.Totals()
+			require.Equal(t, lnwire.MilliSatoshi(70), fees)
+			require.Equal(t, lnwire.MilliSatoshi(7000), volume)
+		})
+	}
+}
+
+// TestReportMerge tests merging the reports of consecutive periods.
+func TestReportMerge(t *testing.T) {
+	t.Parallel()
+
+	report := &Report{
+		ChannelPairs: map[string]map[string]Revenue{
+			"chan1": {
+				"chan2": {
+					AmountIncoming:       1010,
+					FeesIncoming:         5,
+					InboundSurcharges:    1,
+					UndecomposedForwards: 1,
+				},
+			},
+		},
+		Unattributed: []UnattributedForward{
+			{AmountIn: 101, AmountOut: 100},
+		},
+		Attribution: AttributeSplit,
+	}
+
+	report.Merge(&Report{
+		ChannelPairs: map[string]map[string]Revenue{
+			"chan1": {
+				"chan2": {
+					AmountIncoming:   2020,
+					FeesIncoming:     10,
+					InboundDiscounts: 2,
+				},
+			},
+			"chan2": {
+				"chan1": {
+					AmountOutgoing: 2000,
+					FeesOutgoing:   10,
+				},
+			},
+		},
+		Unattributed: []UnattributedForward{
+			{AmountIn: 202, AmountOut: 200},
+		},
+		Attribution: AttributeSplit,
+	})
+
+	expected := &Report{
+		ChannelPairs: map[string]map[string]Revenue{
+			"chan1": {
+				"chan2": {
+					AmountIncoming:       3030,
+					FeesIncoming:         15,
+					InboundSurcharges:    1,
+					InboundDiscounts:     2,
+					UndecomposedForwards: 1,
+				},
+			},
+			"chan2": {
+				"chan1": {
+					AmountOutgoing: 2000,
+					FeesOutgoing:   10,
+				},
+			},
+		},
+		Unattributed: []UnattributedForward{
+			{AmountIn: 101, AmountOut: 100},
+			{AmountIn: 202, AmountOut: 200},
+		},
+		Attribution: AttributeSplit,
+	}
+	require.Equal(t, expected, report)
+}